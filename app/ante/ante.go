@@ -8,7 +8,7 @@ import (
 )
 
 // NewAnteHandler returns an 'AnteHandler' that will run actions before a tx is sent to a module's handler.
-func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer, addressFetchers ...AddressFetcher) sdk.AnteHandler {
+func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer, pauseKeeper PauseKeeper, restrictionKeeper TransferRestrictionKeeper, addressFetchers ...AddressFetcher) sdk.AnteHandler {
 	decorators := []sdk.AnteDecorator{}
 
 	decorators = append(decorators, ante.NewSetUpContextDecorator()) // outermost AnteDecorator. SetUpContext must be called first
@@ -16,6 +16,8 @@ func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, si
 	if len(addressFetchers) > 0 {
 		decorators = append(decorators, NewAuthenticatedMempoolDecorator(addressFetchers...))
 	}
+	decorators = append(decorators, NewPausedMsgDecorator(pauseKeeper))
+	decorators = append(decorators, NewIssuanceTransferDecorator(restrictionKeeper))
 	decorators = append(decorators,
 		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
@@ -20,15 +20,20 @@ import (
 )
 
 func TestAppAnteHandler(t *testing.T) {
-	testPrivKeys, testAddresses := app.GeneratePrivKeyAddressPairs(10)
+	testPrivKeys, testAddresses := app.GeneratePrivKeyAddressPairs(13)
 	unauthed := testAddresses[0:2]
 	unathedKeys := testPrivKeys[0:2]
 	deputy := testAddresses[2]
 	deputyKey := testPrivKeys[2]
 	oracles := testAddresses[3:6]
 	oraclesKeys := testPrivKeys[3:6]
-	manual := testAddresses[6:]
-	manualKeys := testPrivKeys[6:]
+	manual := testAddresses[6:10]
+	manualKeys := testPrivKeys[6:10]
+	additionalDeputy := testAddresses[10]
+	additionalDeputyKey := testPrivKeys[10]
+	retiringDeputies := testAddresses[11:]
+	retiringDeputyKeys := testPrivKeys[11:]
+	genesisTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	db := tmdb.NewMemDB()
 	tApp := app.TestApp{
@@ -42,13 +47,16 @@ func TestAppAnteHandler(t *testing.T) {
 
 	chainID := "internal-test-chain"
 	tApp = tApp.InitializeFromGenesisStatesWithTimeAndChainID(
-		time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
+		genesisTime,
 		chainID,
 		NewAuthGenStateWithSameCoins(
 			sdk.NewCoins(sdk.NewInt64Coin("ukava", 1_000_000_000)),
 			testAddresses,
 		),
-		newBep3GenStateMulti(deputy),
+		newBep3GenStateMulti(deputy, []sdk.AccAddress{additionalDeputy}, bep3.RetiringDeputies{
+			bep3.NewRetiringDeputy(retiringDeputies[0], genesisTime.Add(time.Hour)),
+			bep3.NewRetiringDeputy(retiringDeputies[1], genesisTime),
+		}),
 		newPricefeedGenStateMulti(oracles),
 	)
 
@@ -76,6 +84,24 @@ func TestAppAnteHandler(t *testing.T) {
 			privKey:    deputyKey,
 			expectPass: true,
 		},
+		{
+			name:       "additional deputy",
+			address:    additionalDeputy,
+			privKey:    additionalDeputyKey,
+			expectPass: true,
+		},
+		{
+			name:       "retiring deputy in grace period",
+			address:    retiringDeputies[0],
+			privKey:    retiringDeputyKeys[0],
+			expectPass: true,
+		},
+		{
+			name:       "retiring deputy after grace period",
+			address:    retiringDeputies[1],
+			privKey:    retiringDeputyKeys[1],
+			expectPass: false,
+		},
 		{
 			name:       "manual",
 			address:    manual[1],
@@ -140,7 +166,7 @@ func newPricefeedGenStateMulti(oracles []sdk.AccAddress) app.GenesisState {
 	return app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pfGenesis)}
 }
 
-func newBep3GenStateMulti(deputyAddress sdk.AccAddress) app.GenesisState {
+func newBep3GenStateMulti(deputyAddress sdk.AccAddress, additionalDeputies []sdk.AccAddress, retiringDeputies bep3.RetiringDeputies) app.GenesisState {
 	bep3Genesis := bep3.GenesisState{
 		Params: bep3.Params{
 			AssetParams: bep3.AssetParams{
@@ -160,6 +186,9 @@ func newBep3GenStateMulti(deputyAddress sdk.AccAddress) app.GenesisState {
 					MaxSwapAmount: sdk.NewInt(1000000000000),
 					MinBlockLock:  bep3.DefaultMinBlockLock,
 					MaxBlockLock:  bep3.DefaultMaxBlockLock,
+
+					AdditionalDeputies: additionalDeputies,
+					RetiringDeputies:   retiringDeputies,
 				},
 				bep3.AssetParam{
 					Denom:  "inc",
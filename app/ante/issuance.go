@@ -0,0 +1,46 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// TransferRestrictionKeeper defines the expected issuance keeper used to validate transfers of restricted assets
+type TransferRestrictionKeeper interface {
+	ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+}
+
+// IssuanceTransferDecorator rejects bank sends of paused issuance assets, and of blockable issuance assets to or from blocked addresses.
+// Transfers through other modules are checked by those modules' keepers.
+type IssuanceTransferDecorator struct {
+	restrictionKeeper TransferRestrictionKeeper
+}
+
+func NewIssuanceTransferDecorator(rk TransferRestrictionKeeper) IssuanceTransferDecorator {
+	return IssuanceTransferDecorator{
+		restrictionKeeper: rk,
+	}
+}
+
+func (itd IssuanceTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case bank.MsgSend:
+			if err := itd.restrictionKeeper.ValidateTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+				return ctx, err
+			}
+		case bank.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := itd.restrictionKeeper.ValidateTransfer(ctx, input.Address, nil, input.Coins); err != nil {
+					return ctx, err
+				}
+			}
+			for _, output := range msg.Outputs {
+				if err := itd.restrictionKeeper.ValidateTransfer(ctx, nil, output.Address, output.Coins); err != nil {
+					return ctx, err
+				}
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,88 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRestrictionKeeper struct {
+	pausedDenoms []string
+	blocked      []sdk.AccAddress
+}
+
+func (mrk mockRestrictionKeeper) ValidateTransfer(_ sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	for _, denom := range mrk.pausedDenoms {
+		if !coins.AmountOf(denom).IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "asset is paused")
+		}
+	}
+	for _, addr := range mrk.blocked {
+		if addr.Equals(from) || addr.Equals(to) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "account is blocked")
+		}
+	}
+	return nil
+}
+
+func TestIssuanceTransferDecorator_AnteHandle(t *testing.T) {
+	testPrivKeys, testAddresses := generatePrivKeyAddressPairs(4)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 100_000_000))
+
+	sendTx := helpers.GenTx(
+		[]sdk.Msg{bank.NewMsgSend(testAddresses[0], testAddresses[1], coins)},
+		sdk.NewCoins(), // no fee
+		helpers.DefaultGenTxGas,
+		"testing-chain-id",
+		[]uint64{0},
+		[]uint64{0},
+		testPrivKeys[0],
+	)
+	multiSendTx := helpers.GenTx(
+		[]sdk.Msg{bank.NewMsgMultiSend(
+			[]bank.Input{bank.NewInput(testAddresses[0], coins)},
+			[]bank.Output{bank.NewOutput(testAddresses[2], coins)},
+		)},
+		sdk.NewCoins(), // no fee
+		helpers.DefaultGenTxGas,
+		"testing-chain-id",
+		[]uint64{0},
+		[]uint64{0},
+		testPrivKeys[0],
+	)
+
+	testcases := []struct {
+		name      string
+		tx        sdk.Tx
+		keeper    mockRestrictionKeeper
+		expectErr bool
+	}{
+		{"unrestricted send", sendTx, mockRestrictionKeeper{blocked: []sdk.AccAddress{testAddresses[3]}}, false},
+		{"paused asset send", sendTx, mockRestrictionKeeper{pausedDenoms: []string{"usdtoken"}}, true},
+		{"send from blocked address", sendTx, mockRestrictionKeeper{blocked: []sdk.AccAddress{testAddresses[0]}}, true},
+		{"send to blocked address", sendTx, mockRestrictionKeeper{blocked: []sdk.AccAddress{testAddresses[1]}}, true},
+		{"unrestricted multisend", multiSendTx, mockRestrictionKeeper{blocked: []sdk.AccAddress{testAddresses[1]}}, false},
+		{"multisend to blocked address", multiSendTx, mockRestrictionKeeper{blocked: []sdk.AccAddress{testAddresses[2]}}, true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := NewIssuanceTransferDecorator(tc.keeper)
+			mmd := MockAnteHandler{}
+			ctx := sdk.Context{}
+
+			_, err := decorator.AnteHandle(ctx, tc.tx, false, mmd.AnteHandle)
+
+			if tc.expectErr {
+				require.Error(t, err)
+				require.False(t, mmd.WasCalled)
+			} else {
+				require.NoError(t, err)
+				require.True(t, mmd.WasCalled)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PauseKeeper defines the expected committee keeper used to look up paused msgs
+type PauseKeeper interface {
+	CheckMsgPaused(ctx sdk.Context, msg sdk.Msg) error
+}
+
+// PausedMsgDecorator rejects txs containing msgs that have been paused by a committee.
+// Unlike the AuthenticatedMempoolDecorator it runs in both CheckTx and DeliverTx.
+type PausedMsgDecorator struct {
+	pauseKeeper PauseKeeper
+}
+
+func NewPausedMsgDecorator(pk PauseKeeper) PausedMsgDecorator {
+	return PausedMsgDecorator{
+		pauseKeeper: pk,
+	}
+}
+
+func (pmd PausedMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := pmd.pauseKeeper.CheckMsgPaused(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
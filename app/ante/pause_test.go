@@ -0,0 +1,80 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+type mockPauseKeeper struct {
+	pausedTypes []string
+}
+
+func (mpk mockPauseKeeper) CheckMsgPaused(_ sdk.Context, msg sdk.Msg) error {
+	for _, t := range mpk.pausedTypes {
+		if msg.Type() == t {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg is paused")
+		}
+	}
+	return nil
+}
+
+func TestPausedMsgDecorator_AnteHandle(t *testing.T) {
+	testPrivKeys, testAddresses := generatePrivKeyAddressPairs(2)
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{
+			bank.NewMsgSend(
+				testAddresses[0],
+				testAddresses[1],
+				sdk.NewCoins(sdk.NewInt64Coin("ukava", 100_000_000)),
+			),
+			bep3.NewMsgClaimAtomicSwap(
+				testAddresses[0],
+				nil,
+				nil,
+			),
+		},
+		sdk.NewCoins(), // no fee
+		helpers.DefaultGenTxGas,
+		"testing-chain-id",
+		[]uint64{0},
+		[]uint64{0},
+		testPrivKeys[0],
+	)
+
+	testcases := []struct {
+		name        string
+		pausedTypes []string
+		isCheckTx   bool
+		expectErr   bool
+	}{
+		{"nothing paused", nil, false, false},
+		{"other msg paused", []string{"createAtomicSwap"}, false, false},
+		{"msg paused (DeliverTx)", []string{"claimAtomicSwap"}, false, true},
+		{"msg paused (CheckTx)", []string{"claimAtomicSwap"}, true, true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := NewPausedMsgDecorator(mockPauseKeeper{pausedTypes: tc.pausedTypes})
+			mmd := MockAnteHandler{}
+			ctx := sdk.Context{}.WithIsCheckTx(tc.isCheckTx)
+
+			_, err := decorator.AnteHandle(ctx, tx, false, mmd.AnteHandle)
+
+			if tc.expectErr {
+				require.Error(t, err)
+				require.False(t, mmd.WasCalled)
+			} else {
+				require.NoError(t, err)
+				require.True(t, mmd.WasCalled)
+			}
+		})
+	}
+}
@@ -69,7 +69,8 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler, committee.ProposalHandler,
-			upgradeclient.ProposalHandler, kavadist.ProposalHandler,
+			upgradeclient.ProposalHandler, kavadist.ProposalHandler, kavadist.StreamSpendProposalHandler,
+			kavadist.StreamCancelProposalHandler, hard.ProposalHandler, bep3.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -103,11 +104,14 @@ var (
 		auction.ModuleName:          nil,
 		cdp.ModuleName:              {supply.Minter, supply.Burner},
 		cdp.LiquidatorMacc:          {supply.Minter, supply.Burner},
+		cdp.TreasuryMacc:            nil,
 		bep3.ModuleName:             {supply.Minter, supply.Burner},
 		kavadist.ModuleName:         {supply.Minter},
+		kavadist.StreamEscrowMacc:   nil,
 		issuance.ModuleAccountName:  {supply.Minter, supply.Burner},
-		hard.ModuleAccountName:      {supply.Minter},
+		hard.ModuleAccountName:      {supply.Minter, supply.Burner},
 		swap.ModuleAccountName:      nil,
+		pricefeed.ModuleAccountName: {supply.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -292,23 +296,6 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
 
-	// create committee keeper with router
-	committeeGovRouter := gov.NewRouter()
-	committeeGovRouter.
-		AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
-	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
-	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
-	app.committeeKeeper = committee.NewKeeper(
-		app.cdc,
-		keys[committee.StoreKey],
-		committeeGovRouter,
-		app.paramsKeeper,
-		app.accountKeeper,
-		app.supplyKeeper,
-	)
 	app.kavadistKeeper = kavadist.NewKeeper(
 		app.cdc,
 		keys[kavadist.StoreKey],
@@ -318,24 +305,6 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.ModuleAccountAddrs(),
 	)
 
-	// create gov keeper with router
-	govRouter := gov.NewRouter()
-	govRouter.
-		AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper)).
-		AddRoute(kavadist.RouterKey, kavadist.NewCommunityPoolMultiSpendProposalHandler(app.kavadistKeeper))
-	app.govKeeper = gov.NewKeeper(
-		app.cdc,
-		keys[gov.StoreKey],
-		govSubspace,
-		app.supplyKeeper,
-		&stakingKeeper,
-		govRouter,
-	)
-
 	app.vvKeeper = validatorvesting.NewKeeper(
 		app.cdc,
 		keys[validatorvesting.StoreKey],
@@ -348,6 +317,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.cdc,
 		keys[pricefeed.StoreKey],
 		pricefeedSubspace,
+		app.supplyKeeper,
 	)
 	app.auctionKeeper = auction.NewKeeper(
 		app.cdc,
@@ -355,6 +325,13 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.supplyKeeper,
 		auctionSubspace,
 	)
+	swapKeeper := swap.NewKeeper(
+		app.cdc,
+		keys[swap.StoreKey],
+		swapSubspace,
+		app.accountKeeper,
+		app.supplyKeeper,
+	)
 	cdpKeeper := cdp.NewKeeper(
 		app.cdc,
 		keys[cdp.StoreKey],
@@ -363,9 +340,10 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.auctionKeeper,
 		app.supplyKeeper,
 		app.accountKeeper,
+		&swapKeeper,
 		mAccPerms,
 	)
-	app.bep3Keeper = bep3.NewKeeper(
+	bep3Keeper := bep3.NewKeeper(
 		app.cdc,
 		keys[bep3.StoreKey],
 		app.supplyKeeper,
@@ -382,6 +360,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		&stakingKeeper,
 		app.pricefeedKeeper,
 		app.auctionKeeper,
+		&swapKeeper,
 	)
 	app.issuanceKeeper = issuance.NewKeeper(
 		app.cdc,
@@ -390,13 +369,6 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.accountKeeper,
 		app.supplyKeeper,
 	)
-	swapKeeper := swap.NewKeeper(
-		app.cdc,
-		keys[swap.StoreKey],
-		swapSubspace,
-		app.accountKeeper,
-		app.supplyKeeper,
-	)
 	app.incentiveKeeper = incentive.NewKeeper(
 		app.cdc,
 		keys[incentive.StoreKey],
@@ -414,11 +386,61 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks(), app.incentiveKeeper.Hooks()))
 
-	app.cdpKeeper = *cdpKeeper.SetHooks(cdp.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
+	app.cdpKeeper = *cdpKeeper.SetHooks(cdp.NewMultiCDPHooks(app.incentiveKeeper.Hooks())).SetSendRestriction(app.issuanceKeeper)
+
+	app.hardKeeper = *hardKeeper.SetHooks(hard.NewMultiHARDHooks(app.incentiveKeeper.Hooks())).SetSendRestriction(app.issuanceKeeper)
+
+	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks()).SetSendRestriction(app.issuanceKeeper)
+
+	app.bep3Keeper = *bep3Keeper.SetSendRestriction(app.issuanceKeeper)
+
+	app.auctionKeeper = *app.auctionKeeper.SetHooks(auction.NewMultiAuctionHooks(app.hardKeeper.AuctionHooks()))
+
+	// create committee keeper with router
+	committeeGovRouter := gov.NewRouter()
+	committeeGovRouter.
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(hard.RouterKey, hard.NewReserveWithdrawalProposalHandler(app.hardKeeper)).
+		AddRoute(bep3.RouterKey, bep3.NewDeputyBondSlashProposalHandler(app.bep3Keeper))
+	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
+	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
+	app.committeeKeeper = committee.NewKeeper(
+		app.cdc,
+		keys[committee.StoreKey],
+		committeeGovRouter,
+		app.paramsKeeper,
+		app.accountKeeper,
+		app.supplyKeeper,
+	)
 
-	app.hardKeeper = *hardKeeper.SetHooks(hard.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
+	// create gov keeper with router
+	govRouter := gov.NewRouter()
+	govRouter.
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper)).
+		AddRoute(kavadist.RouterKey, kavadist.NewCommunityPoolMultiSpendProposalHandler(app.kavadistKeeper)).
+		AddRoute(hard.RouterKey, hard.NewReserveWithdrawalProposalHandler(app.hardKeeper)).
+		AddRoute(bep3.RouterKey, bep3.NewDeputyBondSlashProposalHandler(app.bep3Keeper))
+	app.govKeeper = gov.NewKeeper(
+		app.cdc,
+		keys[gov.StoreKey],
+		govSubspace,
+		app.supplyKeeper,
+		&stakingKeeper,
+		govRouter,
+	)
 
-	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	// register the token committee voting power sources
+	app.committeeKeeper = *app.committeeKeeper.SetVotingPowerSources(
+		committee.NewHardVotingPowerSource(app.hardKeeper),
+		committee.NewSwapVotingPowerSource(app.swapKeeper),
+	)
 
 	// create the module manager (Note: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.)
@@ -438,7 +460,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		validatorvesting.NewAppModule(app.vvKeeper, app.accountKeeper),
 		auction.NewAppModule(app.auctionKeeper, app.accountKeeper, app.supplyKeeper),
 		cdp.NewAppModule(app.cdpKeeper, app.accountKeeper, app.pricefeedKeeper, app.supplyKeeper),
-		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper),
+		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper, app.supplyKeeper),
 		bep3.NewAppModule(app.bep3Keeper, app.accountKeeper, app.supplyKeeper),
 		kavadist.NewAppModule(app.kavadistKeeper, app.supplyKeeper),
 		incentive.NewAppModule(app.incentiveKeeper, app.accountKeeper, app.supplyKeeper, app.cdpKeeper),
@@ -490,7 +512,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
-		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper),
+		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper, app.supplyKeeper),
 		cdp.NewAppModule(app.cdpKeeper, app.accountKeeper, app.pricefeedKeeper, app.supplyKeeper),
 		auction.NewAppModule(app.auctionKeeper, app.accountKeeper, app.supplyKeeper),
 		bep3.NewAppModule(app.bep3Keeper, app.accountKeeper, app.supplyKeeper),
@@ -514,9 +536,9 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	var antehandler sdk.AnteHandler
 	if appOpts.MempoolEnableAuth {
 		var getAuthorizedAddresses ante.AddressFetcher = func(sdk.Context) []sdk.AccAddress { return appOpts.MempoolAuthAddresses }
-		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer, app.bep3Keeper.GetAuthorizedAddresses, app.pricefeedKeeper.GetAuthorizedAddresses, getAuthorizedAddresses)
+		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer, app.committeeKeeper, app.issuanceKeeper, app.bep3Keeper.GetAuthorizedAddresses, app.pricefeedKeeper.GetAuthorizedAddresses, getAuthorizedAddresses)
 	} else {
-		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer, app.committeeKeeper, app.issuanceKeeper)
 	}
 	app.SetAnteHandler(antehandler)
 	app.SetEndBlocker(app.EndBlocker)
@@ -0,0 +1,65 @@
+package buyback
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) supplyexported.ModuleAccountI
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+}
+
+// SwapKeeper defines the expected swap keeper
+type SwapKeeper interface {
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+}
+
+// Buyback swaps tokens held by a module account for another denom and burns the tokens bought
+type Buyback struct {
+	supplyKeeper  SupplyKeeper
+	swapKeeper    SwapKeeper
+	moduleAccount string
+}
+
+// NewBuyback returns a buyback that spends and burns the coins of a module account.
+// The module account must have the burner permission.
+func NewBuyback(sk SupplyKeeper, swk SwapKeeper, moduleAccount string) Buyback {
+	return Buyback{
+		supplyKeeper:  sk,
+		swapKeeper:    swk,
+		moduleAccount: moduleAccount,
+	}
+}
+
+// BuyAndBurn swaps a coin for the buy denom and burns the tokens bought.
+// Prices are the value of one base unit of each denom, usually from the pricefeed. The swap fails if its output is
+// more than maxSlippage less than the coin's value in the buy denom at those prices.
+// A failed swap or burn leaves no state changes.
+func (b Buyback) BuyAndBurn(ctx sdk.Context, sell sdk.Coin, sellPrice sdk.Dec, buyDenom string, buyPrice sdk.Dec, maxSlippage sdk.Dec) error {
+	if !sellPrice.IsPositive() || !buyPrice.IsPositive() {
+		return fmt.Errorf("invalid prices %s %s and %s %s", sellPrice, sell.Denom, buyPrice, buyDenom)
+	}
+	expected := sdk.NewCoin(buyDenom, sell.Amount.ToDec().Mul(sellPrice).Quo(buyPrice).TruncateInt())
+	if !expected.IsPositive() {
+		return fmt.Errorf("%s buys no %s", sell, buyDenom)
+	}
+
+	// swap in a cache context so a failed swap or burn leaves no partial state
+	cacheCtx, write := ctx.CacheContext()
+	before := b.supplyKeeper.GetModuleAccount(cacheCtx, b.moduleAccount).GetCoins().AmountOf(buyDenom)
+	addr := b.supplyKeeper.GetModuleAddress(b.moduleAccount)
+	if err := b.swapKeeper.SwapExactForTokens(cacheCtx, addr, sell, expected, maxSlippage); err != nil {
+		return err
+	}
+	bought := b.supplyKeeper.GetModuleAccount(cacheCtx, b.moduleAccount).GetCoins().AmountOf(buyDenom).Sub(before)
+	if err := b.supplyKeeper.BurnCoins(cacheCtx, b.moduleAccount, sdk.NewCoins(sdk.NewCoin(buyDenom, bought))); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
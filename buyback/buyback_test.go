@@ -0,0 +1,118 @@
+package buyback_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/kava-labs/kava/buyback"
+)
+
+const moduleAccount = "buyer"
+
+// fakeKeeper holds module account balances and swaps at a fixed rate, checking slippage the same way as the swap module
+type fakeKeeper struct {
+	coins map[string]sdk.Coins
+	rate  sdk.Dec
+}
+
+func (fk *fakeKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return supply.NewModuleAddress(name)
+}
+
+func (fk *fakeKeeper) GetModuleAccount(_ sdk.Context, name string) supplyexported.ModuleAccountI {
+	macc := supply.NewEmptyModuleAccount(name, supply.Burner)
+	if err := macc.SetCoins(fk.coins[name]); err != nil {
+		panic(err)
+	}
+	return macc
+}
+
+func (fk *fakeKeeper) BurnCoins(_ sdk.Context, name string, amt sdk.Coins) error {
+	coins, negative := fk.coins[name].SafeSub(amt)
+	if negative {
+		return errors.New("insufficient funds")
+	}
+	fk.coins[name] = coins
+	return nil
+}
+
+func (fk *fakeKeeper) SwapExactForTokens(_ sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error {
+	output := sdk.NewCoin(coinB.Denom, exactCoinA.Amount.ToDec().Mul(fk.rate).TruncateInt())
+	if sdk.OneDec().Sub(output.Amount.ToDec().Quo(coinB.Amount.ToDec())).GT(slippageLimit) {
+		return errors.New("slippage exceeded")
+	}
+	fk.coins[moduleAccount] = fk.coins[moduleAccount].Sub(sdk.NewCoins(exactCoinA)).Add(output)
+	return nil
+}
+
+type BuybackTestSuite struct {
+	suite.Suite
+
+	keeper  *fakeKeeper
+	buyback buyback.Buyback
+	ctx     sdk.Context
+}
+
+func (suite *BuybackTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey("buyback")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	suite.Require().NoError(cms.LoadLatestVersion())
+
+	suite.ctx = sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+	suite.keeper = &fakeKeeper{
+		coins: map[string]sdk.Coins{moduleAccount: sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("hard", 50))},
+	}
+	suite.buyback = buyback.NewBuyback(suite.keeper, suite.keeper, moduleAccount)
+}
+
+func (suite *BuybackTestSuite) TestBuyAndBurn() {
+	// the pool pays 0.5 hard per usdx, and the pricefeed values usdx at 1 and hard at 2
+	suite.keeper.rate = sdk.MustNewDecFromStr("0.5")
+
+	err := suite.buyback.BuyAndBurn(suite.ctx, sdk.NewInt64Coin("usdx", 100), sdk.OneDec(), "hard", sdk.NewDec(2), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+	// the 50 hard bought are burned, leaving the hard held before the buyback
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 900), sdk.NewInt64Coin("hard", 50)), suite.keeper.coins[moduleAccount])
+}
+
+func (suite *BuybackTestSuite) TestBuyAndBurn_PoolMovedFromPrice() {
+	// the pool has been moved to pay 0.4 hard per usdx, 20% less than the pricefeed prices
+	suite.keeper.rate = sdk.MustNewDecFromStr("0.4")
+
+	err := suite.buyback.BuyAndBurn(suite.ctx, sdk.NewInt64Coin("usdx", 100), sdk.OneDec(), "hard", sdk.NewDec(2), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("hard", 50)), suite.keeper.coins[moduleAccount])
+
+	// a higher max slippage accepts the pool price
+	err = suite.buyback.BuyAndBurn(suite.ctx, sdk.NewInt64Coin("usdx", 100), sdk.OneDec(), "hard", sdk.NewDec(2), sdk.MustNewDecFromStr("0.2"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 900), sdk.NewInt64Coin("hard", 50)), suite.keeper.coins[moduleAccount])
+}
+
+func (suite *BuybackTestSuite) TestBuyAndBurn_InvalidPrices() {
+	suite.keeper.rate = sdk.MustNewDecFromStr("0.5")
+
+	err := suite.buyback.BuyAndBurn(suite.ctx, sdk.NewInt64Coin("usdx", 100), sdk.ZeroDec(), "hard", sdk.NewDec(2), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().Error(err)
+
+	// at these prices 1 usdx buys less than one base unit of hard
+	err = suite.buyback.BuyAndBurn(suite.ctx, sdk.NewInt64Coin("usdx", 1), sdk.OneDec(), "hard", sdk.NewDec(2), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000), sdk.NewInt64Coin("hard", 50)), suite.keeper.coins[moduleAccount])
+}
+
+func TestBuybackTestSuite(t *testing.T) {
+	suite.Run(t, new(BuybackTestSuite))
+}
@@ -0,0 +1,10 @@
+/*
+Package buyback swaps tokens held by a module account for a governance token through the swap module and burns the
+tokens bought. It is a library used by the cdp and hard modules rather than a module of its own.
+
+Buybacks run in BeginBlock, so the price of a swap pool can be moved by a transaction at the end of the previous
+block. The output a buyback expects is therefore set from pricefeed prices rather than the pool's own reserves, and
+the swap fails if it returns more than the max slippage less than that. A pool that has been moved away from the
+pricefeed price can't be used to buy at a worse rate.
+*/
+package buyback
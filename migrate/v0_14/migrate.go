@@ -169,7 +169,7 @@ func CDP(oldGenState v0_11cdp.GenesisState) v0_14cdp.GenesisState {
 
 	newGlobalDebtLimit := oldGenState.Params.GlobalDebtLimit
 
-	newParams := v0_14cdp.NewParams(newGlobalDebtLimit, newCollateralParams, newDebtParam, oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot, oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot, false)
+	newParams := v0_14cdp.NewParams(newGlobalDebtLimit, newCollateralParams, newDebtParam, oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot, oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot, false, v0_14cdp.DefaultSurplusPolicy)
 
 	return v0_14cdp.NewGenesisState(
 		newParams,
@@ -242,6 +242,7 @@ func Hard(genesisState v0_11hard.GenesisState) v0_14hard.GenesisState {
 			),
 		},
 		sdk.MustNewDecFromStr("10.0"),
+		v0_14hard.DefaultReserveDistributions, v0_14hard.DefaultReservePolicy, v0_14hard.DefaultSocializeBadDebt,
 	)
 
 	for _, newDep := range v13DepositorMap {
@@ -257,7 +258,7 @@ func Hard(genesisState v0_11hard.GenesisState) v0_14hard.GenesisState {
 		v13GenesisAccumulationTimes = append(v13GenesisAccumulationTimes, genAccumulationTime)
 	}
 
-	return v0_14hard.NewGenesisState(newParams, v13GenesisAccumulationTimes, v13Deposits, v0_14hard.DefaultBorrows, v13TotalSupplied, v0_14hard.DefaultTotalBorrowed, v0_14hard.DefaultTotalReserves)
+	return v0_14hard.NewGenesisState(newParams, v13GenesisAccumulationTimes, v13Deposits, v0_14hard.DefaultBorrows, v13TotalSupplied, v0_14hard.DefaultTotalBorrowed, v0_14hard.DefaultTotalReserves, v0_14hard.DefaultTotalBadDebt)
 }
 
 // Incentive migrates from a v0.11 incentive genesis state to a v0.13 incentive genesis state
@@ -528,7 +529,7 @@ func Bep3(genesisState bep3.GenesisState) bep3.GenesisState {
 		newAssetParams = append(newAssetParams, ap)
 	}
 	newParams := bep3.NewParams(newAssetParams)
-	return bep3.NewGenesisState(newParams, newSwaps, newSupplies, genesisState.PreviousBlockTime)
+	return bep3.NewGenesisState(newParams, newSwaps, newSupplies, nil, genesisState.PreviousBlockTime, bep3.DeputyBonds{}, bep3.SwapFees{}, bep3.CollectedFeesList{})
 }
 
 // Committee migrates from a v0.11 (or v0.12) committee genesis state to a v0.13 committee genesis state
@@ -686,7 +687,10 @@ func Pricefeed(genesisState v0_11pricefeed.GenesisState) v0_14pricefeed.GenesisS
 		}
 	}
 
-	return v0_14pricefeed.NewGenesisState(v0_14pricefeed.NewParams(newMarkets), newPrices)
+	return v0_14pricefeed.NewGenesisState(
+		v0_14pricefeed.NewParams(newMarkets, v0_14pricefeed.DefaultParams().PerformanceParams, v0_14pricefeed.DefaultPriceHistoryLength), newPrices,
+		v0_14pricefeed.OraclePerformances{}, v0_14pricefeed.OracleBonds{}, v0_14pricefeed.PriceCommitments{}, v0_14pricefeed.PriceSnapshots{},
+	)
 }
 
 func removeIndex(accs authexported.GenesisAccounts, index int) authexported.GenesisAccounts {
@@ -2,12 +2,15 @@ package v0_15
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/kava-labs/kava/ratelimit"
 	v0_15bep3 "github.com/kava-labs/kava/x/bep3/types"
 )
 
 // Bep3 resets the swap expire/close heights for a chain starting at height 0.
-func Bep3(genesisState v0_15bep3.GenesisState) v0_15bep3.GenesisState {
+// Each asset's time-limited supply is recorded in its rate limit window at genesis time.
+func Bep3(genesisState v0_15bep3.GenesisState, genesisTime time.Time) v0_15bep3.GenesisState {
 
 	var newSwaps v0_15bep3.AtomicSwaps
 	for _, swap := range genesisState.AtomicSwaps {
@@ -30,5 +33,13 @@ func Bep3(genesisState v0_15bep3.GenesisState) v0_15bep3.GenesisState {
 
 	genesisState.AtomicSwaps = newSwaps
 
+	var buckets ratelimit.Buckets
+	for _, supply := range genesisState.Supplies {
+		if supply.TimeLimitedCurrentSupply.IsPositive() {
+			buckets = append(buckets, ratelimit.NewBucket(supply.GetDenom(), genesisTime, supply.TimeLimitedCurrentSupply.Amount))
+		}
+	}
+	genesisState.RateLimitBuckets = buckets
+
 	return genesisState
 }
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/bep3"
 )
 
@@ -39,7 +40,11 @@ var (
 		exampleBep3Params,
 		bep3.AtomicSwaps{},
 		exampleAssetSupplies,
+		nil,
 		exampleExportTime,
+		bep3.DeputyBonds{},
+		bep3.SwapFees{},
+		bep3.CollectedFeesList{},
 	)
 )
 
@@ -70,10 +75,14 @@ func TestBep3_SwapHeightsAreReset(t *testing.T) {
 			exampleBep3Swap(2e5, 1e5, bep3.Completed),
 		},
 		exampleAssetSupplies,
+		nil,
 		exampleExportTime,
+		bep3.DeputyBonds{},
+		bep3.SwapFees{},
+		bep3.CollectedFeesList{},
 	)
 
-	newState := Bep3(oldState)
+	newState := Bep3(oldState, GenesisTime)
 
 	expectedSwaps := bep3.AtomicSwaps{
 		exampleBep3Swap(1, 6e5, bep3.Expired),
@@ -90,10 +99,41 @@ func TestBep3_OnlySwapHeightsModified(t *testing.T) {
 		exampleBep3Params,
 		nil,
 		exampleAssetSupplies,
+		nil,
 		exampleExportTime,
+		bep3.DeputyBonds{},
+		bep3.SwapFees{},
+		bep3.CollectedFeesList{},
 	)
 
-	newState := Bep3(oldState)
+	newState := Bep3(oldState, GenesisTime)
 
 	require.Equal(t, oldState, newState)
 }
+
+func TestBep3_TimeLimitedSupplyRecordedInRateLimitWindow(t *testing.T) {
+	supplies := bep3.AssetSupplies{
+		bep3.NewAssetSupply(
+			sdk.NewInt64Coin("xrpb", 1e10),
+			sdk.NewInt64Coin("xrpb", 1e9),
+			sdk.NewInt64Coin("xrpb", 1e15),
+			sdk.NewInt64Coin("xrpb", 1e8),
+			0,
+		),
+	}
+	oldState := bep3.NewGenesisState(
+		exampleBep3Params,
+		nil,
+		supplies,
+		nil,
+		exampleExportTime,
+		bep3.DeputyBonds{},
+		bep3.SwapFees{},
+		bep3.CollectedFeesList{},
+	)
+
+	newState := Bep3(oldState, GenesisTime)
+
+	require.Equal(t, ratelimit.Buckets{ratelimit.NewBucket("xrpb", GenesisTime, sdk.NewInt(1e8))}, newState.RateLimitBuckets)
+	require.Equal(t, supplies, newState.Supplies)
+}
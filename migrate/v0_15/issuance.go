@@ -0,0 +1,44 @@
+package v0_15
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/ratelimit"
+	v0_13issuance "github.com/kava-labs/kava/x/issuance/legacy/v0_13"
+	v0_15issuance "github.com/kava-labs/kava/x/issuance/types"
+)
+
+// Issuance migrates the issuance genesis state. Block lists move out of asset params into the genesis block lists,
+// which are loaded into the module store, and no asset starts in allowlist mode. Supply issued in the current rate limit
+// period is recorded in the asset's rate limit window at genesis time.
+func Issuance(genesisState v0_13issuance.GenesisState, genesisTime time.Time) v0_15issuance.GenesisState {
+	assets := v0_15issuance.Assets{}
+	blockedAddresses := v0_15issuance.AddressLists{}
+	for _, asset := range genesisState.Params.Assets {
+		rateLimit := v0_15issuance.NewRateLimit(asset.RateLimit.Active, asset.RateLimit.Limit, asset.RateLimit.TimePeriod)
+		assets = append(assets, v0_15issuance.NewAsset(asset.Owner, asset.Denom, asset.Paused, asset.Blockable, false, rateLimit))
+		if len(asset.BlockedAddresses) > 0 {
+			blockedAddresses = append(blockedAddresses, v0_15issuance.NewAddressList(asset.Denom, asset.BlockedAddresses))
+		}
+	}
+
+	supplies := v0_15issuance.AssetSupplies{}
+	buckets := ratelimit.Buckets{}
+	for _, supply := range genesisState.Supplies {
+		supplies = append(supplies, v0_15issuance.NewAssetSupply(supply.CurrentSupply, supply.TimeElapsed))
+		if supply.CurrentSupply.IsPositive() {
+			buckets = append(buckets, ratelimit.NewBucket(supply.GetDenom(), genesisTime, supply.CurrentSupply.Amount))
+		}
+	}
+
+	return v0_15issuance.NewGenesisState(
+		v0_15issuance.NewParams(assets),
+		supplies,
+		buckets,
+		v0_15issuance.RoleHolders{},
+		v0_15issuance.AssetOwners{},
+		v0_15issuance.PendingOwners{},
+		blockedAddresses,
+		v0_15issuance.AddressLists{},
+	)
+}
@@ -0,0 +1,42 @@
+package v0_15
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/ratelimit"
+	v0_13issuance "github.com/kava-labs/kava/x/issuance/legacy/v0_13"
+	v0_15issuance "github.com/kava-labs/kava/x/issuance/types"
+)
+
+func TestIssuance_BlockListsMovedFromParams(t *testing.T) {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("IssuanceOwner")))
+	blocked := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("BlockedUser1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("BlockedUser2"))),
+	}
+	rateLimit := v0_13issuance.NewRateLimit(true, sdk.NewInt(1e10), time.Hour*24)
+	oldState := v0_13issuance.NewGenesisState(
+		v0_13issuance.NewParams(v0_13issuance.Assets{
+			v0_13issuance.NewAsset(owner, "usdtoken", blocked, false, true, rateLimit),
+			v0_13issuance.NewAsset(owner, "pegtoken", []sdk.AccAddress{}, true, false, v0_13issuance.NewRateLimit(false, sdk.ZeroInt(), 0)),
+		}),
+		v0_13issuance.AssetSupplies{v0_13issuance.NewAssetSupply(sdk.NewInt64Coin("usdtoken", 1000), time.Hour)},
+	)
+
+	newState := Issuance(oldState, GenesisTime)
+	require.NoError(t, newState.Validate())
+
+	require.Equal(t, v0_15issuance.NewParams(v0_15issuance.Assets{
+		v0_15issuance.NewAsset(owner, "usdtoken", false, true, false, v0_15issuance.NewRateLimit(true, sdk.NewInt(1e10), time.Hour*24)),
+		v0_15issuance.NewAsset(owner, "pegtoken", true, false, false, v0_15issuance.NewRateLimit(false, sdk.ZeroInt(), 0)),
+	}), newState.Params)
+	require.Equal(t, v0_15issuance.AddressLists{v0_15issuance.NewAddressList("usdtoken", blocked)}, newState.BlockedAddresses)
+	require.Empty(t, newState.AllowedAddresses)
+	require.Equal(t, v0_15issuance.AssetSupplies{v0_15issuance.NewAssetSupply(sdk.NewInt64Coin("usdtoken", 1000), time.Hour)}, newState.Supplies)
+	require.Equal(t, ratelimit.Buckets{ratelimit.NewBucket("usdtoken", GenesisTime, sdk.NewInt(1000))}, newState.RateLimitBuckets)
+}
@@ -0,0 +1,28 @@
+package v0_15
+
+import (
+	v0_13kavadist "github.com/kava-labs/kava/x/kavadist/legacy/v0_13"
+	v0_15kavadist "github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// Kavadist migrates the single kavadist inflation schedule to a list of inflation schedules.
+// The old periods become a schedule for the gov denom without recipients, so minted coins stay in the kavadist module account.
+func Kavadist(genesisState v0_13kavadist.GenesisState) v0_15kavadist.GenesisState {
+	schedules := v0_15kavadist.InflationSchedules{}
+	if len(genesisState.Params.Periods) > 0 {
+		var periods v0_15kavadist.Periods
+		for _, period := range genesisState.Params.Periods {
+			periods = append(periods, v0_15kavadist.NewPeriod(period.Start, period.End, period.Inflation))
+		}
+		schedules = append(schedules, v0_15kavadist.NewInflationSchedule(
+			v0_15kavadist.GovDenom, periods, v0_15kavadist.InflationRecipients{},
+		))
+	}
+
+	return v0_15kavadist.NewGenesisState(
+		v0_15kavadist.NewParams(genesisState.Params.Active, schedules),
+		genesisState.PreviousBlockTime,
+		v0_15kavadist.SpendStreams{},
+		v0_15kavadist.DefaultNextStreamID,
+	)
+}
@@ -0,0 +1,55 @@
+package v0_15
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v0_13kavadist "github.com/kava-labs/kava/x/kavadist/legacy/v0_13"
+	v0_15kavadist "github.com/kava-labs/kava/x/kavadist/types"
+)
+
+func TestKavadist_PeriodsMigratedToSchedule(t *testing.T) {
+	periods := v0_13kavadist.Periods{
+		v0_13kavadist.NewPeriod(
+			time.Date(2020, time.March, 1, 1, 0, 0, 0, time.UTC),
+			time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC),
+			sdk.MustNewDecFromStr("1.000000003022265980"),
+		),
+		v0_13kavadist.NewPeriod(
+			time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC),
+			time.Date(2022, time.March, 1, 1, 0, 0, 0, time.UTC),
+			sdk.MustNewDecFromStr("1.000000001547125958"),
+		),
+	}
+	oldState := v0_13kavadist.NewGenesisState(v0_13kavadist.NewParams(true, periods), exampleExportTime)
+
+	newState := Kavadist(oldState)
+	require.NoError(t, newState.Validate())
+
+	require.True(t, newState.Params.Active)
+	require.Equal(t, exampleExportTime, newState.PreviousBlockTime)
+	require.Equal(t, v0_15kavadist.DefaultNextStreamID, newState.NextStreamID)
+	require.Len(t, newState.Params.Schedules, 1)
+	schedule := newState.Params.Schedules[0]
+	require.Equal(t, v0_15kavadist.GovDenom, schedule.Denom)
+	require.Empty(t, schedule.Recipients)
+	require.Len(t, schedule.Periods, len(periods))
+	for i, period := range periods {
+		require.Equal(t, period.Start, schedule.Periods[i].Start)
+		require.Equal(t, period.End, schedule.Periods[i].End)
+		require.Equal(t, period.Inflation, schedule.Periods[i].Inflation)
+	}
+}
+
+func TestKavadist_NoPeriods(t *testing.T) {
+	oldState := v0_13kavadist.NewGenesisState(v0_13kavadist.NewParams(false, v0_13kavadist.Periods{}), exampleExportTime)
+
+	newState := Kavadist(oldState)
+	require.NoError(t, newState.Validate())
+
+	require.False(t, newState.Params.Active)
+	require.Empty(t, newState.Params.Schedules)
+}
@@ -22,7 +22,11 @@ import (
 	v0_15hard "github.com/kava-labs/kava/x/hard/types"
 	v0_14incentive "github.com/kava-labs/kava/x/incentive/legacy/v0_14"
 	v0_15incentive "github.com/kava-labs/kava/x/incentive/types"
+	v0_13issuance "github.com/kava-labs/kava/x/issuance/legacy/v0_13"
+	v0_15issuance "github.com/kava-labs/kava/x/issuance/types"
 	"github.com/kava-labs/kava/x/kavadist"
+	v0_13kavadist "github.com/kava-labs/kava/x/kavadist/legacy/v0_13"
+	v0_15kavadist "github.com/kava-labs/kava/x/kavadist/types"
 	v0_15swap "github.com/kava-labs/kava/x/swap/types"
 	v0_14validator_vesting "github.com/kava-labs/kava/x/validator-vesting"
 )
@@ -121,7 +125,27 @@ func MigrateAppState(v0_14AppState genutil.AppMap) {
 		v0_14Codec.MustUnmarshalJSON(v0_14AppState[v0_15bep3.ModuleName], &bep3GenState)
 		delete(v0_14AppState, v0_15bep3.ModuleName)
 
-		v0_14AppState[v0_15bep3.ModuleName] = v0_15Codec.MustMarshalJSON(Bep3(bep3GenState))
+		v0_14AppState[v0_15bep3.ModuleName] = v0_15Codec.MustMarshalJSON(Bep3(bep3GenState, GenesisTime))
+	}
+
+	// Migrate kavadist app state
+	if v0_14AppState[v0_15kavadist.ModuleName] != nil {
+		// Unmarshal genesis state and delete it. The genesis format was not changed between v0.13 and v0.14.
+		var kavadistGenState v0_13kavadist.GenesisState
+		v0_14Codec.MustUnmarshalJSON(v0_14AppState[v0_15kavadist.ModuleName], &kavadistGenState)
+		delete(v0_14AppState, v0_15kavadist.ModuleName)
+
+		v0_14AppState[v0_15kavadist.ModuleName] = v0_15Codec.MustMarshalJSON(Kavadist(kavadistGenState))
+	}
+
+	// Migrate issuance app state
+	if v0_14AppState[v0_15issuance.ModuleName] != nil {
+		// Unmarshal genesis state and delete it. The genesis format was not changed between v0.13 and v0.14.
+		var issuanceGenState v0_13issuance.GenesisState
+		v0_14Codec.MustUnmarshalJSON(v0_14AppState[v0_15issuance.ModuleName], &issuanceGenState)
+		delete(v0_14AppState, v0_15issuance.ModuleName)
+
+		v0_14AppState[v0_15issuance.ModuleName] = v0_15Codec.MustMarshalJSON(Issuance(issuanceGenState, GenesisTime))
 	}
 
 	v0_14AppState[v0_15swap.ModuleName] = v0_15Codec.MustMarshalJSON(Swap())
@@ -360,7 +384,14 @@ func Committee(genesisState v0_14committee.GenesisState) v0_15committee.GenesisS
 					// update AllowedAssetParams
 					var newAssetParams v0_15committee.AllowedAssetParams
 					for _, ap := range subPerm.AllowedAssetParams {
-						newAP := v0_15committee.AllowedAssetParam(ap)
+						newAP := v0_15committee.AllowedAssetParam{
+							Denom:         ap.Denom,
+							CoinID:        ap.CoinID,
+							Limit:         ap.Limit,
+							Active:        ap.Active,
+							MaxSwapAmount: ap.MaxSwapAmount,
+							MinBlockLock:  ap.MinBlockLock,
+						}
 						newAssetParams = append(newAssetParams, newAP)
 					}
 					newStabilitySubParamPermissions.AllowedAssetParams = newAssetParams
@@ -368,7 +399,13 @@ func Committee(genesisState v0_14committee.GenesisState) v0_15committee.GenesisS
 					// Update Allowed Markets
 					var newMarketParams v0_15committee.AllowedMarkets
 					for _, mp := range subPerm.AllowedMarkets {
-						newMP := v0_15committee.AllowedMarket(mp)
+						newMP := v0_15committee.AllowedMarket{
+							MarketID:   mp.MarketID,
+							BaseAsset:  mp.BaseAsset,
+							QuoteAsset: mp.QuoteAsset,
+							Oracles:    mp.Oracles,
+							Active:     mp.Active,
+						}
 						newMarketParams = append(newMarketParams, newMP)
 					}
 					newStabilitySubParamPermissions.AllowedMarkets = newMarketParams
@@ -483,7 +520,7 @@ func Committee(genesisState v0_14committee.GenesisState) v0_15committee.GenesisS
 		proposals = append(proposals, newProp)
 	}
 	return v0_15committee.NewGenesisState(
-		genesisState.NextProposalID, committees, proposals, votes)
+		genesisState.NextProposalID, committees, proposals, votes, v0_15committee.QueuedProposals{}, v0_15committee.VoteDelegations{}, v0_15committee.Pauses{})
 }
 
 func loadStabilityComMembers() ([]sdk.AccAddress, error) {
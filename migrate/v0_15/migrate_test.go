@@ -744,7 +744,7 @@ func TestBep3_Full(t *testing.T) {
 	var oldGenState v0_15bep3.GenesisState
 	cdc.MustUnmarshalJSON(oldState[v0_15bep3.ModuleName], &oldGenState)
 
-	newGenState := Bep3(oldGenState)
+	newGenState := Bep3(oldGenState, GenesisTime)
 
 	require.NoError(t, newGenState.Validate())
 
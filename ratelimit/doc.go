@@ -0,0 +1,14 @@
+/*
+Package ratelimit limits the net issuance of assets over a rolling window of time.
+It is a library used by the issuance and bep3 modules rather than a module of its own.
+
+Modules previously limited issuance over fixed time periods, resetting the issued amount when each period ended.
+An account able to issue tokens could issue the full limit just before and again just after a reset.
+A Window instead records the amount issued at each block time, and issuance only stops counting against
+the limit once the full period has passed since the block it was issued in. This bounds the amount issued
+over any span of time of the limit's length.
+
+Redeemed tokens, or tokens that leave the chain, cancel the most recent issuance in the window. An amount greater
+than the issuance in the window doesn't create extra capacity.
+*/
+package ratelimit
@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Bucket is the amount of an asset issued at a block time
+type Bucket struct {
+	Denom  string    `json:"denom" yaml:"denom"`
+	Time   time.Time `json:"time" yaml:"time"`
+	Amount sdk.Int   `json:"amount" yaml:"amount"`
+}
+
+// NewBucket returns a new Bucket
+func NewBucket(denom string, blockTime time.Time, amount sdk.Int) Bucket {
+	return Bucket{
+		Denom:  denom,
+		Time:   blockTime,
+		Amount: amount,
+	}
+}
+
+// String implements fmt.Stringer
+func (b Bucket) String() string {
+	return fmt.Sprintf(`Bucket:
+	Denom: %s
+	Time: %s
+	Amount: %s
+	`, b.Denom, b.Time, b.Amount)
+}
+
+// Validate performs a basic validation of bucket fields
+func (b Bucket) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return err
+	}
+	if b.Amount.IsNil() || !b.Amount.IsPositive() {
+		return fmt.Errorf("bucket amount must be positive: %s %s", b.Denom, b.Time)
+	}
+	return nil
+}
+
+// Buckets is a slice of Bucket
+type Buckets []Bucket
+
+// Validate checks each bucket and that no asset has more than one bucket for a block time
+func (bs Buckets) Validate() error {
+	seen := make(map[string]bool)
+	for _, b := range bs {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		key := string(BucketKey(b.Denom, b.Time))
+		if seen[key] {
+			return fmt.Errorf("duplicate bucket for %s at %s", b.Denom, b.Time)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// AmountOf returns the total amount of an asset's buckets
+func (bs Buckets) AmountOf(denom string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, b := range bs {
+		if b.Denom == denom {
+			total = total.Add(b.Amount)
+		}
+	}
+	return total
+}
+
+// Capacity is an asset's issuance over the window of its rate limit, and the amount that can still be issued
+type Capacity struct {
+	Denom     string        `json:"denom" yaml:"denom"`
+	Limit     sdk.Int       `json:"limit" yaml:"limit"`
+	Period    time.Duration `json:"period" yaml:"period"`
+	Issued    sdk.Int       `json:"issued" yaml:"issued"`
+	Remaining sdk.Int       `json:"remaining" yaml:"remaining"`
+}
+
+// NewCapacity returns the capacity of an asset that has issued an amount over the window of its rate limit
+func NewCapacity(denom string, limit sdk.Int, period time.Duration, issued sdk.Int) Capacity {
+	remaining := limit.Sub(issued)
+	if remaining.IsNegative() {
+		remaining = sdk.ZeroInt()
+	}
+	return Capacity{
+		Denom:     denom,
+		Limit:     limit,
+		Period:    period,
+		Issued:    issued,
+		Remaining: remaining,
+	}
+}
+
+// String implements fmt.Stringer
+func (c Capacity) String() string {
+	return fmt.Sprintf(`Capacity:
+	Denom: %s
+	Limit: %s
+	Period: %s
+	Issued: %s
+	Remaining: %s
+	`, c.Denom, c.Limit, c.Period, c.Issued, c.Remaining)
+}
+
+// Capacities is a slice of Capacity
+type Capacities []Capacity
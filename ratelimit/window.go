@@ -0,0 +1,186 @@
+package ratelimit
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var sep = []byte{0x00}
+
+// Window records the amount of each asset issued at each block time, so that issuance can be limited over a rolling period.
+// Issuance leaves the window once the period has elapsed since the block it was recorded in.
+// The running total of each asset's issuance in the window is kept by the module using the window.
+type Window struct {
+	key    sdk.StoreKey
+	cdc    *codec.Codec
+	prefix []byte
+}
+
+// NewWindow returns a window that stores its buckets under a prefix of a module's store
+func NewWindow(cdc *codec.Codec, key sdk.StoreKey, prefix []byte) Window {
+	return Window{
+		key:    key,
+		cdc:    cdc,
+		prefix: prefix,
+	}
+}
+
+// Record adds an amount of an asset issued at the current block time
+func (w Window) Record(ctx sdk.Context, denom string, amount sdk.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	w.SetBucket(ctx, NewBucket(denom, ctx.BlockTime(), w.getAmount(ctx, denom, ctx.BlockTime()).Add(amount)))
+}
+
+// Cancel removes an amount from an asset's most recent buckets, when issued tokens are redeemed or leave the chain.
+// It returns the amount removed, which is less than the input amount if less was issued in the window.
+func (w Window) Cancel(ctx sdk.Context, denom string, amount sdk.Int) sdk.Int {
+	var buckets Buckets
+	collected := sdk.ZeroInt()
+	w.iterateBuckets(ctx, denom, true, func(bucket Bucket) bool {
+		buckets = append(buckets, bucket)
+		collected = collected.Add(bucket.Amount)
+		return collected.GTE(amount)
+	})
+	removed := sdk.ZeroInt()
+	for _, bucket := range buckets {
+		remaining := amount.Sub(removed)
+		if remaining.IsZero() {
+			break
+		}
+		if bucket.Amount.LTE(remaining) {
+			w.deleteBucket(ctx, bucket)
+			removed = removed.Add(bucket.Amount)
+			continue
+		}
+		bucket.Amount = bucket.Amount.Sub(remaining)
+		w.SetBucket(ctx, bucket)
+		removed = removed.Add(remaining)
+	}
+	return removed
+}
+
+// Prune removes an asset's buckets that are outside of a window of the given period ending at the current block time.
+// It returns the total amount removed.
+func (w Window) Prune(ctx sdk.Context, denom string, period time.Duration) sdk.Int {
+	cutoff := ctx.BlockTime().Add(-period)
+	pruned := sdk.ZeroInt()
+	var expired Buckets
+	w.iterateBuckets(ctx, denom, false, func(bucket Bucket) bool {
+		if bucket.Time.After(cutoff) {
+			return true
+		}
+		expired = append(expired, bucket)
+		return false
+	})
+	for _, bucket := range expired {
+		w.deleteBucket(ctx, bucket)
+		pruned = pruned.Add(bucket.Amount)
+	}
+	return pruned
+}
+
+// Clear removes all of an asset's buckets
+func (w Window) Clear(ctx sdk.Context, denom string) {
+	for _, bucket := range w.GetBuckets(ctx, denom) {
+		w.deleteBucket(ctx, bucket)
+	}
+}
+
+// GetBuckets returns an asset's buckets, oldest first
+func (w Window) GetBuckets(ctx sdk.Context, denom string) (buckets Buckets) {
+	w.iterateBuckets(ctx, denom, false, func(bucket Bucket) bool {
+		buckets = append(buckets, bucket)
+		return false
+	})
+	return
+}
+
+// GetAllBuckets returns the buckets of all assets, ordered by denom then block time
+func (w Window) GetAllBuckets(ctx sdk.Context) (buckets Buckets) {
+	store := prefix.NewStore(ctx.KVStore(w.key), w.prefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom, blockTime := parseBucketKey(iterator.Key())
+		var amount sdk.Int
+		w.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		buckets = append(buckets, NewBucket(denom, blockTime, amount))
+	}
+	return
+}
+
+// SetBucket stores a bucket, replacing the asset's bucket for the same block time.
+// Modules use it to import their window from genesis.
+func (w Window) SetBucket(ctx sdk.Context, bucket Bucket) {
+	store := prefix.NewStore(ctx.KVStore(w.key), w.prefix)
+	store.Set(BucketKey(bucket.Denom, bucket.Time), w.cdc.MustMarshalBinaryBare(bucket.Amount))
+}
+
+func (w Window) getAmount(ctx sdk.Context, denom string, blockTime time.Time) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(w.key), w.prefix)
+	bz := store.Get(BucketKey(denom, blockTime))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	w.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return amount
+}
+
+func (w Window) deleteBucket(ctx sdk.Context, bucket Bucket) {
+	store := prefix.NewStore(ctx.KVStore(w.key), w.prefix)
+	store.Delete(BucketKey(bucket.Denom, bucket.Time))
+}
+
+func (w Window) iterateBuckets(ctx sdk.Context, denom string, reverse bool, cb func(bucket Bucket) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(w.key), w.prefix)
+	keyPrefix := BucketsKey(denom)
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = sdk.KVStoreReversePrefixIterator(store, keyPrefix)
+	} else {
+		iterator = sdk.KVStorePrefixIterator(store, keyPrefix)
+	}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		blockTime, err := sdk.ParseTimeBytes(iterator.Key()[len(keyPrefix):])
+		if err != nil {
+			panic(err)
+		}
+		var amount sdk.Int
+		w.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+
+		if cb(NewBucket(denom, blockTime, amount)) {
+			break
+		}
+	}
+}
+
+// BucketKey returns the store key of the bucket for an asset and block time, relative to the window's prefix.
+// Keys are ordered by denom then block time, so an asset's buckets are iterated oldest first.
+func BucketKey(denom string, blockTime time.Time) []byte {
+	return append(BucketsKey(denom), sdk.FormatTimeBytes(blockTime)...)
+}
+
+// BucketsKey returns the key prefix for an asset's buckets
+func BucketsKey(denom string) []byte {
+	return append([]byte(denom), sep...)
+}
+
+// parseBucketKey returns the denom and block time of a bucket's store key
+func parseBucketKey(key []byte) (string, time.Time) {
+	i := bytes.IndexByte(key, sep[0])
+	blockTime, err := sdk.ParseTimeBytes(key[i+len(sep):])
+	if err != nil {
+		panic(err)
+	}
+	return string(key[:i]), blockTime
+}
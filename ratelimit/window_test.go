@@ -0,0 +1,119 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/kava-labs/kava/ratelimit"
+)
+
+type WindowTestSuite struct {
+	suite.Suite
+
+	window    ratelimit.Window
+	ctx       sdk.Context
+	blockTime time.Time
+}
+
+func (suite *WindowTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey("ratelimit")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	suite.Require().NoError(cms.LoadLatestVersion())
+
+	suite.blockTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = sdk.NewContext(cms, abci.Header{Time: suite.blockTime}, false, log.NewNopLogger())
+	suite.window = ratelimit.NewWindow(codec.New(), key, []byte{0x01})
+}
+
+func (suite *WindowTestSuite) atTime(offset time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.blockTime.Add(offset))
+}
+
+func (suite *WindowTestSuite) TestRecord() {
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(10))
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(5))
+	suite.window.Record(suite.ctx, "usdx", sdk.ZeroInt())
+	suite.window.Record(suite.ctx, "bnb", sdk.NewInt(7))
+	suite.atTime(time.Minute)
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(1))
+
+	suite.Require().Equal(ratelimit.Buckets{
+		ratelimit.NewBucket("usdx", suite.blockTime, sdk.NewInt(15)),
+		ratelimit.NewBucket("usdx", suite.blockTime.Add(time.Minute), sdk.NewInt(1)),
+	}, suite.window.GetBuckets(suite.ctx, "usdx"))
+}
+
+func (suite *WindowTestSuite) TestCancel() {
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(10))
+	suite.atTime(time.Minute)
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(5))
+
+	// the most recent issuance is cancelled first
+	suite.Require().Equal(sdk.NewInt(7), suite.window.Cancel(suite.ctx, "usdx", sdk.NewInt(7)))
+	suite.Require().Equal(ratelimit.Buckets{
+		ratelimit.NewBucket("usdx", suite.blockTime, sdk.NewInt(8)),
+	}, suite.window.GetBuckets(suite.ctx, "usdx"))
+
+	// no more than the issuance in the window is cancelled
+	suite.Require().Equal(sdk.NewInt(8), suite.window.Cancel(suite.ctx, "usdx", sdk.NewInt(20)))
+	suite.Require().Empty(suite.window.GetBuckets(suite.ctx, "usdx"))
+	suite.Require().Equal(sdk.ZeroInt(), suite.window.Cancel(suite.ctx, "usdx", sdk.NewInt(1)))
+}
+
+func (suite *WindowTestSuite) TestPrune() {
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(10))
+	suite.atTime(time.Minute)
+	suite.window.Record(suite.ctx, "usdx", sdk.NewInt(5))
+	suite.window.Record(suite.ctx, "bnb", sdk.NewInt(7))
+
+	suite.atTime(time.Hour - time.Second)
+	suite.Require().Equal(sdk.ZeroInt(), suite.window.Prune(suite.ctx, "usdx", time.Hour))
+	suite.atTime(time.Hour)
+	suite.Require().Equal(sdk.NewInt(10), suite.window.Prune(suite.ctx, "usdx", time.Hour))
+	suite.Require().Equal(ratelimit.Buckets{
+		ratelimit.NewBucket("usdx", suite.blockTime.Add(time.Minute), sdk.NewInt(5)),
+	}, suite.window.GetBuckets(suite.ctx, "usdx"))
+	suite.Require().Len(suite.window.GetBuckets(suite.ctx, "bnb"), 1)
+
+	suite.window.Clear(suite.ctx, "usdx")
+	suite.Require().Empty(suite.window.GetBuckets(suite.ctx, "usdx"))
+}
+
+func (suite *WindowTestSuite) TestGetSetAllBuckets() {
+	buckets := ratelimit.Buckets{
+		ratelimit.NewBucket("bnb", suite.blockTime, sdk.NewInt(7)),
+		ratelimit.NewBucket("usdx", suite.blockTime, sdk.NewInt(15)),
+		ratelimit.NewBucket("usdx", suite.blockTime.Add(time.Minute), sdk.NewInt(1)),
+	}
+	suite.Require().NoError(buckets.Validate())
+	for _, bucket := range buckets {
+		suite.window.SetBucket(suite.ctx, bucket)
+	}
+	suite.Require().Equal(buckets, suite.window.GetAllBuckets(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(16), buckets.AmountOf("usdx"))
+
+	// imported buckets are pruned and cancelled like recorded ones
+	suite.atTime(time.Hour)
+	suite.Require().Equal(sdk.NewInt(15), suite.window.Prune(suite.ctx, "usdx", time.Hour))
+	suite.Require().Equal(sdk.NewInt(7), suite.window.Cancel(suite.ctx, "bnb", sdk.NewInt(10)))
+	suite.Require().Equal(buckets[2:], suite.window.GetAllBuckets(suite.ctx))
+
+	duplicate := append(buckets, ratelimit.NewBucket("usdx", suite.blockTime, sdk.NewInt(3)))
+	suite.Require().Error(duplicate.Validate())
+	suite.Require().Error(ratelimit.Buckets{ratelimit.NewBucket("usdx", suite.blockTime, sdk.ZeroInt())}.Validate())
+}
+
+func TestWindowTestSuite(t *testing.T) {
+	suite.Run(t, new(WindowTestSuite))
+}
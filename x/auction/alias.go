@@ -56,6 +56,7 @@ var (
 	NewDebtAuction           = types.NewDebtAuction
 	NewGenesisState          = types.NewGenesisState
 	NewMsgPlaceBid           = types.NewMsgPlaceBid
+	NewMultiAuctionHooks     = types.NewMultiAuctionHooks
 	NewParams                = types.NewParams
 	NewQueryAllAuctionParams = types.NewQueryAllAuctionParams
 	NewQueryAuctionParams    = types.NewQueryAuctionParams
@@ -94,6 +95,7 @@ var (
 type (
 	Keeper                = keeper.Keeper
 	Auction               = types.Auction
+	AuctionHooks          = types.AuctionHooks
 	AuctionWithPhase      = types.AuctionWithPhase
 	Auctions              = types.Auctions
 	BaseAuction           = types.BaseAuction
@@ -103,6 +105,7 @@ type (
 	GenesisAuctions       = types.GenesisAuctions
 	GenesisState          = types.GenesisState
 	MsgPlaceBid           = types.MsgPlaceBid
+	MultiAuctionHooks     = types.MultiAuctionHooks
 	Params                = types.Params
 	QueryAllAuctionParams = types.QueryAllAuctionParams
 	QueryAuctionParams    = types.QueryAuctionParams
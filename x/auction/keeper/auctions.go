@@ -485,6 +485,10 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 			sdk.NewAttribute(types.AttributeKeyCloseBlock, fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
+
+	if auc, ok := auction.(types.CollateralAuction); ok {
+		k.AfterCollateralAuctionClosed(ctx, auc)
+	}
 	return nil
 }
 
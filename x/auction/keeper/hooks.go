@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// Implements AuctionHooks interface
+var _ types.AuctionHooks = Keeper{}
+
+// AfterCollateralAuctionClosed - call hook if registered
+func (k Keeper) AfterCollateralAuctionClosed(ctx sdk.Context, auction types.CollateralAuction) {
+	if k.hooks != nil {
+		k.hooks.AfterCollateralAuctionClosed(ctx, auction)
+	}
+}
@@ -19,6 +19,7 @@ type Keeper struct {
 	storeKey      sdk.StoreKey
 	cdc           *codec.Codec
 	paramSubspace subspace.Subspace
+	hooks         types.AuctionHooks
 }
 
 // NewKeeper returns a new auction keeper.
@@ -39,6 +40,15 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.Suppl
 	}
 }
 
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.AuctionHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set auction hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -17,3 +17,8 @@ type SupplyKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// AuctionHooks event hooks for other keepers to run code in response to auction modifications
+type AuctionHooks interface {
+	AfterCollateralAuctionClosed(ctx sdk.Context, auction CollateralAuction)
+}
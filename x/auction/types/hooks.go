@@ -0,0 +1,18 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiAuctionHooks combine multiple auction hooks, all hook functions are run in array sequence
+type MultiAuctionHooks []AuctionHooks
+
+// NewMultiAuctionHooks returns a new MultiAuctionHooks
+func NewMultiAuctionHooks(hooks ...AuctionHooks) MultiAuctionHooks {
+	return hooks
+}
+
+// AfterCollateralAuctionClosed runs after a collateral auction is closed and paid out
+func (h MultiAuctionHooks) AfterCollateralAuctionClosed(ctx sdk.Context, auction CollateralAuction) {
+	for i := range h {
+		h[i].AfterCollateralAuctionClosed(ctx, auction)
+	}
+}
@@ -1,6 +1,7 @@
 package bep3
 
 import (
+	"github.com/kava-labs/kava/x/bep3/client"
 	"github.com/kava-labs/kava/x/bep3/keeper"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
@@ -12,138 +13,229 @@ import (
 // ALIASGEN: github.com/kava-labs/kava/x/bep3/types
 
 const (
-	EventTypeCreateAtomicSwap      = types.EventTypeCreateAtomicSwap
-	EventTypeClaimAtomicSwap       = types.EventTypeClaimAtomicSwap
-	EventTypeRefundAtomicSwap      = types.EventTypeRefundAtomicSwap
-	EventTypeSwapsExpired          = types.EventTypeSwapsExpired
-	AttributeValueCategory         = types.AttributeValueCategory
-	AttributeKeySender             = types.AttributeKeySender
-	AttributeKeyRecipient          = types.AttributeKeyRecipient
-	AttributeKeyAtomicSwapID       = types.AttributeKeyAtomicSwapID
-	AttributeKeyRandomNumberHash   = types.AttributeKeyRandomNumberHash
-	AttributeKeyTimestamp          = types.AttributeKeyTimestamp
-	AttributeKeySenderOtherChain   = types.AttributeKeySenderOtherChain
-	AttributeKeyExpireHeight       = types.AttributeKeyExpireHeight
-	AttributeKeyAmount             = types.AttributeKeyAmount
-	AttributeKeyDirection          = types.AttributeKeyDirection
-	AttributeKeyClaimSender        = types.AttributeKeyClaimSender
-	AttributeKeyRandomNumber       = types.AttributeKeyRandomNumber
-	AttributeKeyRefundSender       = types.AttributeKeyRefundSender
-	AttributeKeyAtomicSwapIDs      = types.AttributeKeyAtomicSwapIDs
-	AttributeExpirationBlock       = types.AttributeExpirationBlock
-	ModuleName                     = types.ModuleName
-	StoreKey                       = types.StoreKey
-	RouterKey                      = types.RouterKey
-	QuerierRoute                   = types.QuerierRoute
-	DefaultParamspace              = types.DefaultParamspace
-	DefaultLongtermStorageDuration = types.DefaultLongtermStorageDuration
-	CreateAtomicSwap               = types.CreateAtomicSwap
-	ClaimAtomicSwap                = types.ClaimAtomicSwap
-	RefundAtomicSwap               = types.RefundAtomicSwap
-	CalcSwapID                     = types.CalcSwapID
-	Int64Size                      = types.Int64Size
-	RandomNumberHashLength         = types.RandomNumberHashLength
-	RandomNumberLength             = types.RandomNumberLength
-	AddrByteCount                  = types.AddrByteCount
-	MaxOtherChainAddrLength        = types.MaxOtherChainAddrLength
-	SwapIDLength                   = types.SwapIDLength
-	MaxExpectedIncomeLength        = types.MaxExpectedIncomeLength
-	QueryGetAssetSupply            = types.QueryGetAssetSupply
-	QueryGetAssetSupplies          = types.QueryGetAssetSupplies
-	QueryGetAtomicSwap             = types.QueryGetAtomicSwap
-	QueryGetAtomicSwaps            = types.QueryGetAtomicSwaps
-	QueryGetParams                 = types.QueryGetParams
-	NULL                           = types.NULL
-	Open                           = types.Open
-	Completed                      = types.Completed
-	Expired                        = types.Expired
-	INVALID                        = types.INVALID
-	Incoming                       = types.Incoming
-	Outgoing                       = types.Outgoing
+	AttributeKeyDeputy                    = types.AttributeKeyDeputy
+	AttributeKeyError                     = types.AttributeKeyError
+	AttributeKeyFee                       = types.AttributeKeyFee
+	AttributeKeySlashFraction             = types.AttributeKeySlashFraction
+	AttributeKeySuccess                   = types.AttributeKeySuccess
+	ClaimAtomicSwaps                      = types.ClaimAtomicSwaps
+	CreatePeerAtomicSwap                  = types.CreatePeerAtomicSwap
+	DepositDeputyBond                     = types.DepositDeputyBond
+	EventTypeBatchClaimResult             = types.EventTypeBatchClaimResult
+	EventTypeBatchRefundResult            = types.EventTypeBatchRefundResult
+	EventTypeCollectSwapFee               = types.EventTypeCollectSwapFee
+	EventTypeCreateAtomicSwap             = types.EventTypeCreateAtomicSwap
+	EventTypeClaimAtomicSwap              = types.EventTypeClaimAtomicSwap
+	EventTypeDeputyBond                   = types.EventTypeDeputyBond
+	EventTypeDeputyUnbond                 = types.EventTypeDeputyUnbond
+	EventTypeRefundAtomicSwap             = types.EventTypeRefundAtomicSwap
+	EventTypeSlashDeputyBond              = types.EventTypeSlashDeputyBond
+	EventTypeSwapsExpired                 = types.EventTypeSwapsExpired
+	AttributeValueCategory                = types.AttributeValueCategory
+	AttributeKeySender                    = types.AttributeKeySender
+	AttributeKeyRecipient                 = types.AttributeKeyRecipient
+	AttributeKeyAtomicSwapID              = types.AttributeKeyAtomicSwapID
+	AttributeKeyRandomNumberHash          = types.AttributeKeyRandomNumberHash
+	AttributeKeyTimestamp                 = types.AttributeKeyTimestamp
+	AttributeKeySenderOtherChain          = types.AttributeKeySenderOtherChain
+	AttributeKeyExpireHeight              = types.AttributeKeyExpireHeight
+	AttributeKeyAmount                    = types.AttributeKeyAmount
+	AttributeKeyDirection                 = types.AttributeKeyDirection
+	AttributeKeyClaimSender               = types.AttributeKeyClaimSender
+	AttributeKeyRandomNumber              = types.AttributeKeyRandomNumber
+	AttributeKeyRefundSender              = types.AttributeKeyRefundSender
+	AttributeKeyAtomicSwapIDs             = types.AttributeKeyAtomicSwapIDs
+	AttributeExpirationBlock              = types.AttributeExpirationBlock
+	MaxBatchSize                          = types.MaxBatchSize
+	MaxPeerSwapHeightSpan                 = types.MaxPeerSwapHeightSpan
+	MinPeerSwapHeightSpan                 = types.MinPeerSwapHeightSpan
+	ModuleName                            = types.ModuleName
+	PeerToPeer                            = types.PeerToPeer
+	ProposalTypeDeputyBondSlash           = types.ProposalTypeDeputyBondSlash
+	QueryGetAtomicSwapsByExpiry           = types.QueryGetAtomicSwapsByExpiry
+	QueryGetAtomicSwapsByRandomNumberHash = types.QueryGetAtomicSwapsByRandomNumberHash
+	QueryGetAtomicSwapsBySenderOtherChain = types.QueryGetAtomicSwapsBySenderOtherChain
+	QueryGetCapacity                      = types.QueryGetCapacity
+	QueryGetCollectedFees                 = types.QueryGetCollectedFees
+	QueryGetDeputyBonds                   = types.QueryGetDeputyBonds
+	QueryGetPeerAtomicSwaps               = types.QueryGetPeerAtomicSwaps
+	QueryGetSwapFee                       = types.QueryGetSwapFee
+	RefundAtomicSwaps                     = types.RefundAtomicSwaps
+	StoreKey                              = types.StoreKey
+	RouterKey                             = types.RouterKey
+	QuerierRoute                          = types.QuerierRoute
+	DefaultParamspace                     = types.DefaultParamspace
+	DefaultLongtermStorageDuration        = types.DefaultLongtermStorageDuration
+	CreateAtomicSwap                      = types.CreateAtomicSwap
+	ClaimAtomicSwap                       = types.ClaimAtomicSwap
+	RefundAtomicSwap                      = types.RefundAtomicSwap
+	CalcSwapID                            = types.CalcSwapID
+	Int64Size                             = types.Int64Size
+	RandomNumberHashLength                = types.RandomNumberHashLength
+	RandomNumberLength                    = types.RandomNumberLength
+	AddrByteCount                         = types.AddrByteCount
+	MaxOtherChainAddrLength               = types.MaxOtherChainAddrLength
+	SwapIDLength                          = types.SwapIDLength
+	MaxExpectedIncomeLength               = types.MaxExpectedIncomeLength
+	QueryGetAssetSupply                   = types.QueryGetAssetSupply
+	QueryGetAssetSupplies                 = types.QueryGetAssetSupplies
+	QueryGetAtomicSwap                    = types.QueryGetAtomicSwap
+	QueryGetAtomicSwaps                   = types.QueryGetAtomicSwaps
+	QueryGetParams                        = types.QueryGetParams
+	NULL                                  = types.NULL
+	Open                                  = types.Open
+	Completed                             = types.Completed
+	Expired                               = types.Expired
+	INVALID                               = types.INVALID
+	Incoming                              = types.Incoming
+	Outgoing                              = types.Outgoing
+	WithdrawDeputyBond                    = types.WithdrawDeputyBond
 )
 
 var (
 	// functions aliases
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	NewAssetSupply             = types.NewAssetSupply
-	RegisterCodec              = types.RegisterCodec
-	NewGenesisState            = types.NewGenesisState
-	DefaultGenesisState        = types.DefaultGenesisState
-	GenerateSecureRandomNumber = types.GenerateSecureRandomNumber
-	CalculateRandomHash        = types.CalculateRandomHash
-	CalculateSwapID            = types.CalculateSwapID
-	GetAtomicSwapByHeightKey   = types.GetAtomicSwapByHeightKey
-	NewMsgCreateAtomicSwap     = types.NewMsgCreateAtomicSwap
-	NewMsgClaimAtomicSwap      = types.NewMsgClaimAtomicSwap
-	NewMsgRefundAtomicSwap     = types.NewMsgRefundAtomicSwap
-	NewParams                  = types.NewParams
-	DefaultParams              = types.DefaultParams
-	NewAssetParam              = types.NewAssetParam
-	ParamKeyTable              = types.ParamKeyTable
-	NewQueryAssetSupply        = types.NewQueryAssetSupply
-	NewQueryAssetSupplies      = types.NewQueryAssetSupplies
-	NewQueryAtomicSwapByID     = types.NewQueryAtomicSwapByID
-	NewQueryAtomicSwaps        = types.NewQueryAtomicSwaps
-	NewAtomicSwap              = types.NewAtomicSwap
-	NewSwapStatusFromString    = types.NewSwapStatusFromString
-	NewSwapDirectionFromString = types.NewSwapDirectionFromString
-	NewAugmentedAtomicSwap     = types.NewAugmentedAtomicSwap
+	HandleDeputyBondSlashProposal         = keeper.HandleDeputyBondSlashProposal
+	NewKeeper                             = keeper.NewKeeper
+	NewQuerier                            = keeper.NewQuerier
+	CollectedFeesKey                      = types.CollectedFeesKey
+	DeputyBondKey                         = types.DeputyBondKey
+	GetAtomicSwapByRandomNumberHashKey    = types.GetAtomicSwapByRandomNumberHashKey
+	GetAtomicSwapBySenderOtherChainKey    = types.GetAtomicSwapBySenderOtherChainKey
+	GetSenderOtherChainPrefix             = types.GetSenderOtherChainPrefix
+	NewAssetSupply                        = types.NewAssetSupply
+	NewCollectedFees                      = types.NewCollectedFees
+	NewDeputyBond                         = types.NewDeputyBond
+	NewDeputyBondSlashProposal            = types.NewDeputyBondSlashProposal
+	NewFeeTier                            = types.NewFeeTier
+	NewMsgClaimAtomicSwaps                = types.NewMsgClaimAtomicSwaps
+	NewMsgCreatePeerAtomicSwap            = types.NewMsgCreatePeerAtomicSwap
+	NewMsgDepositDeputyBond               = types.NewMsgDepositDeputyBond
+	NewMsgRefundAtomicSwaps               = types.NewMsgRefundAtomicSwaps
+	NewMsgWithdrawDeputyBond              = types.NewMsgWithdrawDeputyBond
+	NewQueryAtomicSwapsByExpiry           = types.NewQueryAtomicSwapsByExpiry
+	NewQueryAtomicSwapsByRandomNumberHash = types.NewQueryAtomicSwapsByRandomNumberHash
+	NewQueryAtomicSwapsBySenderOtherChain = types.NewQueryAtomicSwapsBySenderOtherChain
+	NewQueryCapacity                      = types.NewQueryCapacity
+	NewQueryCollectedFees                 = types.NewQueryCollectedFees
+	NewQueryDeputyBonds                   = types.NewQueryDeputyBonds
+	NewQueryPeerAtomicSwaps               = types.NewQueryPeerAtomicSwaps
+	NewRetiringDeputy                     = types.NewRetiringDeputy
+	NewSwapClaim                          = types.NewSwapClaim
+	NewSwapFee                            = types.NewSwapFee
+	RegisterCodec                         = types.RegisterCodec
+	NewGenesisState                       = types.NewGenesisState
+	DefaultGenesisState                   = types.DefaultGenesisState
+	GenerateSecureRandomNumber            = types.GenerateSecureRandomNumber
+	CalculateRandomHash                   = types.CalculateRandomHash
+	CalculateSwapID                       = types.CalculateSwapID
+	GetAtomicSwapByHeightKey              = types.GetAtomicSwapByHeightKey
+	NewMsgCreateAtomicSwap                = types.NewMsgCreateAtomicSwap
+	NewMsgClaimAtomicSwap                 = types.NewMsgClaimAtomicSwap
+	NewMsgRefundAtomicSwap                = types.NewMsgRefundAtomicSwap
+	NewParams                             = types.NewParams
+	DefaultParams                         = types.DefaultParams
+	NewAssetParam                         = types.NewAssetParam
+	ParamKeyTable                         = types.ParamKeyTable
+	NewQueryAssetSupply                   = types.NewQueryAssetSupply
+	NewQueryAssetSupplies                 = types.NewQueryAssetSupplies
+	NewQueryAtomicSwapByID                = types.NewQueryAtomicSwapByID
+	NewQueryAtomicSwaps                   = types.NewQueryAtomicSwaps
+	NewAtomicSwap                         = types.NewAtomicSwap
+	NewSwapStatusFromString               = types.NewSwapStatusFromString
+	NewSwapDirectionFromString            = types.NewSwapDirectionFromString
+	NewAugmentedAtomicSwap                = types.NewAugmentedAtomicSwap
 
 	// variable aliases
-	ModuleCdc                       = types.ModuleCdc
-	ErrInvalidTimestamp             = types.ErrInvalidTimestamp
-	ErrInvalidHeightSpan            = types.ErrInvalidHeightSpan
-	ErrInsufficientAmount           = types.ErrInsufficientAmount
-	ErrAssetNotSupported            = types.ErrAssetNotSupported
-	ErrAssetNotActive               = types.ErrAssetNotActive
-	ErrAssetSupplyNotFound          = types.ErrAssetSupplyNotFound
-	ErrExceedsSupplyLimit           = types.ErrExceedsSupplyLimit
-	ErrExceedsAvailableSupply       = types.ErrExceedsAvailableSupply
-	ErrInvalidCurrentSupply         = types.ErrInvalidCurrentSupply
-	ErrInvalidIncomingSupply        = types.ErrInvalidIncomingSupply
-	ErrInvalidOutgoingSupply        = types.ErrInvalidOutgoingSupply
-	ErrInvalidClaimSecret           = types.ErrInvalidClaimSecret
-	ErrAtomicSwapAlreadyExists      = types.ErrAtomicSwapAlreadyExists
-	ErrAtomicSwapNotFound           = types.ErrAtomicSwapNotFound
-	ErrSwapNotRefundable            = types.ErrSwapNotRefundable
-	ErrSwapNotClaimable             = types.ErrSwapNotClaimable
-	ErrInvalidAmount                = types.ErrInvalidAmount
-	ErrInvalidSwapAccount           = types.ErrInvalidSwapAccount
-	AtomicSwapKeyPrefix             = types.AtomicSwapKeyPrefix
-	AtomicSwapByBlockPrefix         = types.AtomicSwapByBlockPrefix
-	AtomicSwapLongtermStoragePrefix = types.AtomicSwapLongtermStoragePrefix
-	AtomicSwapCoinsAccAddr          = types.AtomicSwapCoinsAccAddr
-	KeyAssetParams                  = types.KeyAssetParams
-	DefaultBnbDeputyFixedFee        = types.DefaultBnbDeputyFixedFee
-	DefaultMinAmount                = types.DefaultMinAmount
-	DefaultMaxAmount                = types.DefaultMaxAmount
-	DefaultMinBlockLock             = types.DefaultMinBlockLock
-	DefaultMaxBlockLock             = types.DefaultMaxBlockLock
-	DefaultPreviousBlockTime        = types.DefaultPreviousBlockTime
-	ModulePermissionsUpgradeTime    = types.ModulePermissionsUpgradeTime
+	AtomicSwapByExpiryPrefix           = types.AtomicSwapByExpiryPrefix
+	AtomicSwapByRandomNumberHashPrefix = types.AtomicSwapByRandomNumberHashPrefix
+	AtomicSwapBySenderOtherChainPrefix = types.AtomicSwapBySenderOtherChainPrefix
+	CollectedFeesPrefix                = types.CollectedFeesPrefix
+	DeputyBondPrefix                   = types.DeputyBondPrefix
+	ErrBatchFailed                     = types.ErrBatchFailed
+	ErrDeputyBondNotFound              = types.ErrDeputyBondNotFound
+	ErrInsufficientDeputyBond          = types.ErrInsufficientDeputyBond
+	ErrInvalidBondDenom                = types.ErrInvalidBondDenom
+	ModuleCdc                          = types.ModuleCdc
+	ErrInvalidTimestamp                = types.ErrInvalidTimestamp
+	ErrInvalidHeightSpan               = types.ErrInvalidHeightSpan
+	ErrInsufficientAmount              = types.ErrInsufficientAmount
+	ErrAssetNotSupported               = types.ErrAssetNotSupported
+	ErrAssetNotActive                  = types.ErrAssetNotActive
+	ErrAssetSupplyNotFound             = types.ErrAssetSupplyNotFound
+	ErrExceedsSupplyLimit              = types.ErrExceedsSupplyLimit
+	ErrExceedsAvailableSupply          = types.ErrExceedsAvailableSupply
+	ErrInvalidCurrentSupply            = types.ErrInvalidCurrentSupply
+	ErrInvalidIncomingSupply           = types.ErrInvalidIncomingSupply
+	ErrInvalidOutgoingSupply           = types.ErrInvalidOutgoingSupply
+	ErrInvalidClaimSecret              = types.ErrInvalidClaimSecret
+	ErrAtomicSwapAlreadyExists         = types.ErrAtomicSwapAlreadyExists
+	ErrAtomicSwapNotFound              = types.ErrAtomicSwapNotFound
+	ErrSwapNotRefundable               = types.ErrSwapNotRefundable
+	ErrSwapNotClaimable                = types.ErrSwapNotClaimable
+	ErrInvalidAmount                   = types.ErrInvalidAmount
+	ErrInvalidSwapAccount              = types.ErrInvalidSwapAccount
+	AtomicSwapKeyPrefix                = types.AtomicSwapKeyPrefix
+	AtomicSwapByBlockPrefix            = types.AtomicSwapByBlockPrefix
+	AtomicSwapLongtermStoragePrefix    = types.AtomicSwapLongtermStoragePrefix
+	AtomicSwapCoinsAccAddr             = types.AtomicSwapCoinsAccAddr
+	KeyAssetParams                     = types.KeyAssetParams
+	DefaultBnbDeputyFixedFee           = types.DefaultBnbDeputyFixedFee
+	DefaultMinAmount                   = types.DefaultMinAmount
+	DefaultMaxAmount                   = types.DefaultMaxAmount
+	DefaultMinBlockLock                = types.DefaultMinBlockLock
+	DefaultMaxBlockLock                = types.DefaultMaxBlockLock
+	DefaultPreviousBlockTime           = types.DefaultPreviousBlockTime
+	ModulePermissionsUpgradeTime       = types.ModulePermissionsUpgradeTime
+	RateLimitPrefix                    = types.RateLimitPrefix
+	SwapFeePrefix                      = types.SwapFeePrefix
+	ProposalHandler                    = client.ProposalHandler
 )
 
 type (
-	Keeper               = keeper.Keeper
-	AssetSupply          = types.AssetSupply
-	AssetSupplies        = types.AssetSupplies
-	GenesisState         = types.GenesisState
-	MsgCreateAtomicSwap  = types.MsgCreateAtomicSwap
-	MsgClaimAtomicSwap   = types.MsgClaimAtomicSwap
-	MsgRefundAtomicSwap  = types.MsgRefundAtomicSwap
-	Params               = types.Params
-	AssetParam           = types.AssetParam
-	AssetParams          = types.AssetParams
-	QueryAssetSupply     = types.QueryAssetSupply
-	QueryAssetSupplies   = types.QueryAssetSupplies
-	QueryAtomicSwapByID  = types.QueryAtomicSwapByID
-	QueryAtomicSwaps     = types.QueryAtomicSwaps
-	AtomicSwap           = types.AtomicSwap
-	AtomicSwaps          = types.AtomicSwaps
-	SwapStatus           = types.SwapStatus
-	SwapDirection        = types.SwapDirection
-	SupplyLimit          = types.SupplyLimit
-	AugmentedAtomicSwap  = types.AugmentedAtomicSwap
-	AugmentedAtomicSwaps = types.AugmentedAtomicSwaps
+	Keeper                             = keeper.Keeper
+	AssetSupply                        = types.AssetSupply
+	AssetSupplies                      = types.AssetSupplies
+	CollectedFees                      = types.CollectedFees
+	CollectedFeesList                  = types.CollectedFeesList
+	DeputyBond                         = types.DeputyBond
+	DeputyBonds                        = types.DeputyBonds
+	DeputyBondSlashProposal            = types.DeputyBondSlashProposal
+	FeeSchedule                        = types.FeeSchedule
+	FeeTier                            = types.FeeTier
+	GenesisState                       = types.GenesisState
+	MsgClaimAtomicSwaps                = types.MsgClaimAtomicSwaps
+	MsgCreateAtomicSwap                = types.MsgCreateAtomicSwap
+	MsgClaimAtomicSwap                 = types.MsgClaimAtomicSwap
+	MsgCreatePeerAtomicSwap            = types.MsgCreatePeerAtomicSwap
+	MsgDepositDeputyBond               = types.MsgDepositDeputyBond
+	MsgRefundAtomicSwap                = types.MsgRefundAtomicSwap
+	MsgRefundAtomicSwaps               = types.MsgRefundAtomicSwaps
+	MsgWithdrawDeputyBond              = types.MsgWithdrawDeputyBond
+	Params                             = types.Params
+	AssetParam                         = types.AssetParam
+	AssetParams                        = types.AssetParams
+	QueryAssetSupply                   = types.QueryAssetSupply
+	QueryAssetSupplies                 = types.QueryAssetSupplies
+	QueryAtomicSwapByID                = types.QueryAtomicSwapByID
+	QueryAtomicSwaps                   = types.QueryAtomicSwaps
+	AtomicSwap                         = types.AtomicSwap
+	AtomicSwaps                        = types.AtomicSwaps
+	QueryAtomicSwapsByExpiry           = types.QueryAtomicSwapsByExpiry
+	QueryAtomicSwapsByRandomNumberHash = types.QueryAtomicSwapsByRandomNumberHash
+	QueryAtomicSwapsBySenderOtherChain = types.QueryAtomicSwapsBySenderOtherChain
+	QueryCapacity                      = types.QueryCapacity
+	QueryCollectedFees                 = types.QueryCollectedFees
+	QueryDeputyBonds                   = types.QueryDeputyBonds
+	QueryPeerAtomicSwaps               = types.QueryPeerAtomicSwaps
+	RetiringDeputies                   = types.RetiringDeputies
+	RetiringDeputy                     = types.RetiringDeputy
+	SendRestriction                    = types.SendRestriction
+	SwapClaim                          = types.SwapClaim
+	SwapFee                            = types.SwapFee
+	SwapFees                           = types.SwapFees
+	SwapStatus                         = types.SwapStatus
+	SwapDirection                      = types.SwapDirection
+	SupplyLimit                        = types.SupplyLimit
+	AugmentedAtomicSwap                = types.AugmentedAtomicSwap
+	AugmentedAtomicSwaps               = types.AugmentedAtomicSwaps
 )
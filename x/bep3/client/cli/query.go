@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/viper"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
@@ -24,6 +25,10 @@ const (
 	flagExpiration = "expiration"
 	flagStatus     = "status"
 	flagDirection  = "direction"
+	flagSender     = "sender"
+	flagRecipient  = "recipient"
+	flagDenom      = "denom"
+	flagDeputy     = "deputy"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -42,8 +47,16 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryCalcRandomNumberHashCmd(queryRoute, cdc),
 		QueryGetAssetSupplyCmd(queryRoute, cdc),
 		QueryGetAssetSuppliesCmd(queryRoute, cdc),
+		QueryGetCapacityCmd(queryRoute, cdc),
 		QueryGetAtomicSwapCmd(queryRoute, cdc),
 		QueryGetAtomicSwapsCmd(queryRoute, cdc),
+		QueryGetPeerAtomicSwapsCmd(queryRoute, cdc),
+		QueryGetAtomicSwapsByRandomNumberHashCmd(queryRoute, cdc),
+		QueryGetAtomicSwapsBySenderOtherChainCmd(queryRoute, cdc),
+		QueryGetAtomicSwapsByExpiryCmd(queryRoute, cdc),
+		QueryGetSwapFeeCmd(queryRoute, cdc),
+		QueryGetDeputyBondsCmd(queryRoute, cdc),
+		QueryGetCollectedFeesCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
 	)...)
 
@@ -179,6 +192,38 @@ func QueryGetAssetSuppliesCmd(queryRoute string, cdc *codec.Codec) *cobra.Comman
 	}
 }
 
+// QueryGetCapacityCmd queries the amount of an asset that can still be swapped in under its supply limits
+func QueryGetCapacityCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "capacity [denom]",
+		Short:   "get the amount of an asset that can still be swapped in",
+		Long:    "Get the supply of an asset counted against its time-based limit over the limit's rolling window, and the amount that can still be swapped in.",
+		Example: "bep3 capacity bnb",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare query params
+			bz, err := cdc.MarshalJSON(types.NewQueryCapacity(args[0]))
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCapacity), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var capacity ratelimit.Capacity
+			cdc.MustUnmarshalJSON(res, &capacity)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(capacity)
+		},
+	}
+}
+
 // QueryGetAtomicSwapCmd queries an AtomicSwap by swapID
 func QueryGetAtomicSwapCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -216,6 +261,142 @@ func QueryGetAtomicSwapCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// QueryGetSwapFeeCmd queries the fee charged on an outgoing swap
+func QueryGetSwapFeeCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "swap-fee [swap-id]",
+		Short:   "get the fee charged on chain for an outgoing atomic swap",
+		Example: "bep3 swap-fee 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAtomicSwapByID(swapID))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSwapFee), bz)
+			if err != nil {
+				return err
+			}
+
+			var fee types.SwapFee
+			cdc.MustUnmarshalJSON(res, &fee)
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(fee)
+		},
+	}
+}
+
+// QueryGetDeputyBondsCmd queries deputy bonds in the store
+func QueryGetDeputyBondsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deputy-bonds",
+		Short: "query deputy bonds with an optional deputy filter",
+		Long: strings.TrimSpace(`Query for all paginated deputy bonds, or the bond of one deputy:
+Example:
+$ kvcli q bep3 deputy-bonds
+$ kvcli q bep3 deputy-bonds --deputy=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+$ kvcli q bep3 deputy-bonds --page=2 --limit=100
+`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := types.NewQueryDeputyBonds(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), nil)
+
+			bechDeputyAddr := viper.GetString(flagDeputy)
+			if len(bechDeputyAddr) != 0 {
+				deputyAddr, err := sdk.AccAddressFromBech32(bechDeputyAddr)
+				if err != nil {
+					return err
+				}
+				params.Deputy = deputyAddr
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDeputyBonds), bz)
+			if err != nil {
+				return err
+			}
+
+			var bonds types.DeputyBonds
+			cdc.MustUnmarshalJSON(res, &bonds)
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(bonds)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of deputy bonds to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of deputy bonds to query for")
+	cmd.Flags().String(flagDeputy, "", "(optional) filter by deputy address")
+
+	return cmd
+}
+
+// QueryGetCollectedFeesCmd queries the swap fees collected by deputies
+func QueryGetCollectedFeesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collected-fees",
+		Short: "query the swap fees collected by deputies with an optional deputy filter",
+		Long: strings.TrimSpace(`Query for the paginated swap fees collected by all deputies, or by one deputy:
+Example:
+$ kvcli q bep3 collected-fees
+$ kvcli q bep3 collected-fees --deputy=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+$ kvcli q bep3 collected-fees --page=2 --limit=100
+`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := types.NewQueryCollectedFees(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), nil)
+
+			bechDeputyAddr := viper.GetString(flagDeputy)
+			if len(bechDeputyAddr) != 0 {
+				deputyAddr, err := sdk.AccAddressFromBech32(bechDeputyAddr)
+				if err != nil {
+					return err
+				}
+				params.Deputy = deputyAddr
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCollectedFees), bz)
+			if err != nil {
+				return err
+			}
+
+			var feesList types.CollectedFeesList
+			cdc.MustUnmarshalJSON(res, &feesList)
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(feesList)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of collected fees to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of collected fees to query for")
+	cmd.Flags().String(flagDeputy, "", "(optional) filter by deputy address")
+
+	return cmd
+}
+
 // QueryGetAtomicSwapsCmd queries AtomicSwaps in the store
 func QueryGetAtomicSwapsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -226,7 +407,7 @@ Example:
 $ kvcli q bep3 swaps --involve=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
 $ kvcli q bep3 swaps --expiration=280
 $ kvcli q bep3 swaps --status=(Open|Completed|Expired)
-$ kvcli q bep3 swaps --direction=(Incoming|Outgoing)
+$ kvcli q bep3 swaps --direction=(Incoming|Outgoing|PeerToPeer)
 $ kvcli q bep3 swaps --page=2 --limit=100
 `,
 		),
@@ -306,7 +487,88 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 	cmd.Flags().String(flagInvolve, "", "(optional) filter by atomic swaps that involve an address")
 	cmd.Flags().String(flagExpiration, "", "(optional) filter by atomic swaps that expire before a block height")
 	cmd.Flags().String(flagStatus, "", "(optional) filter by atomic swap status, status: open/completed/expired")
-	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing")
+	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing/peer")
+
+	return cmd
+}
+
+// QueryGetPeerAtomicSwapsCmd queries peer to peer AtomicSwaps in the store
+func QueryGetPeerAtomicSwapsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peer-swaps",
+		Short: "query peer to peer atomic swaps with optional filters",
+		Long: strings.TrimSpace(`Query for all paginated peer to peer atomic swaps that match optional filters:
+Example:
+$ kvcli q bep3 peer-swaps --sender=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+$ kvcli q bep3 peer-swaps --recipient=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+$ kvcli q bep3 peer-swaps --denom=ukava
+$ kvcli q bep3 peer-swaps --status=(Open|Completed|Expired)
+$ kvcli q bep3 peer-swaps --page=2 --limit=100
+`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bechSenderAddr := viper.GetString(flagSender)
+			bechRecipientAddr := viper.GetString(flagRecipient)
+			strSwapStatus := viper.GetString(flagStatus)
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			params := types.NewQueryPeerAtomicSwaps(page, limit, nil, nil, viper.GetString(flagDenom), types.NULL)
+
+			if len(bechSenderAddr) != 0 {
+				senderAddr, err := sdk.AccAddressFromBech32(bechSenderAddr)
+				if err != nil {
+					return err
+				}
+				params.Sender = senderAddr
+			}
+
+			if len(bechRecipientAddr) != 0 {
+				recipientAddr, err := sdk.AccAddressFromBech32(bechRecipientAddr)
+				if err != nil {
+					return err
+				}
+				params.Recipient = recipientAddr
+			}
+
+			if len(strSwapStatus) != 0 {
+				swapStatus := types.NewSwapStatusFromString(strSwapStatus)
+				if !swapStatus.IsValid() {
+					return fmt.Errorf("invalid swap status %s", strSwapStatus)
+				}
+				params.Status = swapStatus
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetPeerAtomicSwaps), bz)
+			if err != nil {
+				return err
+			}
+
+			var matchingAtomicSwaps types.AugmentedAtomicSwaps
+			cdc.UnmarshalJSON(res, &matchingAtomicSwaps)
+
+			if len(matchingAtomicSwaps) == 0 {
+				return fmt.Errorf("No matching atomic swaps found")
+			}
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(matchingAtomicSwaps) // nolint:errcheck
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
+	cmd.Flags().String(flagSender, "", "(optional) filter by atomic swaps sent by an address")
+	cmd.Flags().String(flagRecipient, "", "(optional) filter by atomic swaps sent to an address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter by atomic swaps of a denom")
+	cmd.Flags().String(flagStatus, "", "(optional) filter by atomic swap status, status: open/completed/expired")
 
 	return cmd
 }
@@ -335,3 +597,94 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QueryGetAtomicSwapsByRandomNumberHashCmd queries the AtomicSwaps locked with a random number hash
+func QueryGetAtomicSwapsByRandomNumberHashCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swaps-by-random-number-hash [random-number-hash]",
+		Short:   "query the atomic swaps locked with a random number hash",
+		Example: "bep3 swaps-by-random-number-hash 464105c245199d02a4289475b8b231f3f73918b6f0fdad898825186950d46f36",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			randomNumberHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryAtomicSwapsByRandomNumberHash(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), randomNumberHash)
+			return queryIndexedAtomicSwaps(cdc, queryRoute, types.QueryGetAtomicSwapsByRandomNumberHash, params)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
+	return cmd
+}
+
+// QueryGetAtomicSwapsBySenderOtherChainCmd queries the AtomicSwaps created by an address on the other chain
+func QueryGetAtomicSwapsBySenderOtherChainCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swaps-by-sender-other-chain [sender-other-chain]",
+		Short:   "query the atomic swaps created by an address on the other chain",
+		Example: "bep3 swaps-by-sender-other-chain bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := types.NewQueryAtomicSwapsBySenderOtherChain(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), args[0])
+			return queryIndexedAtomicSwaps(cdc, queryRoute, types.QueryGetAtomicSwapsBySenderOtherChain, params)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
+	return cmd
+}
+
+// QueryGetAtomicSwapsByExpiryCmd queries the AtomicSwaps expiring in an inclusive range of block heights
+func QueryGetAtomicSwapsByExpiryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swaps-by-expiry [min-expire-height] [max-expire-height]",
+		Short:   "query the atomic swaps expiring in an inclusive range of block heights",
+		Example: "bep3 swaps-by-expiry 100 200",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			minHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryAtomicSwapsByExpiry(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), minHeight, maxHeight)
+			return queryIndexedAtomicSwaps(cdc, queryRoute, types.QueryGetAtomicSwapsByExpiry, params)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
+	return cmd
+}
+
+// queryIndexedAtomicSwaps queries and prints the atomic swaps found through one of the swap indexes
+func queryIndexedAtomicSwaps(cdc *codec.Codec, queryRoute, path string, params interface{}) error {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, path), bz)
+	if err != nil {
+		return err
+	}
+
+	var matchingAtomicSwaps types.AugmentedAtomicSwaps
+	cdc.UnmarshalJSON(res, &matchingAtomicSwaps)
+
+	if len(matchingAtomicSwaps) == 0 {
+		return fmt.Errorf("No matching atomic swaps found")
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	return cliCtx.PrintOutput(matchingAtomicSwaps) // nolint:errcheck
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,12 +18,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
+// Create peer to peer atomic swap flags
+const (
+	flagRecipientOtherChain = "recipient-other-chain"
+	flagSenderOtherChain    = "sender-other-chain"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	bep3TxCmd := &cobra.Command{
@@ -35,8 +44,13 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	bep3TxCmd.AddCommand(flags.PostCommands(
 		GetCmdCreateAtomicSwap(cdc),
+		GetCmdCreatePeerAtomicSwap(cdc),
 		GetCmdClaimAtomicSwap(cdc),
 		GetCmdRefundAtomicSwap(cdc),
+		GetCmdClaimAtomicSwaps(cdc),
+		GetCmdRefundAtomicSwaps(cdc),
+		GetCmdDepositDeputyBond(cdc),
+		GetCmdWithdrawDeputyBond(cdc),
 	)...)
 
 	return bep3TxCmd
@@ -113,6 +127,82 @@ func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdCreatePeerAtomicSwap cli command for creating atomic swaps between two accounts without a deputy
+func GetCmdCreatePeerAtomicSwap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-peer [to] [timestamp] [coins] [height-span]",
+		Short: "create a new peer to peer atomic swap, escrowing coins until the recipient claims them",
+		Long: strings.TrimSpace(`Create an atomic swap with another account that doesn't involve a deputy.
+The coins are held in escrow until the recipient claims them with the random number, or the swap expires and is refunded.
+Set the addresses on the other chain for one side of a cross-chain swap.`),
+		Example: fmt.Sprintf("%s tx %s create-peer kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj now 100ukava 1000 --from accA",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			from := cliCtx.GetFromAddress()
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Timestamp defaults to time.Now() unless it's explicitly set
+			var timestamp int64
+			if strings.Compare(args[1], "now") == 0 {
+				timestamp = tmtime.Now().Unix()
+			} else {
+				timestamp, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Generate cryptographically strong pseudo-random number
+			randomNumber, err := types.GenerateSecureRandomNumber()
+			if err != nil {
+				return err
+			}
+
+			randomNumberHash := types.CalculateRandomHash(randomNumber, timestamp)
+
+			// Print random number, timestamp, and hash to user's console
+			fmt.Printf("\nRandom number: %s\n", hex.EncodeToString(randomNumber))
+			fmt.Printf("Timestamp: %d\n", timestamp)
+			fmt.Printf("Random number hash: %s\n\n", hex.EncodeToString(randomNumberHash))
+
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			heightSpan, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeerAtomicSwap(
+				from, to, viper.GetString(flagRecipientOtherChain), viper.GetString(flagSenderOtherChain),
+				randomNumberHash, timestamp, coins, heightSpan,
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRecipientOtherChain, "", "(optional) recipient's address on the other chain of a cross-chain swap")
+	cmd.Flags().String(flagSenderOtherChain, "", "(optional) sender's address on the other chain of a cross-chain swap")
+
+	return cmd
+}
+
 // GetCmdClaimAtomicSwap cli command for claiming an atomic swap
 func GetCmdClaimAtomicSwap(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -182,3 +272,203 @@ func GetCmdRefundAtomicSwap(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdClaimAtomicSwaps cli command for claiming a batch of atomic swaps
+func GetCmdClaimAtomicSwaps(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-batch [swap-id] [random-number] [[swap-id] [random-number]...]",
+		Short: "claim coins in a batch of atomic swaps using their secret numbers",
+		Long: strings.TrimSpace(`Claim up to 100 atomic swaps in one transaction. Each swap is claimed independently,
+so swaps that can't be claimed don't prevent the others from being claimed. The result of each claim is reported in the
+transaction's events.`),
+		Example: fmt.Sprintf("%s tx %s claim-batch 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af 56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c --from accA", version.ClientName, types.ModuleName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%2 != 0 {
+				return fmt.Errorf("expected pairs of swap ID and random number, got %d args", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			from := cliCtx.GetFromAddress()
+
+			var claims []types.SwapClaim
+			for i := 0; i < len(args); i += 2 {
+				swapID, err := hex.DecodeString(args[i])
+				if err != nil {
+					return err
+				}
+				randomNumber, err := hex.DecodeString(args[i+1])
+				if err != nil {
+					return err
+				}
+				claims = append(claims, types.NewSwapClaim(swapID, randomNumber))
+			}
+
+			msg := types.NewMsgClaimAtomicSwaps(from, claims)
+
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRefundAtomicSwaps cli command for refunding a batch of atomic swaps
+func GetCmdRefundAtomicSwaps(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refund-batch [swap-id] [[swap-id]...]",
+		Short: "refund the coins in a batch of atomic swaps",
+		Long: strings.TrimSpace(`Refund up to 100 atomic swaps in one transaction. Each swap is refunded independently,
+so swaps that can't be refunded don't prevent the others from being refunded. The result of each refund is reported in the
+transaction's events.`),
+		Example: fmt.Sprintf("%s tx %s refund-batch 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af --from accA", version.ClientName, types.ModuleName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			from := cliCtx.GetFromAddress()
+
+			var swapIDs []tmbytes.HexBytes
+			for _, arg := range args {
+				swapID, err := hex.DecodeString(arg)
+				if err != nil {
+					return err
+				}
+				swapIDs = append(swapIDs, swapID)
+			}
+
+			msg := types.NewMsgRefundAtomicSwaps(from, swapIDs)
+
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDepositDeputyBond cli command for depositing coins into a deputy's bond
+func GetCmdDepositDeputyBond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "deposit-bond [coins]",
+		Short:   "deposit coins into the sender's deputy bond",
+		Example: fmt.Sprintf("%s tx %s deposit-bond 1000000000ukava --from deputy", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositDeputyBond(cliCtx.GetFromAddress(), amount)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawDeputyBond cli command for withdrawing coins from a deputy's bond
+func GetCmdWithdrawDeputyBond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "withdraw-bond [coins]",
+		Short:   "withdraw coins from the sender's deputy bond",
+		Example: fmt.Sprintf("%s tx %s withdraw-bond 1000000000ukava --from deputy", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDeputyBond(cliCtx.GetFromAddress(), amount)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitDeputyBondSlashProposal implements the command to submit a deputy bond slash proposal
+func GetCmdSubmitDeputyBondSlashProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bep3-deputy-bond-slash [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a bep3 deputy bond slash proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to burn a fraction of a bep3 deputy's bond along with an initial deposit.
+The proposal details must be supplied via a JSON file. The description should set out the evidence of the
+deputy's misbehavior, such as the IDs of incoming swaps it failed to claim.
+
+Example:
+$ %s tx gov submit-proposal bep3-deputy-bond-slash <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Slash Deputy Bond",
+  "description": "The deputy didn't claim the incoming swaps 6682c03c... and 30758299... before they expired",
+  "deputy": "kava1mz2003lathm95n5vnlthmtfvrzrjkrr53j4464",
+  "fraction": "0.100000000000000000",
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseDeputyBondSlashProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewDeputyBondSlashProposal(proposal.Title, proposal.Description, proposal.Deputy, proposal.Fraction)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// DeputyBondSlashProposalJSON defines a DeputyBondSlashProposal with a deposit
+	DeputyBondSlashProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Deputy      sdk.AccAddress `json:"deputy" yaml:"deputy"`
+		Fraction    sdk.Dec        `json:"fraction" yaml:"fraction"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseDeputyBondSlashProposalJSON reads and parses a DeputyBondSlashProposalJSON from a file.
+func ParseDeputyBondSlashProposalJSON(cdc *codec.Codec, proposalFile string) (DeputyBondSlashProposalJSON, error) {
+	proposal := DeputyBondSlashProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/kava-labs/kava/x/bep3/client/cli"
+	"github.com/kava-labs/kava/x/bep3/client/rest"
+)
+
+// deputy bond slash proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDeputyBondSlashProposal, rest.ProposalRESTHandler)
+)
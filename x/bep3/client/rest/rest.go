@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/kava-labs/kava/x/bep3/types"
 )
 
 // REST Variable names
@@ -17,6 +19,12 @@ const (
 	RestInvolve    = "involve"
 	RestStatus     = "status"
 	RestDirection  = "direction"
+	RestSender     = "sender"
+	RestRecipient  = "recipient"
+	RestDenom      = "denom"
+	RestDeputy     = "deputy"
+	RestMinExpire  = "min_expire_height"
+	RestMaxExpire  = "max_expire_height"
 )
 
 // RegisterRoutes registers bep3-related REST handlers to a router
@@ -39,6 +47,19 @@ type PostCreateSwapReq struct {
 	CrossChain          bool             `json:"cross_chain" yaml:"cross_chain"`
 }
 
+// PostCreatePeerSwapReq defines the properties of a peer to peer swap create request's body
+type PostCreatePeerSwapReq struct {
+	BaseReq             rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	From                sdk.AccAddress   `json:"from" yaml:"from"`
+	To                  sdk.AccAddress   `json:"to" yaml:"to"`
+	RecipientOtherChain string           `json:"recipient_other_chain" yaml:"recipient_other_chain"`
+	SenderOtherChain    string           `json:"sender_other_chain" yaml:"sender_other_chain"`
+	RandomNumberHash    tmbytes.HexBytes `json:"random_number_hash" yaml:"random_number_hash"`
+	Timestamp           int64            `json:"timestamp" yaml:"timestamp"`
+	Amount              sdk.Coins        `json:"amount" yaml:"amount"`
+	HeightSpan          uint64           `json:"height_span" yaml:"height_span"`
+}
+
 // PostClaimSwapReq defines the properties of a swap claim request's body
 type PostClaimSwapReq struct {
 	BaseReq      rest.BaseReq     `json:"base_req" yaml:"base_req"`
@@ -53,3 +74,36 @@ type PostRefundSwapReq struct {
 	From    sdk.AccAddress   `json:"from" yaml:"from"`
 	SwapID  tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
 }
+
+// PostClaimSwapsReq defines the properties of a batch swap claim request's body
+type PostClaimSwapsReq struct {
+	BaseReq rest.BaseReq      `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress    `json:"from" yaml:"from"`
+	Claims  []types.SwapClaim `json:"claims" yaml:"claims"`
+}
+
+// PostRefundSwapsReq defines the properties of a batch swap refund request's body
+type PostRefundSwapsReq struct {
+	BaseReq rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress     `json:"from" yaml:"from"`
+	SwapIDs []tmbytes.HexBytes `json:"swap_ids" yaml:"swap_ids"`
+}
+
+// PostDeputyBondReq defines the properties of a deputy bond deposit or withdraw request's body
+type PostDeputyBondReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// DeputyBondSlashProposalReq defines a deputy bond slash proposal request body.
+type DeputyBondSlashProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Deputy      sdk.AccAddress `json:"deputy" yaml:"deputy"`
+	Fraction    sdk.Dec        `json:"fraction" yaml:"fraction"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
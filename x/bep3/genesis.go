@@ -26,6 +26,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, supply := range gs.Supplies {
 		keeper.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
+	for _, bucket := range gs.RateLimitBuckets {
+		keeper.SetRateLimitBucket(ctx, bucket)
+	}
 
 	var incomingSupplies sdk.Coins
 	var outgoingSupplies sdk.Coins
@@ -34,10 +37,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 			panic(fmt.Sprintf("invalid swap %s", swap.GetSwapID()))
 		}
 
-		// Atomic swap assets must be both supported and active
-		err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
-		if err != nil {
-			panic(err)
+		// Atomic swap assets must be both supported and active, except in peer to peer swaps that don't affect asset supplies
+		if swap.Direction != PeerToPeer {
+			err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		keeper.SetAtomicSwap(ctx, swap)
@@ -71,11 +76,31 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 			default:
 				panic(fmt.Sprintf("swap %s has invalid status %s", swap.GetSwapID(), swap.Status.String()))
 			}
+		case PeerToPeer:
+			switch swap.Status {
+			case Open:
+				keeper.InsertIntoByBlockIndex(ctx, swap)
+			case Expired:
+			case Completed:
+				keeper.InsertIntoLongtermStorage(ctx, swap)
+			default:
+				panic(fmt.Sprintf("swap %s has invalid status %s", swap.GetSwapID(), swap.Status.String()))
+			}
 		default:
 			panic(fmt.Sprintf("swap %s has invalid direction %s", swap.GetSwapID(), swap.Direction.String()))
 		}
 	}
 
+	for _, bond := range gs.DeputyBonds {
+		keeper.SetDeputyBond(ctx, bond)
+	}
+	for _, fee := range gs.SwapFees {
+		keeper.SetSwapFee(ctx, fee)
+	}
+	for _, fees := range gs.CollectedFees {
+		keeper.SetCollectedFees(ctx, fees)
+	}
+
 	// Asset's given incoming/outgoing supply much match the amount of coins in incoming/outgoing atomic swaps
 	supplies := keeper.GetAllAssetSupplies(ctx)
 	for _, supply := range supplies {
@@ -114,9 +139,13 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 	params := k.GetParams(ctx)
 	swaps := k.GetAllAtomicSwaps(ctx)
 	supplies := k.GetAllAssetSupplies(ctx)
+	buckets := k.GetRateLimitBuckets(ctx)
 	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, swaps, supplies, previousBlockTime)
+	bonds := k.GetDeputyBonds(ctx)
+	swapFees := k.GetSwapFees(ctx)
+	collectedFees := k.GetAllCollectedFees(ctx)
+	return NewGenesisState(params, swaps, supplies, buckets, previousBlockTime, bonds, swapFees, collectedFees)
 }
@@ -3,6 +3,10 @@ package bep3
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/bep3/keeper"
+	"github.com/kava-labs/kava/x/bep3/types"
 )
 
 // NewHandler creates an sdk.Handler for all the bep3 type messages
@@ -12,10 +16,20 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgCreateAtomicSwap:
 			return handleMsgCreateAtomicSwap(ctx, k, msg)
+		case MsgCreatePeerAtomicSwap:
+			return handleMsgCreatePeerAtomicSwap(ctx, k, msg)
 		case MsgClaimAtomicSwap:
 			return handleMsgClaimAtomicSwap(ctx, k, msg)
 		case MsgRefundAtomicSwap:
 			return handleMsgRefundAtomicSwap(ctx, k, msg)
+		case MsgClaimAtomicSwaps:
+			return handleMsgClaimAtomicSwaps(ctx, k, msg)
+		case MsgRefundAtomicSwaps:
+			return handleMsgRefundAtomicSwaps(ctx, k, msg)
+		case MsgDepositDeputyBond:
+			return handleMsgDepositDeputyBond(ctx, k, msg)
+		case MsgWithdrawDeputyBond:
+			return handleMsgWithdrawDeputyBond(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -43,6 +57,27 @@ func handleMsgCreateAtomicSwap(ctx sdk.Context, k Keeper, msg MsgCreateAtomicSwa
 	}, nil
 }
 
+// handleMsgCreatePeerAtomicSwap handles requests to create a new peer to peer AtomicSwap
+func handleMsgCreatePeerAtomicSwap(ctx sdk.Context, k Keeper, msg MsgCreatePeerAtomicSwap) (*sdk.Result, error) {
+	err := k.CreatePeerAtomicSwap(ctx, msg.RandomNumberHash, msg.Timestamp, msg.HeightSpan,
+		msg.From, msg.To, msg.SenderOtherChain, msg.RecipientOtherChain, msg.Amount, msg.IsCrossChain())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
 // handleMsgClaimAtomicSwap handles requests to claim funds in an active AtomicSwap
 func handleMsgClaimAtomicSwap(ctx sdk.Context, k Keeper, msg MsgClaimAtomicSwap) (*sdk.Result, error) {
 
@@ -84,3 +119,96 @@ func handleMsgRefundAtomicSwap(ctx sdk.Context, k Keeper, msg MsgRefundAtomicSwa
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// handleMsgClaimAtomicSwaps handles requests to claim a batch of AtomicSwaps
+func handleMsgClaimAtomicSwaps(ctx sdk.Context, k Keeper, msg MsgClaimAtomicSwaps) (*sdk.Result, error) {
+	err := k.ClaimAtomicSwaps(ctx, msg.From, msg.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgRefundAtomicSwaps handles requests to refund a batch of AtomicSwaps
+func handleMsgRefundAtomicSwaps(ctx sdk.Context, k Keeper, msg MsgRefundAtomicSwaps) (*sdk.Result, error) {
+	err := k.RefundAtomicSwaps(ctx, msg.From, msg.SwapIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgDepositDeputyBond handles requests to deposit coins into a deputy's bond
+func handleMsgDepositDeputyBond(ctx sdk.Context, k Keeper, msg MsgDepositDeputyBond) (*sdk.Result, error) {
+	err := k.DepositDeputyBond(ctx, msg.From, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgWithdrawDeputyBond handles requests to withdraw coins from a deputy's bond
+func handleMsgWithdrawDeputyBond(ctx sdk.Context, k Keeper, msg MsgWithdrawDeputyBond) (*sdk.Result, error) {
+	err := k.WithdrawDeputyBond(ctx, msg.From, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// NewDeputyBondSlashProposalHandler creates a gov handler for deputy bond slash proposals
+func NewDeputyBondSlashProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.DeputyBondSlashProposal:
+			return keeper.HandleDeputyBondSlashProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bep3 proposal content type: %T", c)
+		}
+	}
+}
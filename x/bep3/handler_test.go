@@ -124,6 +124,28 @@ func (suite *HandlerTestSuite) TestMsgRefundAtomicSwap() {
 	suite.Require().NotNil(res2)
 }
 
+func (suite *HandlerTestSuite) TestMsgClaimAtomicSwaps() {
+	badRandomNumber, _ := bep3.GenerateSecureRandomNumber()
+	badRandomNumberHash := bep3.CalculateRandomHash(badRandomNumber[:], ts(0))
+	badSwapID := bep3.CalculateSwapID(badRandomNumberHash, suite.addrs[0], TestSenderOtherChain)
+
+	// a batch fails if none of its swaps can be claimed
+	badMsg := bep3.NewMsgClaimAtomicSwaps(suite.addrs[0], []bep3.SwapClaim{bep3.NewSwapClaim(badSwapID, badRandomNumber[:])})
+	badRes, err := suite.handler(suite.ctx, badMsg)
+	suite.Require().Error(err)
+	suite.Require().Nil(badRes)
+
+	// a batch succeeds if some of its swaps can be claimed
+	swapID, randomNumber := suite.AddAtomicSwap()
+	msg := bep3.NewMsgClaimAtomicSwaps(suite.addrs[0], []bep3.SwapClaim{
+		bep3.NewSwapClaim(badSwapID, badRandomNumber[:]),
+		bep3.NewSwapClaim(swapID, randomNumber),
+	})
+	res, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+}
+
 func (suite *HandlerTestSuite) TestInvalidMsg() {
 	res, err := suite.handler(suite.ctx, sdk.NewTestMsg())
 	suite.Require().Error(err)
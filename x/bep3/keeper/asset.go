@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
@@ -32,6 +33,7 @@ func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) erro
 		if timeBasedSupplyLimit.IsLT(supply.TimeLimitedCurrentSupply.Add(coin)) {
 			return sdkerrors.Wrapf(types.ErrExceedsTimeBasedSupplyLimit, "increase %s, current time-based asset supply %s, limit %s", coin, supply.TimeLimitedCurrentSupply, timeBasedSupplyLimit)
 		}
+		k.window.Record(ctx, coin.Denom, coin.Amount)
 		supply.TimeLimitedCurrentSupply = supply.TimeLimitedCurrentSupply.Add(coin)
 	}
 
@@ -40,7 +42,8 @@ func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) erro
 	return nil
 }
 
-// DecrementCurrentAssetSupply decrement an asset's supply by the coin
+// DecrementCurrentAssetSupply decrement an asset's supply by the coin.
+// For time-limited assets, the coin cancels the most recent issuance in the rate limit's window.
 func (k Keeper) DecrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
@@ -53,6 +56,15 @@ func (k Keeper) DecrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) erro
 		return sdkerrors.Wrapf(types.ErrInvalidCurrentSupply, "decrease %s, asset supply %s", coin, supply.CurrentSupply)
 	}
 
+	limit, err := k.GetSupplyLimit(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if limit.TimeLimited {
+		cancelled := k.window.Cancel(ctx, coin.Denom, coin.Amount)
+		supply.TimeLimitedCurrentSupply = supply.TimeLimitedCurrentSupply.Sub(sdk.NewCoin(coin.Denom, cancelled))
+	}
+
 	supply.CurrentSupply = supply.CurrentSupply.Sub(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
 	return nil
@@ -153,31 +165,59 @@ func (k Keeper) CreateNewAssetSupply(ctx sdk.Context, denom string) types.AssetS
 	return supply
 }
 
-// UpdateTimeBasedSupplyLimits updates the time based supply for each asset, resetting it if the current time window has elapsed.
+// GetRateLimitBuckets returns the amounts swapped in recorded in the rate limit window of each asset
+func (k Keeper) GetRateLimitBuckets(ctx sdk.Context) ratelimit.Buckets {
+	return k.window.GetAllBuckets(ctx)
+}
+
+// SetRateLimitBucket stores an amount swapped in in an asset's rate limit window. It is used to import the window from genesis.
+func (k Keeper) SetRateLimitBucket(ctx sdk.Context, bucket ratelimit.Bucket) {
+	k.window.SetBucket(ctx, bucket)
+}
+
+// GetCapacity returns the amount of an asset that can still be swapped in to the chain.
+// For time-limited assets it is the lower of the capacity left under the time-based limit and under the absolute limit.
+// The supply counted against each limit includes the incoming supply of open swaps.
+func (k Keeper) GetCapacity(ctx sdk.Context, denom string) (ratelimit.Capacity, error) {
+	limit, err := k.GetSupplyLimit(ctx, denom)
+	if err != nil {
+		return ratelimit.Capacity{}, err
+	}
+	supply, found := k.GetAssetSupply(ctx, denom)
+	if !found {
+		return ratelimit.Capacity{}, sdkerrors.Wrap(types.ErrAssetSupplyNotFound, denom)
+	}
+
+	absolute := ratelimit.NewCapacity(denom, limit.Limit, time.Duration(0), supply.CurrentSupply.Amount.Add(supply.IncomingSupply.Amount))
+	if !limit.TimeLimited {
+		return absolute, nil
+	}
+	capacity := ratelimit.NewCapacity(denom, limit.TimeBasedLimit, limit.TimePeriod, supply.TimeLimitedCurrentSupply.Amount.Add(supply.IncomingSupply.Amount))
+	capacity.Remaining = sdk.MinInt(capacity.Remaining, absolute.Remaining)
+	return capacity, nil
+}
+
+// UpdateTimeBasedSupplyLimits removes issuance that is no longer within the rate limit's window from each asset's time-limited supply.
+// The time-limited supply of assets that aren't time limited is reset.
 func (k Keeper) UpdateTimeBasedSupplyLimits(ctx sdk.Context) {
 	assets, found := k.GetAssets(ctx)
 	if !found {
 		return
 	}
-	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
-	if !found {
-		previousBlockTime = ctx.BlockTime()
-		k.SetPreviousBlockTime(ctx, previousBlockTime)
-	}
-	timeElapsed := ctx.BlockTime().Sub(previousBlockTime)
 	for _, asset := range assets {
 		supply, found := k.GetAssetSupply(ctx, asset.Denom)
 		// if a new asset has been added by governance, create a new asset supply for it in the store
 		if !found {
 			supply = k.CreateNewAssetSupply(ctx, asset.Denom)
 		}
-		newTimeElapsed := supply.TimeElapsed + timeElapsed
-		if asset.SupplyLimit.TimeLimited && newTimeElapsed < asset.SupplyLimit.TimePeriod {
-			supply.TimeElapsed = newTimeElapsed
+		if asset.SupplyLimit.TimeLimited {
+			expired := k.window.Prune(ctx, asset.Denom, asset.SupplyLimit.TimePeriod)
+			supply.TimeLimitedCurrentSupply = supply.TimeLimitedCurrentSupply.Sub(sdk.NewCoin(asset.Denom, expired))
 		} else {
-			supply.TimeElapsed = time.Duration(0)
+			k.window.Clear(ctx, asset.Denom)
 			supply.TimeLimitedCurrentSupply = sdk.NewCoin(asset.Denom, sdk.ZeroInt())
 		}
+		supply.TimeElapsed = time.Duration(0)
 		k.SetAssetSupply(ctx, supply, asset.Denom)
 	}
 	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
@@ -13,6 +13,7 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/bep3/keeper"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
@@ -545,7 +546,7 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 			args{
 				asset:          "inc",
 				duration:       time.Second,
-				expectedSupply: types.NewAssetSupply(c("inc", 10), c("inc", 5), c("inc", 5), c("inc", 0), time.Second),
+				expectedSupply: types.NewAssetSupply(c("inc", 10), c("inc", 5), c("inc", 5), c("inc", 0), time.Duration(0)),
 			},
 			errArgs{
 				expectPanic: false,
@@ -557,7 +558,7 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 			args{
 				asset:          "inc",
 				duration:       time.Minute * 30,
-				expectedSupply: types.NewAssetSupply(c("inc", 10), c("inc", 5), c("inc", 5), c("inc", 0), time.Minute*30),
+				expectedSupply: types.NewAssetSupply(c("inc", 10), c("inc", 5), c("inc", 5), c("inc", 0), time.Duration(0)),
 			},
 			errArgs{
 				expectPanic: false,
@@ -617,7 +618,7 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 			args{
 				asset:          "lol",
 				duration:       time.Second,
-				expectedSupply: types.NewAssetSupply(c("lol", 0), c("lol", 0), c("lol", 0), c("lol", 0), time.Second),
+				expectedSupply: types.NewAssetSupply(c("lol", 0), c("lol", 0), c("lol", 0), c("lol", 0), time.Duration(0)),
 			},
 			errArgs{
 				expectPanic: false,
@@ -700,6 +701,65 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 	}
 }
 
+func (suite *AssetTestSuite) TestRollingWindowSupplyLimit() {
+	nextBlock := func(timeElapsed time.Duration) {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(timeElapsed))
+		suite.keeper.UpdateTimeBasedSupplyLimits(suite.ctx)
+	}
+	timeLimitedSupply := func() sdk.Coin {
+		supply, _ := suite.keeper.GetAssetSupply(suite.ctx, "inc")
+		return supply.TimeLimitedCurrentSupply
+	}
+
+	// the time-based limit of 15 applies over any hour, not over fixed hourly periods
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c("inc", 10)))
+	nextBlock(time.Minute * 59)
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c("inc", 5)))
+	nextBlock(time.Minute * 2)
+	suite.Require().Equal(c("inc", 5), timeLimitedSupply())
+	suite.Require().Error(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c("inc", 11)))
+
+	// swaps out of the chain cancel the most recent swaps in
+	suite.Require().NoError(suite.keeper.DecrementCurrentAssetSupply(suite.ctx, c("inc", 8)))
+	suite.Require().Equal(c("inc", 0), timeLimitedSupply())
+
+	// the capacity counts incoming swaps against both limits
+	capacity, err := suite.keeper.GetCapacity(suite.ctx, "inc")
+	suite.Require().NoError(err)
+	suite.Require().Equal(ratelimit.NewCapacity("inc", sdk.NewInt(15), time.Hour, sdk.NewInt(10)), capacity)
+	capacity, err = suite.keeper.GetCapacity(suite.ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(ratelimit.NewCapacity("bnb", sdk.NewInt(50), time.Duration(0), sdk.NewInt(45)), capacity)
+}
+
+func (suite *AssetTestSuite) TestRateLimitBucketsGenesis() {
+	swappedAt := suite.ctx.BlockTime()
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c("inc", 10)))
+	suite.ctx = suite.ctx.WithBlockTime(swappedAt.Add(time.Minute * 30))
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c("inc", 4)))
+
+	buckets := suite.keeper.GetRateLimitBuckets(suite.ctx)
+	suite.Require().Equal(ratelimit.Buckets{
+		ratelimit.NewBucket("inc", swappedAt, sdk.NewInt(10)),
+		ratelimit.NewBucket("inc", swappedAt.Add(time.Minute*30), sdk.NewInt(4)),
+	}, buckets)
+	supply, found := suite.keeper.GetAssetSupply(suite.ctx, "inc")
+	suite.Require().True(found)
+
+	// importing the buckets into a new chain keeps the times assets were swapped in at
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockTime(swappedAt.Add(time.Minute * 30))
+	suite.keeper.SetAssetSupply(suite.ctx, supply, "inc")
+	for _, bucket := range buckets {
+		suite.keeper.SetRateLimitBucket(suite.ctx, bucket)
+	}
+	suite.ctx = suite.ctx.WithBlockTime(swappedAt.Add(time.Hour))
+	suite.keeper.UpdateTimeBasedSupplyLimits(suite.ctx)
+	supply, found = suite.keeper.GetAssetSupply(suite.ctx, "inc")
+	suite.Require().True(found)
+	suite.Require().Equal(c("inc", 4), supply.TimeLimitedCurrentSupply)
+}
+
 func TestAssetTestSuite(t *testing.T) {
 	suite.Run(t, new(AssetTestSuite))
 }
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// GetDeputyBond returns the bond deposited by a deputy
+func (k Keeper) GetDeputyBond(ctx sdk.Context, deputy sdk.AccAddress) (types.DeputyBond, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.DeputyBondKey(deputy))
+	if bz == nil {
+		return types.DeputyBond{}, false
+	}
+	var bond types.DeputyBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+	return bond, true
+}
+
+// SetDeputyBond stores a deputy's bond, removing it if the bond is empty
+func (k Keeper) SetDeputyBond(ctx sdk.Context, bond types.DeputyBond) {
+	store := ctx.KVStore(k.key)
+	if bond.Amount.Empty() {
+		store.Delete(types.DeputyBondKey(bond.DeputyAddress))
+		return
+	}
+	store.Set(types.DeputyBondKey(bond.DeputyAddress), k.cdc.MustMarshalBinaryBare(bond))
+}
+
+// IterateDeputyBonds iterates over all deputy bonds and performs a callback function
+func (k Keeper) IterateDeputyBonds(ctx sdk.Context, cb func(bond types.DeputyBond) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyBondPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.DeputyBond
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetDeputyBonds returns all deputy bonds from the store
+func (k Keeper) GetDeputyBonds(ctx sdk.Context) types.DeputyBonds {
+	bonds := types.DeputyBonds{}
+	k.IterateDeputyBonds(ctx, func(bond types.DeputyBond) (stop bool) {
+		bonds = append(bonds, bond)
+		return false
+	})
+	return bonds
+}
+
+// DepositDeputyBond transfers coins from a deputy to the module account and adds them to the deputy's bond.
+// Bonds can't be deposited in bep3 assets, as slashing burns the bond outside of the assets' supply accounting.
+func (k Keeper) DepositDeputyBond(ctx sdk.Context, deputy sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if _, err := k.GetAsset(ctx, coin.Denom); err == nil {
+			return sdkerrors.Wrap(types.ErrInvalidBondDenom, coin.Denom)
+		}
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, deputy, types.ModuleName, amount); err != nil {
+		return err
+	}
+	bond, found := k.GetDeputyBond(ctx, deputy)
+	if !found {
+		bond = types.NewDeputyBond(deputy, sdk.NewCoins())
+	}
+	bond.Amount = bond.Amount.Add(amount...)
+	k.SetDeputyBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeputyBond,
+			sdk.NewAttribute(types.AttributeKeyDeputy, deputy.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawDeputyBond returns coins from a deputy's bond. Deputies, including retiring deputies in their grace
+// period, cannot withdraw below the minimum bond of the assets they are deputies for.
+func (k Keeper) WithdrawDeputyBond(ctx sdk.Context, deputy sdk.AccAddress, amount sdk.Coins) error {
+	bond, found := k.GetDeputyBond(ctx, deputy)
+	if !found {
+		return sdkerrors.Wrap(types.ErrDeputyBondNotFound, deputy.String())
+	}
+	remaining, isNegative := bond.Amount.SafeSub(amount)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrInsufficientDeputyBond, "withdraw amount %s exceeds bond %s", amount, bond.Amount)
+	}
+	minBond := k.GetRequiredDeputyBond(ctx, deputy)
+	if !remaining.IsAllGTE(minBond) {
+		return sdkerrors.Wrapf(types.ErrInsufficientDeputyBond, "remaining bond %s is below minimum %s", remaining, minBond)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deputy, amount); err != nil {
+		return err
+	}
+	bond.Amount = remaining
+	k.SetDeputyBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeputyUnbond,
+			sdk.NewAttribute(types.AttributeKeyDeputy, deputy.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// SlashDeputyBond burns a fraction of a deputy's bond and returns the amount burned
+func (k Keeper) SlashDeputyBond(ctx sdk.Context, deputy sdk.AccAddress, fraction sdk.Dec) (sdk.Coins, error) {
+	bond, found := k.GetDeputyBond(ctx, deputy)
+	if !found || fraction.IsNil() || !fraction.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+	slashed := sdk.NewCoins()
+	for _, coin := range bond.Amount {
+		amount := coin.Amount.ToDec().Mul(fraction).TruncateInt()
+		if amount.IsPositive() {
+			slashed = slashed.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	if slashed.Empty() {
+		return slashed, nil
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+		return sdk.NewCoins(), err
+	}
+	bond.Amount = bond.Amount.Sub(slashed)
+	k.SetDeputyBond(ctx, bond)
+	return slashed, nil
+}
+
+// GetRequiredDeputyBond returns the bond an address must hold to cover the minimum bond of each asset
+// it is an active or retiring deputy for
+func (k Keeper) GetRequiredDeputyBond(ctx sdk.Context, deputy sdk.AccAddress) sdk.Coins {
+	required := sdk.NewCoins()
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		if !asset.IsDeputy(deputy) && !isRetiringDeputy(ctx, asset, deputy) {
+			continue
+		}
+		for _, coin := range asset.MinDeputyBond {
+			if coin.Amount.GT(required.AmountOf(coin.Denom)) {
+				required = required.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(required.AmountOf(coin.Denom))))
+			}
+		}
+	}
+	return required
+}
+
+// HasSufficientDeputyBond returns true if the deputy's bond covers an asset's minimum deputy bond
+func (k Keeper) HasSufficientDeputyBond(ctx sdk.Context, asset types.AssetParam, deputy sdk.AccAddress) bool {
+	if asset.MinDeputyBond.Empty() {
+		return true
+	}
+	bond, found := k.GetDeputyBond(ctx, deputy)
+	if !found {
+		return false
+	}
+	return bond.Amount.IsAllGTE(asset.MinDeputyBond)
+}
+
+// isRetiringDeputy returns true if the address is a retiring deputy of the asset that is still in its grace period
+func isRetiringDeputy(ctx sdk.Context, asset types.AssetParam, address sdk.AccAddress) bool {
+	for _, retiring := range asset.RetiringDeputies {
+		if retiring.Address.Equals(address) && retiring.IsActive(ctx.BlockTime()) {
+			return true
+		}
+	}
+	return false
+}
+
+// ------------------------------------------
+//				Swap Fees
+// ------------------------------------------
+
+// GetSwapFee returns the fee charged on an outgoing swap
+func (k Keeper) GetSwapFee(ctx sdk.Context, swapID []byte) (types.SwapFee, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapFeePrefix)
+	bz := store.Get(swapID)
+	if bz == nil {
+		return types.SwapFee{}, false
+	}
+	var fee types.SwapFee
+	k.cdc.MustUnmarshalBinaryBare(bz, &fee)
+	return fee, true
+}
+
+// SetSwapFee stores the fee charged on an outgoing swap
+func (k Keeper) SetSwapFee(ctx sdk.Context, fee types.SwapFee) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapFeePrefix)
+	store.Set(fee.SwapID, k.cdc.MustMarshalBinaryBare(fee))
+}
+
+// DeleteSwapFee removes the fee of an outgoing swap from the store
+func (k Keeper) DeleteSwapFee(ctx sdk.Context, swapID []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapFeePrefix)
+	store.Delete(swapID)
+}
+
+// IterateSwapFees iterates over the fees of all open or expired outgoing swaps and performs a callback function
+func (k Keeper) IterateSwapFees(ctx sdk.Context, cb func(fee types.SwapFee) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapFeePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.SwapFee
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fee)
+		if cb(fee) {
+			break
+		}
+	}
+}
+
+// GetSwapFees returns the fees of all open or expired outgoing swaps from the store
+func (k Keeper) GetSwapFees(ctx sdk.Context) types.SwapFees {
+	fees := types.SwapFees{}
+	k.IterateSwapFees(ctx, func(fee types.SwapFee) (stop bool) {
+		fees = append(fees, fee)
+		return false
+	})
+	return fees
+}
+
+// GetCollectedFees returns the swap fees that have been paid to a deputy
+func (k Keeper) GetCollectedFees(ctx sdk.Context, deputy sdk.AccAddress) (types.CollectedFees, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.CollectedFeesKey(deputy))
+	if bz == nil {
+		return types.CollectedFees{}, false
+	}
+	var fees types.CollectedFees
+	k.cdc.MustUnmarshalBinaryBare(bz, &fees)
+	return fees, true
+}
+
+// SetCollectedFees stores the swap fees that have been paid to a deputy
+func (k Keeper) SetCollectedFees(ctx sdk.Context, fees types.CollectedFees) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.CollectedFeesKey(fees.DeputyAddress), k.cdc.MustMarshalBinaryBare(fees))
+}
+
+// IterateCollectedFees iterates over the fees collected by each deputy and performs a callback function
+func (k Keeper) IterateCollectedFees(ctx sdk.Context, cb func(fees types.CollectedFees) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CollectedFeesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var fees types.CollectedFees
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fees)
+		if cb(fees) {
+			break
+		}
+	}
+}
+
+// GetAllCollectedFees returns the fees collected by each deputy from the store
+func (k Keeper) GetAllCollectedFees(ctx sdk.Context) types.CollectedFeesList {
+	feesList := types.CollectedFeesList{}
+	k.IterateCollectedFees(ctx, func(fees types.CollectedFees) (stop bool) {
+		feesList = append(feesList, fees)
+		return false
+	})
+	return feesList
+}
+
+// collectSwapFee pays the fee of a claimed outgoing swap to the deputy that received the swap
+func (k Keeper) collectSwapFee(ctx sdk.Context, swap types.AtomicSwap, fee types.SwapFee) error {
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.Recipient, sdk.NewCoins(fee.Amount))
+	if err != nil {
+		return err
+	}
+	collected, found := k.GetCollectedFees(ctx, swap.Recipient)
+	if !found {
+		collected = types.NewCollectedFees(swap.Recipient, sdk.NewCoins())
+	}
+	collected.Amount = collected.Amount.Add(fee.Amount)
+	k.SetCollectedFees(ctx, collected)
+	k.DeleteSwapFee(ctx, fee.SwapID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCollectSwapFee,
+			sdk.NewAttribute(types.AttributeKeyDeputy, swap.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(fee.SwapID)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.Amount.String()),
+		),
+	)
+	return nil
+}
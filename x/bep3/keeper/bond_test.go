@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3"
+	"github.com/kava-labs/kava/x/bep3/keeper"
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+const BOND_DENOM = "ukava"
+
+func (suite *AtomicSwapTestSuite) fundBond(addr sdk.AccAddress, amount int64) {
+	sk := suite.app.GetSupplyKeeper()
+	suite.Require().NoError(sk.MintCoins(suite.ctx, types.ModuleName, cs(c(BOND_DENOM, amount))))
+	suite.Require().NoError(sk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addr, cs(c(BOND_DENOM, amount))))
+}
+
+func (suite *AtomicSwapTestSuite) TestDeputyBond() {
+	ak := suite.app.GetAccountKeeper()
+	suite.fundBond(suite.deputy, 1000)
+	asset, err := suite.keeper.GetAsset(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(err)
+	asset.MinDeputyBond = cs(c(BOND_DENOM, 500))
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	// bonds can't be posted in the denom of a bep3 asset
+	err = suite.keeper.DepositDeputyBond(suite.ctx, suite.deputy, cs(c(BNB_DENOM, 500)))
+	suite.Require().True(errors.Is(err, types.ErrInvalidBondDenom))
+
+	// swaps can't be created until the deputy holds the min bond
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0],
+		types.DefaultMinBlockLock, suite.deputy, suite.addrs[1], TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, 50000)), true)
+	suite.Require().True(errors.Is(err, types.ErrInsufficientDeputyBond))
+
+	suite.Require().NoError(suite.keeper.DepositDeputyBond(suite.ctx, suite.deputy, cs(c(BOND_DENOM, 600))))
+	bond, found := suite.keeper.GetDeputyBond(suite.ctx, suite.deputy)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c(BOND_DENOM, 600)), bond.Amount)
+	suite.Require().Equal(i(400), ak.GetAccount(suite.ctx, suite.deputy).GetCoins().AmountOf(BOND_DENOM))
+
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0],
+		types.DefaultMinBlockLock, suite.deputy, suite.addrs[1], TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, 50000)), true)
+	suite.Require().NoError(err)
+
+	// withdrawals can't leave the bond below the min bond
+	err = suite.keeper.WithdrawDeputyBond(suite.ctx, suite.deputy, cs(c(BOND_DENOM, 101)))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientDeputyBond))
+	suite.Require().NoError(suite.keeper.WithdrawDeputyBond(suite.ctx, suite.deputy, cs(c(BOND_DENOM, 100))))
+	suite.Require().Equal(i(500), ak.GetAccount(suite.ctx, suite.deputy).GetCoins().AmountOf(BOND_DENOM))
+
+	// the full bond can be withdrawn once the address is no longer a deputy
+	asset.DeputyAddress = suite.addrs[19]
+	suite.keeper.SetAsset(suite.ctx, asset)
+	suite.Require().NoError(suite.keeper.WithdrawDeputyBond(suite.ctx, suite.deputy, cs(c(BOND_DENOM, 500))))
+	_, found = suite.keeper.GetDeputyBond(suite.ctx, suite.deputy)
+	suite.Require().False(found)
+}
+
+func (suite *AtomicSwapTestSuite) TestSlashDeputyBond() {
+	sk := suite.app.GetSupplyKeeper()
+	suite.fundBond(suite.deputy, 1000)
+	suite.Require().NoError(suite.keeper.DepositDeputyBond(suite.ctx, suite.deputy, cs(c(BOND_DENOM, 1000))))
+	supplyPre := sk.GetSupply(suite.ctx).GetTotal().AmountOf(BOND_DENOM)
+
+	// slashing a deputy without a bond fails
+	proposal := types.NewDeputyBondSlashProposal("A Title", "A description of the deputy's misbehavior.", suite.addrs[1], sdk.MustNewDecFromStr("0.5"))
+	err := keeper.HandleDeputyBondSlashProposal(suite.ctx, suite.keeper, proposal)
+	suite.Require().True(errors.Is(err, types.ErrDeputyBondNotFound))
+
+	// slashed coins are burned
+	proposal.Deputy = suite.deputy
+	proposal.Fraction = sdk.MustNewDecFromStr("0.25")
+	suite.Require().NoError(keeper.HandleDeputyBondSlashProposal(suite.ctx, suite.keeper, proposal))
+	bond, found := suite.keeper.GetDeputyBond(suite.ctx, suite.deputy)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c(BOND_DENOM, 750)), bond.Amount)
+	suite.Require().Equal(supplyPre.Sub(i(250)), sk.GetSupply(suite.ctx).GetTotal().AmountOf(BOND_DENOM))
+
+	// slashing the full bond removes it
+	proposal.Fraction = sdk.OneDec()
+	suite.Require().NoError(keeper.HandleDeputyBondSlashProposal(suite.ctx, suite.keeper, proposal))
+	_, found = suite.keeper.GetDeputyBond(suite.ctx, suite.deputy)
+	suite.Require().False(found)
+}
+
+func (suite *AtomicSwapTestSuite) TestOutgoingSwapFee() {
+	ak := suite.app.GetAccountKeeper()
+	sender := suite.addrs[1]
+	amount := cs(c(BNB_DENOM, 50000))
+	balance := func(addr sdk.AccAddress) sdk.Int {
+		return ak.GetAccount(suite.ctx, addr).GetCoins().AmountOf(BNB_DENOM)
+	}
+	asset, err := suite.keeper.GetAsset(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(err)
+	asset.FeeSchedule = types.FeeSchedule{
+		types.NewFeeTier(sdk.ZeroInt(), i(100), sdk.MustNewDecFromStr("0.01"), i(200), i(10000)),
+	}
+	suite.keeper.SetAsset(suite.ctx, asset)
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 100000)))
+
+	create := func(index int) {
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[index], suite.timestamps[index],
+			types.DefaultMinBlockLock, sender, suite.deputy, TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+		suite.Require().NoError(err)
+	}
+
+	// the fee is recorded when the swap is created
+	create(0)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, TestSenderOtherChain)
+	fee, found := suite.keeper.GetSwapFee(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(c(BNB_DENOM, 600), fee.Amount)
+
+	// the fee is paid to the deputy on claim and only the rest of the amount is burned
+	deputyPre := balance(suite.deputy)
+	supplyPre, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.deputy, swapID, suite.randomNumbers[0]))
+	suite.Require().Equal(deputyPre.Add(i(600)), balance(suite.deputy))
+	supplyPost, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Require().Equal(supplyPre.CurrentSupply.Sub(c(BNB_DENOM, 49400)), supplyPost.CurrentSupply)
+	suite.Require().True(supplyPre.OutgoingSupply.Sub(amount[0]).IsEqual(supplyPost.OutgoingSupply))
+	_, found = suite.keeper.GetSwapFee(suite.ctx, swapID)
+	suite.Require().False(found)
+	collected, found := suite.keeper.GetCollectedFees(suite.ctx, suite.deputy)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c(BNB_DENOM, 600)), collected.Amount)
+
+	// refunded swaps return the full amount, including the fee
+	create(1)
+	swapID = types.CalculateSwapID(suite.randomNumberHashes[1], sender, TestSenderOtherChain)
+	senderPre := balance(sender)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(types.DefaultMinBlockLock))
+	bep3.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().NoError(suite.keeper.RefundAtomicSwap(suite.ctx, sender, swapID))
+	suite.Require().Equal(senderPre.Add(amount[0].Amount), balance(sender))
+	_, found = suite.keeper.GetSwapFee(suite.ctx, swapID)
+	suite.Require().False(found)
+}
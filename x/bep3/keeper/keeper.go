@@ -11,17 +11,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
 // Keeper of the bep3 store
 type Keeper struct {
-	key           sdk.StoreKey
-	cdc           *codec.Codec
-	paramSubspace subspace.Subspace
-	supplyKeeper  types.SupplyKeeper
-	accountKeeper types.AccountKeeper
-	Maccs         map[string]bool
+	key             sdk.StoreKey
+	cdc             *codec.Codec
+	paramSubspace   subspace.Subspace
+	supplyKeeper    types.SupplyKeeper
+	accountKeeper   types.AccountKeeper
+	sendRestriction types.SendRestriction
+	Maccs           map[string]bool
+	window          ratelimit.Window
 }
 
 // NewKeeper creates a bep3 keeper
@@ -38,10 +41,28 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.SupplyKeeper, ak typ
 		supplyKeeper:  sk,
 		accountKeeper: ak,
 		Maccs:         maccs,
+		window:        ratelimit.NewWindow(cdc, key, types.RateLimitPrefix),
 	}
 	return keeper
 }
 
+// SetSendRestriction sets the restriction checked when coins move between accounts in peer to peer swaps
+func (k *Keeper) SetSendRestriction(sr types.SendRestriction) *Keeper {
+	if k.sendRestriction != nil {
+		panic("cannot set bep3 send restriction twice")
+	}
+	k.sendRestriction = sr
+	return k
+}
+
+// validateTransfer checks a transfer against the send restriction, if one is set
+func (k Keeper) validateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if k.sendRestriction == nil {
+		return nil
+	}
+	return k.sendRestriction.ValidateTransfer(ctx, from, to, coins)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -69,6 +90,7 @@ func (k Keeper) SetAtomicSwap(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapKeyPrefix)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(atomicSwap)
 	store.Set(atomicSwap.GetSwapID(), bz)
+	k.insertIntoLookupIndexes(ctx, atomicSwap)
 }
 
 // GetAtomicSwap gets an AtomicSwap from the store.
@@ -85,8 +107,11 @@ func (k Keeper) GetAtomicSwap(ctx sdk.Context, swapID []byte) (types.AtomicSwap,
 	return atomicSwap, true
 }
 
-// RemoveAtomicSwap removes an AtomicSwap from the AtomicSwapKeyPrefix.
+// RemoveAtomicSwap removes an AtomicSwap from the AtomicSwapKeyPrefix, and from the lookup indexes.
 func (k Keeper) RemoveAtomicSwap(ctx sdk.Context, swapID []byte) {
+	if atomicSwap, found := k.GetAtomicSwap(ctx, swapID); found {
+		k.removeFromLookupIndexes(ctx, atomicSwap)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapKeyPrefix)
 	store.Delete(swapID)
 }
@@ -192,6 +217,77 @@ func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCuto
 	}
 }
 
+// ------------------------------------------
+//			Atomic Swap Lookup Indexes
+// ------------------------------------------
+
+// insertIntoLookupIndexes adds a swap to the indexes by random number hash, sender on the other chain and expiry height.
+// The indexed fields never change, so swaps stay in these indexes until they are removed from the store.
+func (k Keeper) insertIntoLookupIndexes(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	swapID := atomicSwap.GetSwapID()
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByRandomNumberHashPrefix)
+	store.Set(types.GetAtomicSwapByRandomNumberHashKey(atomicSwap.RandomNumberHash, swapID), swapID)
+	if atomicSwap.SenderOtherChain != "" {
+		store = prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapBySenderOtherChainPrefix)
+		store.Set(types.GetAtomicSwapBySenderOtherChainKey(atomicSwap.SenderOtherChain, swapID), swapID)
+	}
+	store = prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByExpiryPrefix)
+	store.Set(types.GetAtomicSwapByHeightKey(atomicSwap.ExpireHeight, swapID), swapID)
+}
+
+// removeFromLookupIndexes removes a swap from the indexes by random number hash, sender on the other chain and expiry height.
+func (k Keeper) removeFromLookupIndexes(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	swapID := atomicSwap.GetSwapID()
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByRandomNumberHashPrefix)
+	store.Delete(types.GetAtomicSwapByRandomNumberHashKey(atomicSwap.RandomNumberHash, swapID))
+	store = prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapBySenderOtherChainPrefix)
+	store.Delete(types.GetAtomicSwapBySenderOtherChainKey(atomicSwap.SenderOtherChain, swapID))
+	store = prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByExpiryPrefix)
+	store.Delete(types.GetAtomicSwapByHeightKey(atomicSwap.ExpireHeight, swapID))
+}
+
+// IterateAtomicSwapsByRandomNumberHash provides an iterator over the AtomicSwaps locked with a random number hash.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByRandomNumberHash(ctx sdk.Context, randomNumberHash []byte, cb func(atomicSwap types.AtomicSwap) (stop bool)) {
+	k.iterateIndexedAtomicSwaps(ctx, prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByRandomNumberHashPrefix), randomNumberHash, cb)
+}
+
+// IterateAtomicSwapsBySenderOtherChain provides an iterator over the AtomicSwaps created by an address on the other chain.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsBySenderOtherChain(ctx sdk.Context, senderOtherChain string, cb func(atomicSwap types.AtomicSwap) (stop bool)) {
+	k.iterateIndexedAtomicSwaps(ctx, prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapBySenderOtherChainPrefix), types.GetSenderOtherChainPrefix(senderOtherChain), cb)
+}
+
+// IterateAtomicSwapsByExpiry provides an iterator over the AtomicSwaps with an expire height in an inclusive range,
+// ordered by expire height. For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByExpiry(ctx sdk.Context, minHeight, maxHeight uint64, cb func(atomicSwap types.AtomicSwap) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByExpiryPrefix)
+	iterator := store.Iterator(
+		sdk.Uint64ToBigEndian(minHeight),
+		sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(maxHeight)),
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		atomicSwap, found := k.GetAtomicSwap(ctx, iterator.Value())
+		if found && cb(atomicSwap) {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateIndexedAtomicSwaps(ctx sdk.Context, store prefix.Store, keyPrefix []byte, cb func(atomicSwap types.AtomicSwap) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		atomicSwap, found := k.GetAtomicSwap(ctx, iterator.Value())
+		if found && cb(atomicSwap) {
+			break
+		}
+	}
+}
+
 // ------------------------------------------
 //				Asset Supplies
 // ------------------------------------------
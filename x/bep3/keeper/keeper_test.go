@@ -144,6 +144,50 @@ func (suite *KeeperTestSuite) TestGetAllAtomicSwaps() {
 	suite.Equal(4, len(res))
 }
 
+func (suite *KeeperTestSuite) TestAtomicSwapLookupIndexes() {
+	suite.ResetChain()
+
+	// swaps share a random number hash, but have different senders and expire heights
+	swapA := atomicSwap(suite.ctx, 1)
+	swapB := swapA
+	swapB.Sender, swapB.SenderOtherChain, swapB.ExpireHeight = TestUser2, TestRecipientOtherChain, swapA.ExpireHeight+10
+	swapC := atomicSwap(suite.ctx, 2)
+	swapC.ExpireHeight = swapA.ExpireHeight + 20
+	for _, s := range []types.AtomicSwap{swapA, swapB, swapC} {
+		suite.keeper.SetAtomicSwap(suite.ctx, s)
+	}
+
+	collect := func(iterate func(cb func(types.AtomicSwap) bool)) (swaps types.AtomicSwaps) {
+		iterate(func(s types.AtomicSwap) bool {
+			swaps = append(swaps, s)
+			return false
+		})
+		return swaps
+	}
+
+	suite.ElementsMatch(types.AtomicSwaps{swapA, swapB}, collect(func(cb func(types.AtomicSwap) bool) {
+		suite.keeper.IterateAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash, cb)
+	}))
+	suite.ElementsMatch(types.AtomicSwaps{swapA, swapC}, collect(func(cb func(types.AtomicSwap) bool) {
+		suite.keeper.IterateAtomicSwapsBySenderOtherChain(suite.ctx, TestSenderOtherChain, cb)
+	}))
+	suite.Equal(types.AtomicSwaps{swapB, swapC}, collect(func(cb func(types.AtomicSwap) bool) {
+		suite.keeper.IterateAtomicSwapsByExpiry(suite.ctx, swapB.ExpireHeight, swapC.ExpireHeight, cb)
+	}))
+
+	// removed swaps are removed from the indexes
+	suite.keeper.RemoveAtomicSwap(suite.ctx, swapA.GetSwapID())
+	suite.Equal(types.AtomicSwaps{swapB}, collect(func(cb func(types.AtomicSwap) bool) {
+		suite.keeper.IterateAtomicSwapsByRandomNumberHash(suite.ctx, swapA.RandomNumberHash, cb)
+	}))
+	suite.Equal(types.AtomicSwaps{swapC}, collect(func(cb func(types.AtomicSwap) bool) {
+		suite.keeper.IterateAtomicSwapsBySenderOtherChain(suite.ctx, TestSenderOtherChain, cb)
+	}))
+	suite.Equal(types.AtomicSwaps{swapB, swapC}, collect(func(cb func(types.AtomicSwap) bool) {
+		suite.keeper.IterateAtomicSwapsByExpiry(suite.ctx, 0, swapC.ExpireHeight, cb)
+	}))
+}
+
 func (suite *KeeperTestSuite) TestInsertIntoByBlockIndex() {
 	suite.ResetChain()
 
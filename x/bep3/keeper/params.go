@@ -63,6 +63,15 @@ func (k Keeper) GetDeputyAddress(ctx sdk.Context, denom string) (sdk.AccAddress,
 	return asset.DeputyAddress, nil
 }
 
+// GetDeputyAddresses returns the addresses of all active deputies for the input denom
+func (k Keeper) GetDeputyAddresses(ctx sdk.Context, denom string) ([]sdk.AccAddress, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	return asset.GetDeputies(), nil
+}
+
 // GetFixedFee returns the fixed fee for incoming swaps
 func (k Keeper) GetFixedFee(ctx sdk.Context, denom string) (sdk.Int, error) {
 	asset, err := k.GetAsset(ctx, denom)
@@ -145,6 +154,7 @@ func (k Keeper) GetSupplyLimit(ctx sdk.Context, denom string) (types.SupplyLimit
 // ------------------------------------------
 
 // GetAuthorizedAddresses returns a list of addresses that have special authorization within this module, eg all the deputies.
+// Retiring deputies are included until the end of their grace period.
 func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	assetParams, found := k.GetAssets(ctx)
 	if !found {
@@ -155,12 +165,19 @@ func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	uniqueAddresses := map[string]bool{}
 
 	for _, ap := range assetParams {
-		a := ap.DeputyAddress
-		// de-dup addresses
-		if _, found := uniqueAddresses[a.String()]; !found {
-			addresses = append(addresses, a)
+		deputies := ap.GetDeputies()
+		for _, retiring := range ap.RetiringDeputies {
+			if retiring.IsActive(ctx.BlockTime()) {
+				deputies = append(deputies, retiring.Address)
+			}
+		}
+		for _, a := range deputies {
+			// de-dup addresses
+			if _, found := uniqueAddresses[a.String()]; !found {
+				addresses = append(addresses, a)
+			}
+			uniqueAddresses[a.String()] = true
 		}
-		uniqueAddresses[a.String()] = true
 	}
 	return addresses
 }
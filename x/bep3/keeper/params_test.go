@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -124,6 +125,26 @@ func (suite *ParamsTestSuite) TestGetAuthorizedAddresses() {
 	suite.Require().ElementsMatch(expectedAddresses, deputyAddresses)
 }
 
+func (suite *ParamsTestSuite) TestGetAuthorizedAddressesRotation() {
+	asset, err := suite.keeper.GetAsset(suite.ctx, "bnb")
+	suite.Require().NoError(err)
+	asset.DeputyAddress = suite.addrs[1]
+	asset.AdditionalDeputies = []sdk.AccAddress{suite.addrs[2]}
+	asset.RetiringDeputies = types.RetiringDeputies{
+		types.NewRetiringDeputy(suite.addrs[3], suite.ctx.BlockTime().Add(time.Hour)),
+		types.NewRetiringDeputy(suite.addrs[4], suite.ctx.BlockTime()),
+	}
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	deputies, err := suite.keeper.GetDeputyAddresses(suite.ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{suite.addrs[1], suite.addrs[2]}, deputies)
+
+	// retiring deputies are authorized until the end of their grace period
+	suite.Require().ElementsMatch(suite.addrs[:4], suite.keeper.GetAuthorizedAddresses(suite.ctx))
+	suite.Require().ElementsMatch(suite.addrs[:3], suite.keeper.GetAuthorizedAddresses(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))))
+}
+
 func (suite *AssetTestSuite) TestValidateLiveAsset() {
 	type args struct {
 		coin sdk.Coin
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// HandleDeputyBondSlashProposal is a handler for executing a passed deputy bond slash proposal
+func HandleDeputyBondSlashProposal(ctx sdk.Context, k Keeper, p types.DeputyBondSlashProposal) error {
+	if _, found := k.GetDeputyBond(ctx, p.Deputy); !found {
+		return sdkerrors.Wrap(types.ErrDeputyBondNotFound, p.Deputy.String())
+	}
+	slashed, err := k.SlashDeputyBond(ctx, p.Deputy, p.Fraction)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashDeputyBond,
+			sdk.NewAttribute(types.AttributeKeyDeputy, p.Deputy.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, p.Fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+		),
+	)
+	return nil
+}
@@ -23,8 +23,24 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAtomicSwap(ctx, req, keeper)
 		case types.QueryGetAtomicSwaps:
 			return queryAtomicSwaps(ctx, req, keeper)
+		case types.QueryGetPeerAtomicSwaps:
+			return queryPeerAtomicSwaps(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryGetCapacity:
+			return queryCapacity(ctx, req, keeper)
+		case types.QueryGetDeputyBonds:
+			return queryDeputyBonds(ctx, req, keeper)
+		case types.QueryGetSwapFee:
+			return querySwapFee(ctx, req, keeper)
+		case types.QueryGetCollectedFees:
+			return queryCollectedFees(ctx, req, keeper)
+		case types.QueryGetAtomicSwapsByRandomNumberHash:
+			return queryAtomicSwapsByRandomNumberHash(ctx, req, keeper)
+		case types.QueryGetAtomicSwapsBySenderOtherChain:
+			return queryAtomicSwapsBySenderOtherChain(ctx, req, keeper)
+		case types.QueryGetAtomicSwapsByExpiry:
+			return queryAtomicSwapsByExpiry(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -119,6 +135,207 @@ func queryAtomicSwaps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	return bz, nil
 }
 
+func queryPeerAtomicSwaps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryPeerAtomicSwaps
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	swaps := filterPeerAtomicSwaps(ctx, keeper.GetAllAtomicSwaps(ctx), params)
+
+	augmentedSwaps := types.AugmentedAtomicSwaps{}
+	for _, swap := range swaps {
+		augmentedSwaps = append(augmentedSwaps, types.NewAugmentedAtomicSwap(swap))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, augmentedSwaps)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryCapacity(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	// Decode request
+	var requestParams types.QueryCapacity
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	capacity, err := keeper.GetCapacity(ctx, requestParams.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, capacity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDeputyBonds(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryDeputyBonds
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bonds := types.DeputyBonds{}
+	keeper.IterateDeputyBonds(ctx, func(bond types.DeputyBond) (stop bool) {
+		if len(params.Deputy) == 0 || bond.DeputyAddress.Equals(params.Deputy) {
+			bonds = append(bonds, bond)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(bonds), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		bonds = types.DeputyBonds{}
+	} else {
+		bonds = bonds[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bonds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func querySwapFee(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAtomicSwapByID
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	atomicSwap, found := keeper.GetAtomicSwap(ctx, requestParams.SwapID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAtomicSwapNotFound, "%d", requestParams.SwapID)
+	}
+
+	// Swaps without a fee held on chain have a zero fee
+	fee, found := keeper.GetSwapFee(ctx, requestParams.SwapID)
+	if !found {
+		fee = types.NewSwapFee(atomicSwap.GetSwapID(), sdk.NewCoin(atomicSwap.Amount[0].Denom, sdk.ZeroInt()))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, fee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryCollectedFees(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryCollectedFees
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	feesList := types.CollectedFeesList{}
+	keeper.IterateCollectedFees(ctx, func(fees types.CollectedFees) (stop bool) {
+		if len(params.Deputy) == 0 || fees.DeputyAddress.Equals(params.Deputy) {
+			feesList = append(feesList, fees)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(feesList), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		feesList = types.CollectedFeesList{}
+	} else {
+		feesList = feesList[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, feesList)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAtomicSwapsByRandomNumberHash(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAtomicSwapsByRandomNumberHash
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if len(params.RandomNumberHash) != types.RandomNumberHashLength {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the length of random number hash should be %d", types.RandomNumberHashLength)
+	}
+
+	swaps := types.AtomicSwaps{}
+	keeper.IterateAtomicSwapsByRandomNumberHash(ctx, params.RandomNumberHash, func(atomicSwap types.AtomicSwap) (stop bool) {
+		swaps = append(swaps, atomicSwap)
+		return false
+	})
+	return marshalAtomicSwapsPage(swaps, params.Page, params.Limit)
+}
+
+func queryAtomicSwapsBySenderOtherChain(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAtomicSwapsBySenderOtherChain
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.SenderOtherChain == "" || len(params.SenderOtherChain) > types.MaxOtherChainAddrLength {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sender address on other chain must be between 1 and %d characters", types.MaxOtherChainAddrLength)
+	}
+
+	swaps := types.AtomicSwaps{}
+	keeper.IterateAtomicSwapsBySenderOtherChain(ctx, params.SenderOtherChain, func(atomicSwap types.AtomicSwap) (stop bool) {
+		swaps = append(swaps, atomicSwap)
+		return false
+	})
+	return marshalAtomicSwapsPage(swaps, params.Page, params.Limit)
+}
+
+func queryAtomicSwapsByExpiry(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAtomicSwapsByExpiry
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.MinExpireHeight > params.MaxExpireHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min expire height %d is greater than max expire height %d", params.MinExpireHeight, params.MaxExpireHeight)
+	}
+
+	swaps := types.AtomicSwaps{}
+	keeper.IterateAtomicSwapsByExpiry(ctx, params.MinExpireHeight, params.MaxExpireHeight, func(atomicSwap types.AtomicSwap) (stop bool) {
+		swaps = append(swaps, atomicSwap)
+		return false
+	})
+	return marshalAtomicSwapsPage(swaps, params.Page, params.Limit)
+}
+
+// marshalAtomicSwapsPage encodes a page of swaps found through an index
+func marshalAtomicSwapsPage(swaps types.AtomicSwaps, page, limit int) ([]byte, error) {
+	augmentedSwaps := types.AugmentedAtomicSwaps{}
+	start, end := client.Paginate(len(swaps), page, limit, 100)
+	if start >= 0 && end >= 0 {
+		for _, swap := range swaps[start:end] {
+			augmentedSwaps = append(augmentedSwaps, types.NewAugmentedAtomicSwap(swap))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, augmentedSwaps)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // query params in the bep3 store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	// Get params
@@ -174,3 +391,37 @@ func filterAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.Qu
 
 	return filteredSwaps
 }
+
+// filterPeerAtomicSwaps retrieves peer to peer atomic swaps filtered by a given set of params.
+// If no filters are provided, all peer to peer atomic swaps will be returned in paginated form.
+func filterPeerAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.QueryPeerAtomicSwaps) types.AtomicSwaps {
+	filteredSwaps := make(types.AtomicSwaps, 0, len(swaps))
+
+	for _, s := range swaps {
+		if s.Direction != types.PeerToPeer {
+			continue
+		}
+		if len(params.Sender) > 0 && !s.Sender.Equals(params.Sender) {
+			continue
+		}
+		if len(params.Recipient) > 0 && !s.Recipient.Equals(params.Recipient) {
+			continue
+		}
+		if params.Denom != "" && s.Amount.AmountOf(params.Denom).IsZero() {
+			continue
+		}
+		if params.Status.IsValid() && s.Status != params.Status {
+			continue
+		}
+		filteredSwaps = append(filteredSwaps, s)
+	}
+
+	start, end := client.Paginate(len(filteredSwaps), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		filteredSwaps = types.AtomicSwaps{}
+	} else {
+		filteredSwaps = filteredSwaps[start:end]
+	}
+
+	return filteredSwaps
+}
@@ -171,6 +171,111 @@ func (suite *QuerierTestSuite) TestQueryAtomicSwaps() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryPeerAtomicSwaps() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	var peerSwapIDs []string
+	for i := 0; i < 3; i++ {
+		timestamp := ts(0)
+		randomNumber, _ := types.GenerateSecureRandomNumber()
+		randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
+		amount := cs(c("ukava", 100))
+		if i == 2 {
+			amount = cs(c("bnb", 100))
+		}
+		err := suite.keeper.CreatePeerAtomicSwap(ctx, randomNumberHash, timestamp, 100,
+			suite.addrs[i], suite.addrs[i+1], "", "", amount, false)
+		suite.Require().NoError(err)
+		peerSwapIDs = append(peerSwapIDs, hex.EncodeToString(types.CalculateSwapID(randomNumberHash, suite.addrs[i], "")))
+	}
+
+	query := func(params types.QueryPeerAtomicSwaps) []string {
+		bz, err := suite.querier(ctx, []string{types.QueryGetPeerAtomicSwaps}, abci.RequestQuery{
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		})
+		suite.Require().NoError(err)
+		var swaps types.AugmentedAtomicSwaps
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &swaps))
+		ids := []string{}
+		for _, swap := range swaps {
+			suite.Require().Equal(types.PeerToPeer, swap.Direction)
+			ids = append(ids, swap.ID)
+		}
+		return ids
+	}
+
+	// deputy swaps are not included
+	suite.ElementsMatch(peerSwapIDs, query(types.NewQueryPeerAtomicSwaps(1, 100, nil, nil, "", types.NULL)))
+	suite.ElementsMatch(peerSwapIDs[1:2], query(types.NewQueryPeerAtomicSwaps(1, 100, suite.addrs[1], nil, "", types.NULL)))
+	suite.ElementsMatch(peerSwapIDs[1:2], query(types.NewQueryPeerAtomicSwaps(1, 100, nil, suite.addrs[2], "", types.NULL)))
+	suite.ElementsMatch(peerSwapIDs[2:], query(types.NewQueryPeerAtomicSwaps(1, 100, nil, nil, "bnb", types.NULL)))
+	suite.ElementsMatch(peerSwapIDs, query(types.NewQueryPeerAtomicSwaps(1, 100, nil, nil, "", types.Open)))
+	suite.Empty(query(types.NewQueryPeerAtomicSwaps(1, 100, nil, nil, "", types.Expired)))
+	suite.Len(query(types.NewQueryPeerAtomicSwaps(2, 2, nil, nil, "", types.NULL)), 1)
+}
+
+func (suite *QuerierTestSuite) TestQueryDeputyBonds() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	suite.Require().NoError(suite.keeper.DepositDeputyBond(ctx, suite.addrs[10], cs(c("ukava", 1000))))
+	suite.Require().NoError(suite.keeper.DepositDeputyBond(ctx, suite.addrs[0], cs(c("ukava", 500))))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetDeputyBonds}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryDeputyBonds(1, 100, nil)),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetDeputyBonds}, query)
+	suite.Require().NoError(err)
+	var bonds types.DeputyBonds
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &bonds))
+	suite.Len(bonds, 2)
+
+	// bonds can be filtered by deputy
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryDeputyBonds(1, 100, suite.addrs[10]))
+	bz, err = suite.querier(ctx, []string{types.QueryGetDeputyBonds}, query)
+	suite.Require().NoError(err)
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &bonds))
+	suite.Equal(types.DeputyBonds{types.NewDeputyBond(suite.addrs[10], cs(c("ukava", 1000)))}, bonds)
+}
+
+func (suite *QuerierTestSuite) TestQueryAtomicSwapsByIndex() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	swap, found := suite.keeper.GetAtomicSwap(ctx, suite.swapIDs[0])
+	suite.Require().True(found)
+
+	query := func(path string, params interface{}) types.AugmentedAtomicSwaps {
+		bz, err := suite.querier(ctx, []string{path}, abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, path}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		})
+		suite.Require().NoError(err)
+		var swaps types.AugmentedAtomicSwaps
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &swaps))
+		return swaps
+	}
+
+	swaps := query(types.QueryGetAtomicSwapsByRandomNumberHash, types.NewQueryAtomicSwapsByRandomNumberHash(1, 100, swap.RandomNumberHash))
+	suite.Equal(types.AugmentedAtomicSwaps{types.NewAugmentedAtomicSwap(swap)}, swaps)
+
+	swaps = query(types.QueryGetAtomicSwapsBySenderOtherChain, types.NewQueryAtomicSwapsBySenderOtherChain(1, 100, TestSenderOtherChain))
+	suite.Len(swaps, len(suite.swapIDs))
+	swaps = query(types.QueryGetAtomicSwapsBySenderOtherChain, types.NewQueryAtomicSwapsBySenderOtherChain(2, 4, TestSenderOtherChain))
+	suite.Len(swaps, 4)
+
+	swaps = query(types.QueryGetAtomicSwapsByExpiry, types.NewQueryAtomicSwapsByExpiry(1, 100, swap.ExpireHeight, swap.ExpireHeight))
+	suite.Len(swaps, len(suite.swapIDs))
+	swaps = query(types.QueryGetAtomicSwapsByExpiry, types.NewQueryAtomicSwapsByExpiry(1, 100, 0, swap.ExpireHeight-1))
+	suite.Empty(swaps)
+
+	// invalid params are rejected
+	_, err := suite.querier(ctx, []string{types.QueryGetAtomicSwapsByExpiry}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwapsByExpiry(1, 100, 10, 9)),
+	})
+	suite.Error(err)
+	_, err = suite.querier(ctx, []string{types.QueryGetAtomicSwapsByRandomNumberHash}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAtomicSwapsByRandomNumberHash(1, 100, swap.RandomNumberHash[:4])),
+	})
+	suite.Error(err)
+}
+
 func (suite *QuerierTestSuite) TestQueryParams() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	bz, err := suite.querier(ctx, []string{types.QueryGetParams}, abci.RequestQuery{})
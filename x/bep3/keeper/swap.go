@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -46,26 +48,34 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 		return sdkerrors.Wrapf(types.ErrInvalidAmount, "amount %d outside range [%s, %s]", amount[0].Amount, asset.MinSwapAmount, asset.MaxSwapAmount)
 	}
 
-	// Unix timestamp must be in range [-15 mins, 30 mins] of the current time
-	pastTimestampLimit := ctx.BlockTime().Add(time.Duration(-15) * time.Minute).Unix()
-	futureTimestampLimit := ctx.BlockTime().Add(time.Duration(30) * time.Minute).Unix()
-	if timestamp < pastTimestampLimit || timestamp >= futureTimestampLimit {
-		return sdkerrors.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
+	err = validateTimestamp(ctx, timestamp)
+	if err != nil {
+		return err
 	}
 
+	// Retiring deputies can't create new swaps
 	var direction types.SwapDirection
-	if sender.Equals(asset.DeputyAddress) {
-		if recipient.Equals(asset.DeputyAddress) {
-			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", asset.DeputyAddress)
+	if asset.IsDeputy(sender) {
+		if asset.IsDeputy(recipient) {
+			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", recipient)
 		}
 		direction = types.Incoming
 	} else {
-		if !recipient.Equals(asset.DeputyAddress) {
+		if !asset.IsDeputy(recipient) {
 			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy must be recipient for outgoing account: %s", recipient)
 		}
 		direction = types.Outgoing
 	}
 
+	// The deputy must hold the asset's minimum bond, so that it can be slashed for misbehavior
+	deputy := sender
+	if direction == types.Outgoing {
+		deputy = recipient
+	}
+	if !k.HasSufficientDeputyBond(ctx, asset, deputy) {
+		return sdkerrors.Wrapf(types.ErrInsufficientDeputyBond, "deputy %s must hold a bond of at least %s", deputy, asset.MinDeputyBond)
+	}
+
 	switch direction {
 	case types.Incoming:
 		// If recipient's account doesn't exist, register it in state so that the address can send
@@ -83,8 +93,9 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 		if heightSpan < asset.MinBlockLock || heightSpan > asset.MaxBlockLock {
 			return sdkerrors.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, asset.MinBlockLock, asset.MaxBlockLock)
 		}
-		// Amount in outgoing swaps must be able to pay the deputy's fixed fee.
-		if amount[0].Amount.LTE(asset.FixedFee.Add(asset.MinSwapAmount)) {
+		// Amount in outgoing swaps must be able to pay the deputy's fee.
+		fee := asset.GetOutgoingSwapFee(amount[0].Amount)
+		if amount[0].Amount.LTE(fee.Add(asset.MinSwapAmount)) {
 			return sdkerrors.Wrap(types.ErrInsufficientAmount, amount[0].String())
 		}
 		err = k.IncrementOutgoingAssetSupply(ctx, amount[0])
@@ -93,6 +104,13 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 		}
 		// Transfer coins to module - only needed for outgoing swaps
 		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+		if err != nil {
+			return err
+		}
+		// Fees from a fee schedule are held with the swap and paid to the deputy when it is claimed
+		if asset.HasFeeSchedule() && fee.IsPositive() {
+			k.SetSwapFee(ctx, types.NewSwapFee(swapID, sdk.NewCoin(amount[0].Denom, fee)))
+		}
 	default:
 		err = fmt.Errorf("invalid swap direction: %s", direction.String())
 	}
@@ -128,6 +146,83 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 	return nil
 }
 
+// CreatePeerAtomicSwap creates a new atomic swap between two accounts that doesn't involve a deputy.
+// The amount is transferred from the sender into escrow in the module account, and asset supplies are not changed.
+func (k Keeper) CreatePeerAtomicSwap(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan uint64,
+	sender sdk.AccAddress, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
+	amount sdk.Coins, crossChain bool) error {
+	// Confirm that this is not a duplicate swap
+	swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain)
+	_, found := k.GetAtomicSwap(ctx, swapID)
+	if found {
+		return sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
+	}
+
+	// Cannot send coins to a module account
+	if k.Maccs[recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient)
+	}
+	if sender.Equals(recipient) {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "sender cannot be recipient: %s", sender)
+	}
+
+	if len(amount) != 1 {
+		return fmt.Errorf("amount must contain exactly one coin")
+	}
+
+	err := validateTimestamp(ctx, timestamp)
+	if err != nil {
+		return err
+	}
+
+	if heightSpan < types.MinPeerSwapHeightSpan || heightSpan > types.MaxPeerSwapHeightSpan {
+		return sdkerrors.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, types.MinPeerSwapHeightSpan, types.MaxPeerSwapHeightSpan)
+	}
+
+	// The escrowed coins are transferred to the recipient when the swap is claimed
+	err = k.validateTransfer(ctx, sender, recipient, amount)
+	if err != nil {
+		return err
+	}
+
+	// Register the recipient's account so that it can send a claim swap tx
+	recipientAcc := k.accountKeeper.GetAccount(ctx, recipient)
+	if recipientAcc == nil {
+		newAcc := k.accountKeeper.NewAccountWithAddress(ctx, recipient)
+		k.accountKeeper.SetAccount(ctx, newAcc)
+	}
+
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	// Store the details of the swap
+	expireHeight := uint64(ctx.BlockHeight()) + heightSpan
+	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
+		recipient, senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, types.PeerToPeer)
+
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.InsertIntoByBlockIndex(ctx, atomicSwap)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
+			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
+			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+		),
+	)
+
+	return nil
+}
+
 // ClaimAtomicSwap validates a claim attempt, and if successful, sends the escrowed amount and closes the AtomicSwap.
 func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []byte, randomNumber []byte) error {
 	atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
@@ -175,12 +270,34 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
-		err = k.DecrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
+		// The swap fee is paid to the deputy and stays on chain, the rest of the amount leaves the chain
+		burned := atomicSwap.Amount
+		fee, hasFee := k.GetSwapFee(ctx, atomicSwap.GetSwapID())
+		if hasFee {
+			burned = burned.Sub(sdk.NewCoins(fee.Amount))
+		}
+		err = k.DecrementCurrentAssetSupply(ctx, burned[0])
 		if err != nil {
 			return err
 		}
 		// outgoing case  - coins should be burned
-		err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, atomicSwap.Amount)
+		err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, burned)
+		if err != nil {
+			return err
+		}
+		if hasFee {
+			err = k.collectSwapFee(ctx, atomicSwap, fee)
+			if err != nil {
+				return err
+			}
+		}
+	case types.PeerToPeer:
+		err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleName), atomicSwap.Recipient, atomicSwap.Amount)
+		if err != nil {
+			return err
+		}
+		// peer to peer case - escrowed coins are sent to the recipient
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, atomicSwap.Amount)
 		if err != nil {
 			return err
 		}
@@ -232,7 +349,12 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 		if err != nil {
 			return err
 		}
-		// Refund coins to original swap sender for outgoing swaps
+		// Refund coins to original swap sender for outgoing swaps, including any swap fee
+		k.DeleteSwapFee(ctx, atomicSwap.GetSwapID())
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
+	case types.PeerToPeer:
+		// Return escrowed coins to the sender. Refunds aren't checked against the send restriction,
+		// so that coins can't be locked in escrow.
 		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
 	default:
 		err = fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
@@ -264,6 +386,73 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 	return nil
 }
 
+// ClaimAtomicSwaps claims a batch of swaps. Each claim succeeds or fails independently, and its result is reported in
+// an event. It returns an error only if no swap in the batch could be claimed.
+func (k Keeper) ClaimAtomicSwaps(ctx sdk.Context, from sdk.AccAddress, claims []types.SwapClaim) error {
+	swapIDs := make([][]byte, len(claims))
+	for i, claim := range claims {
+		swapIDs[i] = claim.SwapID
+	}
+	return k.processBatch(ctx, types.EventTypeBatchClaimResult, swapIDs, func(batchCtx sdk.Context, i int) error {
+		return k.ClaimAtomicSwap(batchCtx, from, claims[i].SwapID, claims[i].RandomNumber)
+	})
+}
+
+// RefundAtomicSwaps refunds a batch of swaps. Each refund succeeds or fails independently, and its result is reported in
+// an event. It returns an error only if no swap in the batch could be refunded.
+func (k Keeper) RefundAtomicSwaps(ctx sdk.Context, from sdk.AccAddress, swapIDs []tmbytes.HexBytes) error {
+	ids := make([][]byte, len(swapIDs))
+	for i, swapID := range swapIDs {
+		ids[i] = swapID
+	}
+	return k.processBatch(ctx, types.EventTypeBatchRefundResult, ids, func(batchCtx sdk.Context, i int) error {
+		return k.RefundAtomicSwap(batchCtx, from, swapIDs[i])
+	})
+}
+
+// processBatch applies an operation to each swap of a batch in a cache context, so that a failed operation leaves no
+// partial state, and only the events of successful operations are emitted.
+func (k Keeper) processBatch(ctx sdk.Context, eventType string, swapIDs [][]byte, process func(batchCtx sdk.Context, i int) error) error {
+	processed := 0
+	var firstErr error
+	for i, swapID := range swapIDs {
+		batchCtx, write := ctx.CacheContext()
+		batchCtx = batchCtx.WithEventManager(sdk.NewEventManager())
+
+		result := sdk.NewEvent(eventType, sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(swapID)))
+		err := process(batchCtx, i)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			result = result.AppendAttributes(
+				sdk.NewAttribute(types.AttributeKeySuccess, "false"),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			)
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(batchCtx.EventManager().Events())
+			result = result.AppendAttributes(sdk.NewAttribute(types.AttributeKeySuccess, "true"))
+			processed++
+		}
+		ctx.EventManager().EmitEvent(result)
+	}
+	if processed == 0 {
+		return sdkerrors.Wrapf(types.ErrBatchFailed, "first error: %s", firstErr)
+	}
+	return nil
+}
+
+// validateTimestamp checks that a swap's unix timestamp is in range [-15 mins, 30 mins] of the current time
+func validateTimestamp(ctx sdk.Context, timestamp int64) error {
+	pastTimestampLimit := ctx.BlockTime().Add(time.Duration(-15) * time.Minute).Unix()
+	futureTimestampLimit := ctx.BlockTime().Add(time.Duration(30) * time.Minute).Unix()
+	if timestamp < pastTimestampLimit || timestamp >= futureTimestampLimit {
+		return sdkerrors.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
+	}
+	return nil
+}
+
 // UpdateExpiredAtomicSwaps finds all AtomicSwaps that are past (or at) their ending times and expires them.
 func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	var expiredSwapIDs []string
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -834,6 +835,156 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) TestMultipleDeputies() {
+	newDeputy, additional := suite.addrs[18], suite.addrs[19]
+	amount := cs(c(BNB_DENOM, 50000))
+	create := func(i int, sender, recipient sdk.AccAddress) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
+			types.DefaultMinBlockLock, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+	}
+
+	// a swap with the deputy is in flight when it is rotated out
+	suite.Require().NoError(create(0, suite.deputy, suite.addrs[1]))
+	asset, err := suite.keeper.GetAsset(suite.ctx, BNB_DENOM)
+	suite.Require().NoError(err)
+	asset.DeputyAddress = newDeputy
+	asset.RetiringDeputies = types.RetiringDeputies{types.NewRetiringDeputy(suite.deputy, suite.ctx.BlockTime().Add(time.Hour))}
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	// retired deputies can't create new swaps
+	suite.Require().Error(create(1, suite.deputy, suite.addrs[1]))
+
+	// the in-flight swap can still be claimed
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.addrs[1], swapID, suite.randomNumbers[0]))
+
+	// additional deputies can relay swaps in either direction
+	asset.AdditionalDeputies = []sdk.AccAddress{additional}
+	suite.keeper.SetAsset(suite.ctx, asset)
+	suite.Require().NoError(create(2, additional, suite.addrs[1]))
+	suite.Require().NoError(create(3, suite.addrs[1], newDeputy))
+	suite.Require().Error(create(4, additional, newDeputy))
+}
+
+func (suite *AtomicSwapTestSuite) TestPeerAtomicSwap() {
+	ak := suite.app.GetAccountKeeper()
+	sender, recipient := suite.addrs[1], suite.addrs[2]
+	amount := cs(c(BNB_DENOM, 50000))
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+		return ak.GetAccount(ctx, addr).GetCoins().AmountOf(BNB_DENOM)
+	}
+	supplyPre, found := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Require().True(found)
+
+	// invalid swaps are rejected
+	err := suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], 100,
+		sender, sender, "", "", amount, false)
+	suite.Require().Error(err)
+	err = suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], 100,
+		sender, suite.randMacc, "", "", amount, false)
+	suite.Require().Error(err)
+	err = suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], types.MaxPeerSwapHeightSpan+1,
+		sender, recipient, "", "", amount, false)
+	suite.Require().Error(err)
+	err = suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[0], ts(-20), 100,
+		sender, recipient, "", "", amount, false)
+	suite.Require().Error(err)
+
+	// a claimed swap moves the escrowed coins from the sender to the recipient
+	senderPre, recipientPre := balance(suite.ctx, sender), balance(suite.ctx, recipient)
+	err = suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], 100,
+		sender, recipient, "", "", amount, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(senderPre.Sub(amount[0].Amount), balance(suite.ctx, sender))
+
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], sender, "")
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PeerToPeer, swap.Direction)
+	suite.Require().False(swap.CrossChain)
+	suite.Require().NoError(swap.Validate())
+
+	err = suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0], 100,
+		sender, recipient, "", "", amount, false)
+	suite.Require().Error(err)
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, suite.randomNumbers[1])
+	suite.Require().Error(err)
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, suite.randomNumbers[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(recipientPre.Add(amount[0].Amount), balance(suite.ctx, recipient))
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().Equal(types.Completed, swap.Status)
+
+	// an expired swap is refunded to the sender
+	senderPre = balance(suite.ctx, sender)
+	err = suite.keeper.CreatePeerAtomicSwap(suite.ctx, suite.randomNumberHashes[1], suite.timestamps[1], 100,
+		sender, recipient, "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7", "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7", amount, true)
+	suite.Require().NoError(err)
+	swapID = types.CalculateSwapID(suite.randomNumberHashes[1], sender, "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7")
+
+	refundCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100)
+	bep3.BeginBlocker(refundCtx, suite.keeper)
+	err = suite.keeper.ClaimAtomicSwap(refundCtx, recipient, swapID, suite.randomNumbers[1])
+	suite.Require().Error(err)
+	err = suite.keeper.RefundAtomicSwap(refundCtx, sender, swapID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(senderPre, balance(refundCtx, sender))
+
+	// asset supplies are not changed by peer to peer swaps
+	supplyPost, _ := suite.keeper.GetAssetSupply(refundCtx, BNB_DENOM)
+	suite.Require().Equal(supplyPre, supplyPost)
+}
+
+func (suite *AtomicSwapTestSuite) TestBatchClaimAndRefund() {
+	amount := cs(c(BNB_DENOM, 50000))
+	var swapIDs []tmbytes.HexBytes
+	for i := 0; i < 4; i++ {
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
+			types.DefaultMinBlockLock, suite.deputy, suite.addrs[1], TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+		suite.Require().NoError(err)
+		swapIDs = append(swapIDs, types.CalculateSwapID(suite.randomNumberHashes[i], suite.deputy, TestSenderOtherChain))
+	}
+	resultEvents := func(ctx sdk.Context, eventType string) (results []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				results = append(results, string(event.Attributes[1].Value))
+			}
+		}
+		return results
+	}
+
+	// claims with the wrong secret fail without preventing the other claims
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.ClaimAtomicSwaps(ctx, suite.addrs[1], []types.SwapClaim{
+		types.NewSwapClaim(swapIDs[0], suite.randomNumbers[0]),
+		types.NewSwapClaim(swapIDs[1], suite.randomNumbers[0]),
+		types.NewSwapClaim(swapIDs[2], suite.randomNumbers[2]),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"true", "false", "true"}, resultEvents(ctx, types.EventTypeBatchClaimResult))
+	suite.Require().Len(resultEvents(ctx, types.EventTypeClaimAtomicSwap), 2)
+	for i, expected := range []types.SwapStatus{types.Completed, types.Open, types.Completed, types.Open} {
+		swap, found := suite.keeper.GetAtomicSwap(ctx, swapIDs[i])
+		suite.Require().True(found)
+		suite.Require().Equal(expected, swap.Status)
+	}
+
+	// a batch fails if no swap in it can be processed
+	err = suite.keeper.ClaimAtomicSwaps(ctx, suite.addrs[1], []types.SwapClaim{types.NewSwapClaim(swapIDs[0], suite.randomNumbers[0])})
+	suite.Require().True(errors.Is(err, types.ErrBatchFailed))
+
+	// expired swaps are refunded, while completed ones can't be
+	refundCtx := ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.DefaultMinBlockLock)).WithEventManager(sdk.NewEventManager())
+	bep3.BeginBlocker(refundCtx, suite.keeper)
+	err = suite.keeper.RefundAtomicSwaps(refundCtx, suite.addrs[1], swapIDs)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"false", "true", "false", "true"}, resultEvents(refundCtx, types.EventTypeBatchRefundResult))
+	for _, swapID := range []tmbytes.HexBytes{swapIDs[1], swapIDs[3]} {
+		swap, _ := suite.keeper.GetAtomicSwap(refundCtx, swapID)
+		suite.Require().Equal(types.Completed, swap.Status)
+	}
+}
+
 func TestAtomicSwapTestSuite(t *testing.T) {
 	suite.Run(t, new(AtomicSwapTestSuite))
 }
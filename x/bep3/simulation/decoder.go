@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/bep3/types"
 )
@@ -23,7 +24,10 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		return fmt.Sprintf("%v\n%v", swapA, swapB)
 
 	case bytes.Equal(kvA.Key[:1], types.AtomicSwapByBlockPrefix),
-		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix):
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByRandomNumberHashPrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapBySenderOtherChainPrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByExpiryPrefix):
 		var bytesA tmbytes.HexBytes = kvA.Value
 		var bytesB tmbytes.HexBytes = kvA.Value
 		return fmt.Sprintf("%s\n%s", bytesA.String(), bytesB.String())
@@ -37,6 +41,26 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
 		return fmt.Sprintf("%s\n%s", timeA, timeB)
+	case bytes.Equal(kvA.Key[:1], types.RateLimitPrefix):
+		var amountA, amountB sdk.Int
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &amountA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &amountB)
+		return fmt.Sprintf("%s\n%s", amountA, amountB)
+	case bytes.Equal(kvA.Key[:1], types.DeputyBondPrefix):
+		var bondA, bondB types.DeputyBond
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &bondA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &bondB)
+		return fmt.Sprintf("%s\n%s", bondA, bondB)
+	case bytes.Equal(kvA.Key[:1], types.SwapFeePrefix):
+		var feeA, feeB types.SwapFee
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &feeA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &feeB)
+		return fmt.Sprintf("%s\n%s", feeA, feeB)
+	case bytes.Equal(kvA.Key[:1], types.CollectedFeesPrefix):
+		var feesA, feesB types.CollectedFees
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &feesA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &feesB)
+		return fmt.Sprintf("%s\n%s", feesA, feesB)
 
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
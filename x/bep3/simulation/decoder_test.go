@@ -31,13 +31,20 @@ func TestDecodeBep3Store(t *testing.T) {
 	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100, nil, nil, "otherChainSender", "otherChainRec", 200, types.Completed, true, types.Outgoing)
 	supply := types.AssetSupply{IncomingSupply: oneCoin, OutgoingSupply: oneCoin, CurrentSupply: oneCoin, TimeLimitedCurrentSupply: oneCoin, TimeElapsed: time.Duration(0)}
 	bz := tmbytes.HexBytes([]byte{1, 2})
+	bond := types.NewDeputyBond(sdk.AccAddress("deputy"), sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100))))
+	fee := types.NewSwapFee(tmbytes.HexBytes(make([]byte, types.SwapIDLength)), oneCoin)
+	collected := types.NewCollectedFees(sdk.AccAddress("deputy"), sdk.Coins{oneCoin})
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.AtomicSwapKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(swap)},
 		kv.Pair{Key: types.AssetSupplyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(supply)},
 		kv.Pair{Key: types.AtomicSwapByBlockPrefix, Value: bz},
 		kv.Pair{Key: types.AtomicSwapByBlockPrefix, Value: bz},
+		kv.Pair{Key: types.AtomicSwapByRandomNumberHashPrefix, Value: bz},
 		kv.Pair{Key: types.PreviousBlockTimeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(prevBlockTime)},
+		kv.Pair{Key: types.DeputyBondPrefix, Value: cdc.MustMarshalBinaryBare(bond)},
+		kv.Pair{Key: types.SwapFeePrefix, Value: cdc.MustMarshalBinaryBare(fee)},
+		kv.Pair{Key: types.CollectedFeesPrefix, Value: cdc.MustMarshalBinaryBare(collected)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -49,7 +56,11 @@ func TestDecodeBep3Store(t *testing.T) {
 		{"AssetSupply", fmt.Sprintf("%v\n%v", supply, supply)},
 		{"AtomicSwapByBlock", fmt.Sprintf("%s\n%s", bz, bz)},
 		{"AtomicSwapLongtermStorage", fmt.Sprintf("%s\n%s", bz, bz)},
+		{"AtomicSwapByRandomNumberHash", fmt.Sprintf("%s\n%s", bz, bz)},
 		{"PreviousBlockTime", fmt.Sprintf("%s\n%s", prevBlockTime, prevBlockTime)},
+		{"DeputyBond", fmt.Sprintf("%s\n%s", bond, bond)},
+		{"SwapFee", fmt.Sprintf("%s\n%s", fee, fee)},
+		{"CollectedFees", fmt.Sprintf("%s\n%s", collected, collected)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
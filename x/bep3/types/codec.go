@@ -13,6 +13,11 @@ func init() {
 // RegisterCodec registers concrete types on amino
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateAtomicSwap{}, "bep3/MsgCreateAtomicSwap", nil)
+	cdc.RegisterConcrete(MsgCreatePeerAtomicSwap{}, "bep3/MsgCreatePeerAtomicSwap", nil)
 	cdc.RegisterConcrete(MsgRefundAtomicSwap{}, "bep3/MsgRefundAtomicSwap", nil)
 	cdc.RegisterConcrete(MsgClaimAtomicSwap{}, "bep3/MsgClaimAtomicSwap", nil)
+	cdc.RegisterConcrete(MsgDepositDeputyBond{}, "bep3/MsgDepositDeputyBond", nil)
+	cdc.RegisterConcrete(MsgWithdrawDeputyBond{}, "bep3/MsgWithdrawDeputyBond", nil)
+	cdc.RegisterConcrete(MsgClaimAtomicSwaps{}, "bep3/MsgClaimAtomicSwaps", nil)
+	cdc.RegisterConcrete(MsgRefundAtomicSwaps{}, "bep3/MsgRefundAtomicSwaps", nil)
 }
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// DeputyBond is the deposit a deputy holds in the bep3 module account, which can be slashed by governance
+type DeputyBond struct {
+	DeputyAddress sdk.AccAddress `json:"deputy_address" yaml:"deputy_address"`
+	Amount        sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewDeputyBond returns a new DeputyBond
+func NewDeputyBond(deputy sdk.AccAddress, amount sdk.Coins) DeputyBond {
+	return DeputyBond{
+		DeputyAddress: deputy,
+		Amount:        amount,
+	}
+}
+
+// Validate performs a basic check of a DeputyBond
+func (db DeputyBond) Validate() error {
+	if db.DeputyAddress.Empty() {
+		return errors.New("deputy address cannot be empty")
+	}
+	if !db.Amount.IsValid() || db.Amount.Empty() {
+		return fmt.Errorf("invalid bond amount %s", db.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (db DeputyBond) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Deputy Address: %s
+Amount: %s`, db.DeputyAddress, db.Amount))
+}
+
+// DeputyBonds is a slice of DeputyBond
+type DeputyBonds []DeputyBond
+
+// Validate checks that all bonds are valid and there are no duplicated entries
+func (dbs DeputyBonds) Validate() error {
+	seen := make(map[string]bool)
+	for _, db := range dbs {
+		if err := db.Validate(); err != nil {
+			return err
+		}
+		if seen[db.DeputyAddress.String()] {
+			return fmt.Errorf("duplicated bond for deputy address %s", db.DeputyAddress)
+		}
+		seen[db.DeputyAddress.String()] = true
+	}
+	return nil
+}
+
+// SwapFee is the fee charged on an outgoing swap, paid to the deputy when the swap is claimed
+type SwapFee struct {
+	SwapID tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	Amount sdk.Coin         `json:"amount" yaml:"amount"`
+}
+
+// NewSwapFee returns a new SwapFee
+func NewSwapFee(swapID tmbytes.HexBytes, amount sdk.Coin) SwapFee {
+	return SwapFee{
+		SwapID: swapID,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic check of a SwapFee
+func (sf SwapFee) Validate() error {
+	if len(sf.SwapID) != SwapIDLength {
+		return fmt.Errorf("the length of swap id should be %d", SwapIDLength)
+	}
+	if !sf.Amount.IsValid() || !sf.Amount.IsPositive() {
+		return fmt.Errorf("invalid swap fee %s", sf.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sf SwapFee) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Swap ID: %s
+Amount: %s`, hex.EncodeToString(sf.SwapID), sf.Amount))
+}
+
+// SwapFees is a slice of SwapFee
+type SwapFees []SwapFee
+
+// CollectedFees are the outgoing swap fees that have been paid to a deputy
+type CollectedFees struct {
+	DeputyAddress sdk.AccAddress `json:"deputy_address" yaml:"deputy_address"`
+	Amount        sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewCollectedFees returns a new CollectedFees
+func NewCollectedFees(deputy sdk.AccAddress, amount sdk.Coins) CollectedFees {
+	return CollectedFees{
+		DeputyAddress: deputy,
+		Amount:        amount,
+	}
+}
+
+// Validate performs a basic check of a CollectedFees
+func (cf CollectedFees) Validate() error {
+	if cf.DeputyAddress.Empty() {
+		return errors.New("deputy address cannot be empty")
+	}
+	if !cf.Amount.IsValid() || cf.Amount.Empty() {
+		return fmt.Errorf("invalid collected fees %s", cf.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (cf CollectedFees) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Deputy Address: %s
+Amount: %s`, cf.DeputyAddress, cf.Amount))
+}
+
+// CollectedFeesList is a slice of CollectedFees
+type CollectedFeesList []CollectedFees
+
+// Validate checks that all collected fees are valid and there are no duplicated entries
+func (cfs CollectedFeesList) Validate() error {
+	seen := make(map[string]bool)
+	for _, cf := range cfs {
+		if err := cf.Validate(); err != nil {
+			return err
+		}
+		if seen[cf.DeputyAddress.String()] {
+			return fmt.Errorf("duplicated collected fees for deputy address %s", cf.DeputyAddress)
+		}
+		seen[cf.DeputyAddress.String()] = true
+	}
+	return nil
+}
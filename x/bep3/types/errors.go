@@ -45,4 +45,12 @@ var (
 	ErrInvalidSwapAccount = sdkerrors.Register(ModuleName, 19, "atomic swap has invalid account")
 	// ErrExceedsTimeBasedSupplyLimit error for when the proposed supply increase would put the supply above limit for the current time period
 	ErrExceedsTimeBasedSupplyLimit = sdkerrors.Register(ModuleName, 20, "asset supply over limit for current time period")
+	// ErrInsufficientDeputyBond error for when a deputy's bond doesn't cover the minimum bond of an asset it is a deputy for
+	ErrInsufficientDeputyBond = sdkerrors.Register(ModuleName, 21, "deputy bond is insufficient")
+	// ErrDeputyBondNotFound error for when an address has no deputy bond
+	ErrDeputyBondNotFound = sdkerrors.Register(ModuleName, 22, "deputy bond not found")
+	// ErrInvalidBondDenom error for when a deputy bond is deposited in the denom of a bep3 asset
+	ErrInvalidBondDenom = sdkerrors.Register(ModuleName, 23, "deputy bond cannot be deposited in a bep3 asset")
+	// ErrBatchFailed error for when no swap in a batch could be claimed or refunded
+	ErrBatchFailed = sdkerrors.Register(ModuleName, 24, "no swap in the batch could be processed")
 )
@@ -2,10 +2,16 @@ package types
 
 // Events for bep3 module
 const (
-	EventTypeCreateAtomicSwap = "create_atomic_swap"
-	EventTypeClaimAtomicSwap  = "claim_atomic_swap"
-	EventTypeRefundAtomicSwap = "refund_atomic_swap"
-	EventTypeSwapsExpired     = "swaps_expired"
+	EventTypeCreateAtomicSwap  = "create_atomic_swap"
+	EventTypeClaimAtomicSwap   = "claim_atomic_swap"
+	EventTypeRefundAtomicSwap  = "refund_atomic_swap"
+	EventTypeSwapsExpired      = "swaps_expired"
+	EventTypeDeputyBond        = "deputy_bond"
+	EventTypeDeputyUnbond      = "deputy_unbond"
+	EventTypeSlashDeputyBond   = "slash_deputy_bond"
+	EventTypeCollectSwapFee    = "collect_swap_fee"
+	EventTypeBatchClaimResult  = "batch_claim_result"
+	EventTypeBatchRefundResult = "batch_refund_result"
 
 	AttributeValueCategory       = ModuleName
 	AttributeKeySender           = "sender"
@@ -22,4 +28,9 @@ const (
 	AttributeKeyRefundSender     = "refund_sender"
 	AttributeKeyAtomicSwapIDs    = "atomic_swap_ids"
 	AttributeExpirationBlock     = "expiration_block"
+	AttributeKeyDeputy           = "deputy"
+	AttributeKeyFee              = "fee"
+	AttributeKeySlashFraction    = "slash_fraction"
+	AttributeKeySuccess          = "success"
+	AttributeKeyError            = "error"
 )
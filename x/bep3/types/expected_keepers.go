@@ -25,3 +25,8 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
 	SetAccount(ctx sdk.Context, acc authexported.Account)
 }
+
+// SendRestriction validates transfers of coins between accounts and the module, such as transfers of restricted issuance assets
+type SendRestriction interface {
+	ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+}
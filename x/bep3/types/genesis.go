@@ -5,23 +5,35 @@ import (
 	"encoding/hex"
 	"fmt"
 	"time"
+
+	"github.com/kava-labs/kava/ratelimit"
 )
 
 // GenesisState - all bep3 state that must be provided at genesis
 type GenesisState struct {
-	Params            Params        `json:"params" yaml:"params"`
-	AtomicSwaps       AtomicSwaps   `json:"atomic_swaps" yaml:"atomic_swaps"`
-	Supplies          AssetSupplies `json:"supplies" yaml:"supplies"`
-	PreviousBlockTime time.Time     `json:"previous_block_time" yaml:"previous_block_time"`
+	Params            Params            `json:"params" yaml:"params"`
+	AtomicSwaps       AtomicSwaps       `json:"atomic_swaps" yaml:"atomic_swaps"`
+	Supplies          AssetSupplies     `json:"supplies" yaml:"supplies"`
+	RateLimitBuckets  ratelimit.Buckets `json:"rate_limit_buckets" yaml:"rate_limit_buckets"`
+	PreviousBlockTime time.Time         `json:"previous_block_time" yaml:"previous_block_time"`
+	DeputyBonds       DeputyBonds       `json:"deputy_bonds" yaml:"deputy_bonds"`
+	SwapFees          SwapFees          `json:"swap_fees" yaml:"swap_fees"`
+	// CollectedFees are the swap fees each deputy has been paid
+	CollectedFees CollectedFeesList `json:"collected_fees" yaml:"collected_fees"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, previousBlockTime time.Time) GenesisState {
+func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, rateLimitBuckets ratelimit.Buckets, previousBlockTime time.Time,
+	bonds DeputyBonds, swapFees SwapFees, collectedFees CollectedFeesList) GenesisState {
 	return GenesisState{
 		Params:            params,
 		AtomicSwaps:       swaps,
 		Supplies:          supplies,
+		RateLimitBuckets:  rateLimitBuckets,
 		PreviousBlockTime: previousBlockTime,
+		DeputyBonds:       bonds,
+		SwapFees:          swapFees,
+		CollectedFees:     collectedFees,
 	}
 }
 
@@ -31,7 +43,11 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		AtomicSwaps{},
 		AssetSupplies{},
+		ratelimit.Buckets{},
 		DefaultPreviousBlockTime,
+		DeputyBonds{},
+		SwapFees{},
+		CollectedFeesList{},
 	)
 }
 
@@ -54,6 +70,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	ids := map[string]bool{}
+	outgoingIDs := map[string]bool{}
 	for _, swap := range gs.AtomicSwaps {
 		if ids[hex.EncodeToString(swap.GetSwapID())] {
 			return fmt.Errorf("found duplicate atomic swap ID %s", hex.EncodeToString(swap.GetSwapID()))
@@ -64,6 +81,9 @@ func (gs GenesisState) Validate() error {
 		}
 
 		ids[hex.EncodeToString(swap.GetSwapID())] = true
+		if swap.Direction == Outgoing && swap.Status != Completed {
+			outgoingIDs[hex.EncodeToString(swap.GetSwapID())] = true
+		}
 	}
 
 	supplyDenoms := map[string]bool{}
@@ -76,5 +96,39 @@ func (gs GenesisState) Validate() error {
 		}
 		supplyDenoms[supply.GetDenom()] = true
 	}
-	return nil
+
+	if err := gs.RateLimitBuckets.Validate(); err != nil {
+		return err
+	}
+	for _, bucket := range gs.RateLimitBuckets {
+		if !supplyDenoms[bucket.Denom] {
+			return fmt.Errorf("rate limit bucket for asset without a supply: %s", bucket.Denom)
+		}
+	}
+	for _, supply := range gs.Supplies {
+		if windowed := gs.RateLimitBuckets.AmountOf(supply.GetDenom()); !windowed.Equal(supply.TimeLimitedCurrentSupply.Amount) {
+			return fmt.Errorf("rate limit buckets for %s add up to %s, not the asset's time-limited supply %s", supply.GetDenom(), windowed, supply.TimeLimitedCurrentSupply)
+		}
+	}
+
+	if err := gs.DeputyBonds.Validate(); err != nil {
+		return err
+	}
+
+	feeIDs := map[string]bool{}
+	for _, fee := range gs.SwapFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+		id := hex.EncodeToString(fee.SwapID)
+		if !outgoingIDs[id] {
+			return fmt.Errorf("swap fee %s must belong to an open or expired outgoing atomic swap", id)
+		}
+		if feeIDs[id] {
+			return fmt.Errorf("found duplicate swap fee for atomic swap ID %s", id)
+		}
+		feeIDs[id] = true
+	}
+
+	return gs.CollectedFees.Validate()
 }
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
@@ -16,6 +17,7 @@ type GenesisTestSuite struct {
 	suite.Suite
 	swaps    types.AtomicSwaps
 	supplies types.AssetSupplies
+	buckets  ratelimit.Buckets
 }
 
 func (suite *GenesisTestSuite) SetupTest() {
@@ -27,12 +29,14 @@ func (suite *GenesisTestSuite) SetupTest() {
 
 	supply := types.NewAssetSupply(coin, coin, coin, coin, time.Duration(0))
 	suite.supplies = types.AssetSupplies{supply}
+	suite.buckets = ratelimit.Buckets{ratelimit.NewBucket("kava", types.DefaultPreviousBlockTime, sdk.OneInt())}
 }
 
 func (suite *GenesisTestSuite) TestValidate() {
 	type args struct {
 		swaps             types.AtomicSwaps
 		supplies          types.AssetSupplies
+		buckets           ratelimit.Buckets
 		previousBlockTime time.Time
 	}
 	testCases := []struct {
@@ -61,10 +65,39 @@ func (suite *GenesisTestSuite) TestValidate() {
 			args{
 				swaps:             types.AtomicSwaps{},
 				supplies:          suite.supplies,
+				buckets:           suite.buckets,
 				previousBlockTime: types.DefaultPreviousBlockTime,
 			},
 			true,
 		},
+		{
+			"supplies without rate limit buckets",
+			args{
+				swaps:             types.AtomicSwaps{},
+				supplies:          suite.supplies,
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"rate limit bucket without supply",
+			args{
+				swaps:             types.AtomicSwaps{},
+				buckets:           suite.buckets,
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"invalid rate limit bucket",
+			args{
+				swaps:             types.AtomicSwaps{},
+				supplies:          types.AssetSupplies{types.NewAssetSupply(c("kava", 1), c("kava", 1), c("kava", 1), c("kava", 0), time.Duration(0))},
+				buckets:           ratelimit.Buckets{ratelimit.NewBucket("kava", types.DefaultPreviousBlockTime, sdk.ZeroInt())},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
 		{
 			"invalid supply",
 			args{
@@ -105,7 +138,7 @@ func (suite *GenesisTestSuite) TestValidate() {
 			if tc.name == "default" {
 				gs = types.DefaultGenesisState()
 			} else {
-				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime)
+				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.buckets, tc.args.previousBlockTime, types.DeputyBonds{}, types.SwapFees{}, types.CollectedFeesList{})
 			}
 
 			err := gs.Validate()
@@ -119,6 +152,43 @@ func (suite *GenesisTestSuite) TestValidate() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestValidateDeputyBondsAndFees() {
+	incoming := atomicSwap(0)
+	outgoing := atomicSwap(1)
+	outgoing.Direction = types.Outgoing
+	bond := types.NewDeputyBond(kavaAddrs[1], cs(c("ukava", 1000)))
+	fee := types.NewSwapFee(outgoing.GetSwapID(), c("bnb", 100))
+
+	testCases := []struct {
+		name       string
+		swaps      types.AtomicSwaps
+		bonds      types.DeputyBonds
+		swapFees   types.SwapFees
+		collected  types.CollectedFeesList
+		expectPass bool
+	}{
+		{"valid", types.AtomicSwaps{outgoing}, types.DeputyBonds{bond}, types.SwapFees{fee}, types.CollectedFeesList{types.NewCollectedFees(kavaAddrs[1], cs(c("bnb", 100)))}, true},
+		{"duplicate bonds", nil, types.DeputyBonds{bond, bond}, nil, nil, false},
+		{"empty bond", nil, types.DeputyBonds{types.NewDeputyBond(kavaAddrs[1], sdk.Coins{})}, nil, nil, false},
+		{"fee without swap", nil, nil, types.SwapFees{fee}, nil, false},
+		{"fee for incoming swap", types.AtomicSwaps{incoming}, nil, types.SwapFees{types.NewSwapFee(incoming.GetSwapID(), c("bnb", 100))}, nil, false},
+		{"duplicate fees", types.AtomicSwaps{outgoing}, nil, types.SwapFees{fee, fee}, nil, false},
+		{"empty collected fees", nil, nil, nil, types.CollectedFeesList{types.NewCollectedFees(kavaAddrs[1], sdk.Coins{})}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			gs := types.NewGenesisState(types.DefaultParams(), tc.swaps, types.AssetSupplies{}, ratelimit.Buckets{}, types.DefaultPreviousBlockTime, tc.bonds, tc.swapFees, tc.collected)
+			err := gs.Validate()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -31,14 +31,47 @@ var (
 	// ModulePermissionsUpgradeTime is the block time after which the bep3 module account's permissions are synced with the supply module.
 	ModulePermissionsUpgradeTime time.Time = time.Date(2020, 11, 3, 10, 0, 0, 0, time.UTC)
 
-	AtomicSwapKeyPrefix             = []byte{0x00} // prefix for keys that store AtomicSwaps
-	AtomicSwapByBlockPrefix         = []byte{0x01} // prefix for keys of the AtomicSwapsByBlock index
-	AtomicSwapLongtermStoragePrefix = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
-	AssetSupplyPrefix               = []byte{0x03}
-	PreviousBlockTimeKey            = []byte{0x04}
+	AtomicSwapKeyPrefix                = []byte{0x00} // prefix for keys that store AtomicSwaps
+	AtomicSwapByBlockPrefix            = []byte{0x01} // prefix for keys of the AtomicSwapsByBlock index
+	AtomicSwapLongtermStoragePrefix    = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
+	AssetSupplyPrefix                  = []byte{0x03}
+	PreviousBlockTimeKey               = []byte{0x04}
+	RateLimitPrefix                    = []byte{0x05} // prefix for keys of the buckets of issuance in each asset's rate limit window
+	DeputyBondPrefix                   = []byte{0x06} // prefix for keys that store the bond deposited by each deputy
+	SwapFeePrefix                      = []byte{0x07} // prefix for keys that store the fees of open or expired outgoing swaps
+	CollectedFeesPrefix                = []byte{0x08} // prefix for keys that store the fees collected by each deputy
+	AtomicSwapByRandomNumberHashPrefix = []byte{0x09} // prefix for keys of the AtomicSwapsByRandomNumberHash index
+	AtomicSwapBySenderOtherChainPrefix = []byte{0x0A} // prefix for keys of the AtomicSwapsBySenderOtherChain index
+	AtomicSwapByExpiryPrefix           = []byte{0x0B} // prefix for keys of the AtomicSwapsByExpiry index
 )
 
 // GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
 func GetAtomicSwapByHeightKey(height uint64, swapID []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(height), swapID...)
 }
+
+// GetAtomicSwapByRandomNumberHashKey is used by the AtomicSwapsByRandomNumberHash index
+func GetAtomicSwapByRandomNumberHashKey(randomNumberHash, swapID []byte) []byte {
+	return append(append([]byte{}, randomNumberHash...), swapID...)
+}
+
+// GetSenderOtherChainPrefix returns the prefix of the keys of all swaps from an address on the other chain,
+// which is length prefixed so that no address is a prefix of another
+func GetSenderOtherChainPrefix(senderOtherChain string) []byte {
+	return append([]byte{byte(len(senderOtherChain))}, senderOtherChain...)
+}
+
+// GetAtomicSwapBySenderOtherChainKey is used by the AtomicSwapsBySenderOtherChain index
+func GetAtomicSwapBySenderOtherChainKey(senderOtherChain string, swapID []byte) []byte {
+	return append(GetSenderOtherChainPrefix(senderOtherChain), swapID...)
+}
+
+// DeputyBondKey returns the key for a deputy's bond
+func DeputyBondKey(deputy sdk.AccAddress) []byte {
+	return append(DeputyBondPrefix, deputy...)
+}
+
+// CollectedFeesKey returns the key for the fees collected by a deputy
+func CollectedFeesKey(deputy sdk.AccAddress) []byte {
+	return append(CollectedFeesPrefix, deputy...)
+}
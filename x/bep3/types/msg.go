@@ -13,10 +13,15 @@ import (
 )
 
 const (
-	CreateAtomicSwap = "createAtomicSwap"
-	ClaimAtomicSwap  = "claimAtomicSwap"
-	RefundAtomicSwap = "refundAtomicSwap"
-	CalcSwapID       = "calcSwapID"
+	CreateAtomicSwap     = "createAtomicSwap"
+	CreatePeerAtomicSwap = "createPeerAtomicSwap"
+	ClaimAtomicSwap      = "claimAtomicSwap"
+	RefundAtomicSwap     = "refundAtomicSwap"
+	DepositDeputyBond    = "depositDeputyBond"
+	WithdrawDeputyBond   = "withdrawDeputyBond"
+	ClaimAtomicSwaps     = "claimAtomicSwaps"
+	RefundAtomicSwaps    = "refundAtomicSwaps"
+	CalcSwapID           = "calcSwapID"
 
 	Int64Size               = 8
 	RandomNumberHashLength  = 32
@@ -25,13 +30,26 @@ const (
 	MaxOtherChainAddrLength = 64
 	SwapIDLength            = 32
 	MaxExpectedIncomeLength = 64
+
+	// MaxBatchSize is the maximum number of swaps that can be claimed or refunded in one msg
+	MaxBatchSize = 100
+
+	// MinPeerSwapHeightSpan is the minimum number of blocks a peer to peer swap can be locked for
+	MinPeerSwapHeightSpan uint64 = 1
+	// MaxPeerSwapHeightSpan is the maximum number of blocks a peer to peer swap can be locked for
+	MaxPeerSwapHeightSpan uint64 = DefaultLongtermStorageDuration
 )
 
 // ensure Msg interface compliance at compile time
 var (
 	_                      sdk.Msg = &MsgCreateAtomicSwap{}
+	_                      sdk.Msg = &MsgCreatePeerAtomicSwap{}
 	_                      sdk.Msg = &MsgClaimAtomicSwap{}
 	_                      sdk.Msg = &MsgRefundAtomicSwap{}
+	_                      sdk.Msg = &MsgDepositDeputyBond{}
+	_                      sdk.Msg = &MsgWithdrawDeputyBond{}
+	_                      sdk.Msg = &MsgClaimAtomicSwaps{}
+	_                      sdk.Msg = &MsgRefundAtomicSwaps{}
 	AtomicSwapCoinsAccAddr         = sdk.AccAddress(crypto.AddressHash([]byte("KavaAtomicSwapCoins")))
 	// kava prefix address:  [INSERT BEP3-DEPUTY ADDRESS]
 	// tkava prefix address: [INSERT BEP3-DEPUTY ADDRESS]
@@ -135,6 +153,114 @@ func (msg MsgCreateAtomicSwap) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// MsgCreatePeerAtomicSwap creates an atomic swap between two accounts that doesn't involve a deputy.
+// The amount is held in escrow until the recipient claims it or the swap expires and is refunded to the sender.
+// Addresses on the other chain are only set for cross-chain swaps.
+type MsgCreatePeerAtomicSwap struct {
+	From                sdk.AccAddress   `json:"from"  yaml:"from"`
+	To                  sdk.AccAddress   `json:"to"  yaml:"to"`
+	RecipientOtherChain string           `json:"recipient_other_chain"  yaml:"recipient_other_chain"`
+	SenderOtherChain    string           `json:"sender_other_chain"  yaml:"sender_other_chain"`
+	RandomNumberHash    tmbytes.HexBytes `json:"random_number_hash"  yaml:"random_number_hash"`
+	Timestamp           int64            `json:"timestamp"  yaml:"timestamp"`
+	Amount              sdk.Coins        `json:"amount"  yaml:"amount"`
+	HeightSpan          uint64           `json:"height_span"  yaml:"height_span"`
+}
+
+// NewMsgCreatePeerAtomicSwap initializes a new MsgCreatePeerAtomicSwap
+func NewMsgCreatePeerAtomicSwap(from sdk.AccAddress, to sdk.AccAddress, recipientOtherChain,
+	senderOtherChain string, randomNumberHash tmbytes.HexBytes, timestamp int64,
+	amount sdk.Coins, heightSpan uint64) MsgCreatePeerAtomicSwap {
+	return MsgCreatePeerAtomicSwap{
+		From:                from,
+		To:                  to,
+		RecipientOtherChain: recipientOtherChain,
+		SenderOtherChain:    senderOtherChain,
+		RandomNumberHash:    randomNumberHash,
+		Timestamp:           timestamp,
+		Amount:              amount,
+		HeightSpan:          heightSpan,
+	}
+}
+
+// Route establishes the route for the MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) Route() string { return RouterKey }
+
+// Type is the name of MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) Type() string { return CreatePeerAtomicSwap }
+
+// String prints the MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) String() string {
+	return fmt.Sprintf("peerAtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v}",
+		msg.From, msg.To, msg.RecipientOtherChain, msg.SenderOtherChain,
+		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), AtomicSwapCoinsAccAddr)
+}
+
+// GetSigners gets the signers of a MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// IsCrossChain returns true if the swap is one side of a swap with another chain
+func (msg MsgCreatePeerAtomicSwap) IsCrossChain() bool {
+	return strings.TrimSpace(msg.RecipientOtherChain) != ""
+}
+
+// ValidateBasic validates the MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.From))
+	}
+	if msg.To.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient address cannot be empty")
+	}
+	if len(msg.To) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.To))
+	}
+	if msg.From.Equals(msg.To) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and recipient cannot be the same")
+	}
+	if (strings.TrimSpace(msg.RecipientOtherChain) == "") != (strings.TrimSpace(msg.SenderOtherChain) == "") {
+		return errors.New("cross-chain swaps must have both sender and recipient addresses on other chain")
+	}
+	if len(msg.RecipientOtherChain) > MaxOtherChainAddrLength {
+		return fmt.Errorf("the length of recipient address on other chain should be less than %d", MaxOtherChainAddrLength)
+	}
+	if len(msg.SenderOtherChain) > MaxOtherChainAddrLength {
+		return fmt.Errorf("the length of sender address on other chain should be less than %d", MaxOtherChainAddrLength)
+	}
+	if len(msg.RandomNumberHash) != RandomNumberHashLength {
+		return fmt.Errorf("the length of random number hash should be %d", RandomNumberHashLength)
+	}
+	if msg.Timestamp <= 0 {
+		return errors.New("timestamp must be positive")
+	}
+	if len(msg.Amount) != 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must contain exactly one coin")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.HeightSpan < MinPeerSwapHeightSpan || msg.HeightSpan > MaxPeerSwapHeightSpan {
+		return fmt.Errorf("height span must be in range [%d, %d]", MinPeerSwapHeightSpan, MaxPeerSwapHeightSpan)
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgCreatePeerAtomicSwap
+func (msg MsgCreatePeerAtomicSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
 // MsgClaimAtomicSwap defines a AtomicSwap claim
 type MsgClaimAtomicSwap struct {
 	From         sdk.AccAddress   `json:"from"  yaml:"from"`
@@ -249,3 +375,250 @@ func (msg MsgRefundAtomicSwap) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// MsgDepositDeputyBond deposits coins into a deputy's bond
+type MsgDepositDeputyBond struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositDeputyBond initializes a new MsgDepositDeputyBond
+func NewMsgDepositDeputyBond(from sdk.AccAddress, amount sdk.Coins) MsgDepositDeputyBond {
+	return MsgDepositDeputyBond{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+// Route establishes the route for the MsgDepositDeputyBond
+func (msg MsgDepositDeputyBond) Route() string { return RouterKey }
+
+// Type is the name of MsgDepositDeputyBond
+func (msg MsgDepositDeputyBond) Type() string { return DepositDeputyBond }
+
+// String prints the MsgDepositDeputyBond
+func (msg MsgDepositDeputyBond) String() string {
+	return fmt.Sprintf("depositDeputyBond{%v#%v}", msg.From, msg.Amount)
+}
+
+// GetSigners gets the signers of a MsgDepositDeputyBond
+func (msg MsgDepositDeputyBond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic validates the MsgDepositDeputyBond
+func (msg MsgDepositDeputyBond) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.From))
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bond amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgDepositDeputyBond
+func (msg MsgDepositDeputyBond) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// MsgWithdrawDeputyBond withdraws coins from a deputy's bond
+type MsgWithdrawDeputyBond struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawDeputyBond initializes a new MsgWithdrawDeputyBond
+func NewMsgWithdrawDeputyBond(from sdk.AccAddress, amount sdk.Coins) MsgWithdrawDeputyBond {
+	return MsgWithdrawDeputyBond{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+// Route establishes the route for the MsgWithdrawDeputyBond
+func (msg MsgWithdrawDeputyBond) Route() string { return RouterKey }
+
+// Type is the name of MsgWithdrawDeputyBond
+func (msg MsgWithdrawDeputyBond) Type() string { return WithdrawDeputyBond }
+
+// String prints the MsgWithdrawDeputyBond
+func (msg MsgWithdrawDeputyBond) String() string {
+	return fmt.Sprintf("withdrawDeputyBond{%v#%v}", msg.From, msg.Amount)
+}
+
+// GetSigners gets the signers of a MsgWithdrawDeputyBond
+func (msg MsgWithdrawDeputyBond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic validates the MsgWithdrawDeputyBond
+func (msg MsgWithdrawDeputyBond) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.From))
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgWithdrawDeputyBond
+func (msg MsgWithdrawDeputyBond) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// SwapClaim is the swap ID and secret random number needed to claim one swap in a batch
+type SwapClaim struct {
+	SwapID       tmbytes.HexBytes `json:"swap_id"  yaml:"swap_id"`
+	RandomNumber tmbytes.HexBytes `json:"random_number"  yaml:"random_number"`
+}
+
+// NewSwapClaim initializes a new SwapClaim
+func NewSwapClaim(swapID, randomNumber []byte) SwapClaim {
+	return SwapClaim{
+		SwapID:       swapID,
+		RandomNumber: randomNumber,
+	}
+}
+
+// MsgClaimAtomicSwaps claims a batch of swaps. Each claim succeeds or fails independently.
+type MsgClaimAtomicSwaps struct {
+	From   sdk.AccAddress `json:"from"  yaml:"from"`
+	Claims []SwapClaim    `json:"claims"  yaml:"claims"`
+}
+
+// NewMsgClaimAtomicSwaps initializes a new MsgClaimAtomicSwaps
+func NewMsgClaimAtomicSwaps(from sdk.AccAddress, claims []SwapClaim) MsgClaimAtomicSwaps {
+	return MsgClaimAtomicSwaps{
+		From:   from,
+		Claims: claims,
+	}
+}
+
+// Route establishes the route for the MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) Route() string { return RouterKey }
+
+// Type is the name of MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) Type() string { return ClaimAtomicSwaps }
+
+// String prints the MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) String() string {
+	return fmt.Sprintf("claimAtomicSwaps{%v#%v}", msg.From, msg.Claims)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), AtomicSwapCoinsAccAddr)
+}
+
+// GetSigners gets the signers of a MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic validates the MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "actual address length ≠ expected length (%d ≠ %d)", len(msg.From), AddrByteCount)
+	}
+	if len(msg.Claims) == 0 || len(msg.Claims) > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of claims must be between 1 and %d, got %d", MaxBatchSize, len(msg.Claims))
+	}
+	seen := make(map[string]bool)
+	for _, claim := range msg.Claims {
+		if len(claim.SwapID) != SwapIDLength {
+			return fmt.Errorf("the length of swapID should be %d", SwapIDLength)
+		}
+		if len(claim.RandomNumber) != RandomNumberLength {
+			return fmt.Errorf("the length of random number should be %d", RandomNumberLength)
+		}
+		if seen[claim.SwapID.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate swap %s", claim.SwapID)
+		}
+		seen[claim.SwapID.String()] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgClaimAtomicSwaps
+func (msg MsgClaimAtomicSwaps) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// MsgRefundAtomicSwaps refunds a batch of swaps. Each refund succeeds or fails independently.
+type MsgRefundAtomicSwaps struct {
+	From    sdk.AccAddress     `json:"from" yaml:"from"`
+	SwapIDs []tmbytes.HexBytes `json:"swap_ids" yaml:"swap_ids"`
+}
+
+// NewMsgRefundAtomicSwaps initializes a new MsgRefundAtomicSwaps
+func NewMsgRefundAtomicSwaps(from sdk.AccAddress, swapIDs []tmbytes.HexBytes) MsgRefundAtomicSwaps {
+	return MsgRefundAtomicSwaps{
+		From:    from,
+		SwapIDs: swapIDs,
+	}
+}
+
+// Route establishes the route for the MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) Route() string { return RouterKey }
+
+// Type is the name of MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) Type() string { return RefundAtomicSwaps }
+
+// String prints the MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) String() string {
+	return fmt.Sprintf("refundAtomicSwaps{%v#%v}", msg.From, msg.SwapIDs)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), AtomicSwapCoinsAccAddr)
+}
+
+// GetSigners gets the signers of a MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic validates the MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.From))
+	}
+	if len(msg.SwapIDs) == 0 || len(msg.SwapIDs) > MaxBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of swaps must be between 1 and %d, got %d", MaxBatchSize, len(msg.SwapIDs))
+	}
+	seen := make(map[string]bool)
+	for _, swapID := range msg.SwapIDs {
+		if len(swapID) != SwapIDLength {
+			return fmt.Errorf("the length of swapID should be %d", SwapIDLength)
+		}
+		if seen[swapID.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate swap %s", swapID)
+		}
+		seen[swapID.String()] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgRefundAtomicSwaps
+func (msg MsgRefundAtomicSwaps) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -66,6 +67,47 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 	}
 }
 
+func TestMsgCreatePeerAtomicSwap(t *testing.T) {
+	tests := []struct {
+		description         string
+		from                sdk.AccAddress
+		to                  sdk.AccAddress
+		recipientOtherChain string
+		senderOtherChain    string
+		amount              sdk.Coins
+		heightSpan          uint64
+		expectPass          bool
+	}{
+		{"normal", kavaAddrs[0], kavaAddrs[1], "", "", coinsSingle, 500, true},
+		{"normal cross-chain", kavaAddrs[0], kavaAddrs[1], binanceAddrs[1].String(), binanceAddrs[0].String(), coinsSingle, 500, true},
+		{"one other chain field", kavaAddrs[0], kavaAddrs[1], binanceAddrs[1].String(), "", coinsSingle, 500, false},
+		{"same sender and recipient", kavaAddrs[0], kavaAddrs[0], "", "", coinsSingle, 500, false},
+		{"invalid amount", kavaAddrs[0], kavaAddrs[1], "", "", coinsZero, 500, false},
+		{"multiple coins", kavaAddrs[0], kavaAddrs[1], "", "", coinsSingle.Add(sdk.NewInt64Coin("ukava", 1)), 500, false},
+		{"zero height span", kavaAddrs[0], kavaAddrs[1], "", "", coinsSingle, 0, false},
+		{"height span too long", kavaAddrs[0], kavaAddrs[1], "", "", coinsSingle, types.MaxPeerSwapHeightSpan + 1, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCreatePeerAtomicSwap(
+			tc.from,
+			tc.to,
+			tc.recipientOtherChain,
+			tc.senderOtherChain,
+			randomNumberHash,
+			timestampInt64,
+			tc.amount,
+			tc.heightSpan,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.description)
+			require.Equal(t, tc.recipientOtherChain != "", msg.IsCrossChain(), tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.description)
+		}
+	}
+}
+
 func TestMsgClaimAtomicSwap(t *testing.T) {
 	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
 
@@ -117,3 +159,98 @@ func TestMsgRefundAtomicSwap(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgDeputyBond(t *testing.T) {
+	bond := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+	tests := []struct {
+		description string
+		from        sdk.AccAddress
+		amount      sdk.Coins
+		expectPass  bool
+	}{
+		{"normal", kavaAddrs[0], bond, true},
+		{"empty from", sdk.AccAddress{}, bond, false},
+		{"empty amount", kavaAddrs[0], sdk.Coins{}, false},
+		{"invalid amount", kavaAddrs[0], coinsZero, false},
+	}
+
+	for i, tc := range tests {
+		msgs := []sdk.Msg{
+			types.NewMsgDepositDeputyBond(tc.from, tc.amount),
+			types.NewMsgWithdrawDeputyBond(tc.from, tc.amount),
+		}
+		for _, msg := range msgs {
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			} else {
+				require.Error(t, msg.ValidateBasic(), "test: %v", i)
+			}
+		}
+	}
+}
+
+func TestMsgClaimAtomicSwaps(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+	otherSwapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[1], "")
+	randomNumber := make([]byte, types.RandomNumberLength)
+	tooMany := make([]types.SwapClaim, types.MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewSwapClaim(types.CalculateSwapID(randomNumberHash, binanceAddrs[0], fmt.Sprint(i)), randomNumber)
+	}
+
+	tests := []struct {
+		description string
+		from        sdk.AccAddress
+		claims      []types.SwapClaim
+		expectPass  bool
+	}{
+		{"normal", binanceAddrs[0], []types.SwapClaim{types.NewSwapClaim(swapID, randomNumber), types.NewSwapClaim(otherSwapID, randomNumber)}, true},
+		{"empty from", sdk.AccAddress{}, []types.SwapClaim{types.NewSwapClaim(swapID, randomNumber)}, false},
+		{"no claims", binanceAddrs[0], nil, false},
+		{"too many claims", binanceAddrs[0], tooMany, false},
+		{"invalid swap id", binanceAddrs[0], []types.SwapClaim{types.NewSwapClaim(swapID[:10], randomNumber)}, false},
+		{"invalid random number", binanceAddrs[0], []types.SwapClaim{types.NewSwapClaim(swapID, randomNumber[:10])}, false},
+		{"duplicate swap", binanceAddrs[0], []types.SwapClaim{types.NewSwapClaim(swapID, randomNumber), types.NewSwapClaim(swapID, randomNumber)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgClaimAtomicSwaps(tc.from, tc.claims)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.description)
+		}
+	}
+}
+
+func TestMsgRefundAtomicSwaps(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+	otherSwapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[1], "")
+	tooMany := make([]tmbytes.HexBytes, types.MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = types.CalculateSwapID(randomNumberHash, binanceAddrs[0], fmt.Sprint(i))
+	}
+
+	tests := []struct {
+		description string
+		from        sdk.AccAddress
+		swapIDs     []tmbytes.HexBytes
+		expectPass  bool
+	}{
+		{"normal", binanceAddrs[0], []tmbytes.HexBytes{swapID, otherSwapID}, true},
+		{"empty from", sdk.AccAddress{}, []tmbytes.HexBytes{swapID}, false},
+		{"no swaps", binanceAddrs[0], nil, false},
+		{"too many swaps", binanceAddrs[0], tooMany, false},
+		{"invalid swap id", binanceAddrs[0], []tmbytes.HexBytes{swapID[:10]}, false},
+		{"duplicate swap", binanceAddrs[0], []tmbytes.HexBytes{swapID, swapID}, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRefundAtomicSwaps(tc.from, tc.swapIDs)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.description)
+		}
+	}
+}
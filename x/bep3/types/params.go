@@ -63,6 +63,14 @@ type AssetParam struct {
 	MaxSwapAmount sdk.Int        `json:"max_swap_amount" yaml:"max_swap_amount"` // Maximum swap amount
 	MinBlockLock  uint64         `json:"min_block_lock" yaml:"min_block_lock"`   // Minimum swap block lock
 	MaxBlockLock  uint64         `json:"max_block_lock" yaml:"max_block_lock"`   // Maximum swap block lock
+	// AdditionalDeputies are relayer addresses authorized alongside the deputy address
+	AdditionalDeputies []sdk.AccAddress `json:"additional_deputies" yaml:"additional_deputies"`
+	// RetiringDeputies are former deputies that can still submit txs for in-flight swaps until the end of their grace period
+	RetiringDeputies RetiringDeputies `json:"retiring_deputies" yaml:"retiring_deputies"`
+	// FeeSchedule is the fee charged on chain for outgoing swaps. When empty, the deputy charges the fixed fee during the relay process.
+	FeeSchedule FeeSchedule `json:"fee_schedule" yaml:"fee_schedule"`
+	// MinDeputyBond is the bond a deputy must hold for swaps of the asset to be created
+	MinDeputyBond sdk.Coins `json:"min_deputy_bond" yaml:"min_deputy_bond"`
 }
 
 // NewAssetParam returns a new AssetParam
@@ -97,9 +105,159 @@ func (ap AssetParam) String() string {
 	Min Swap Amount: %s
 	Max Swap Amount: %s
 	Min Block Lock: %d
-	Max Block Lock: %d`,
+	Max Block Lock: %d
+	Additional Deputies: %s
+	Retiring Deputies: %s
+	Fee Schedule: %s
+	Min Deputy Bond: %s`,
 		ap.Denom, ap.CoinID, ap.SupplyLimit, ap.Active, ap.DeputyAddress, ap.FixedFee,
-		ap.MinSwapAmount, ap.MaxSwapAmount, ap.MinBlockLock, ap.MaxBlockLock)
+		ap.MinSwapAmount, ap.MaxSwapAmount, ap.MinBlockLock, ap.MaxBlockLock,
+		ap.AdditionalDeputies, ap.RetiringDeputies, ap.FeeSchedule, ap.MinDeputyBond)
+}
+
+// HasFeeSchedule returns true if fees for the asset's outgoing swaps are charged on chain
+func (ap AssetParam) HasFeeSchedule() bool {
+	return len(ap.FeeSchedule) > 0
+}
+
+// GetOutgoingSwapFee returns the fee for an outgoing swap of an amount of the asset.
+// Without a fee schedule this is the fixed fee, which the deputy charges during the relay process.
+func (ap AssetParam) GetOutgoingSwapFee(amount sdk.Int) sdk.Int {
+	if !ap.HasFeeSchedule() {
+		return ap.FixedFee
+	}
+	return ap.FeeSchedule.GetFee(amount)
+}
+
+// GetDeputies returns the addresses of an asset's active deputies
+func (ap AssetParam) GetDeputies() []sdk.AccAddress {
+	return append([]sdk.AccAddress{ap.DeputyAddress}, ap.AdditionalDeputies...)
+}
+
+// IsDeputy returns true if an address is one of an asset's active deputies
+func (ap AssetParam) IsDeputy(addr sdk.AccAddress) bool {
+	for _, deputy := range ap.GetDeputies() {
+		if deputy.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetiringDeputy is a former deputy of an asset, authorized to submit txs until the end of its grace period
+type RetiringDeputy struct {
+	Address        sdk.AccAddress `json:"address" yaml:"address"`
+	GracePeriodEnd time.Time      `json:"grace_period_end" yaml:"grace_period_end"`
+}
+
+// NewRetiringDeputy returns a new RetiringDeputy
+func NewRetiringDeputy(address sdk.AccAddress, gracePeriodEnd time.Time) RetiringDeputy {
+	return RetiringDeputy{
+		Address:        address,
+		GracePeriodEnd: gracePeriodEnd,
+	}
+}
+
+// IsActive returns true if the deputy's grace period hasn't ended at the block time
+func (rd RetiringDeputy) IsActive(blockTime time.Time) bool {
+	return blockTime.Before(rd.GracePeriodEnd)
+}
+
+// String implements fmt.Stringer
+func (rd RetiringDeputy) String() string {
+	return fmt.Sprintf("%s (until %s)", rd.Address, rd.GracePeriodEnd)
+}
+
+// RetiringDeputies slice of RetiringDeputy
+type RetiringDeputies []RetiringDeputy
+
+// FeeTier is the fee charged on outgoing swaps of at least a minimum amount: a fixed fee plus a percentage of
+// the swap amount, kept within a minimum and maximum fee
+type FeeTier struct {
+	MinAmount sdk.Int `json:"min_amount" yaml:"min_amount"` // swaps of at least this amount are charged by the tier
+	FixedFee  sdk.Int `json:"fixed_fee" yaml:"fixed_fee"`
+	Rate      sdk.Dec `json:"rate" yaml:"rate"` // fraction of the swap amount charged on top of the fixed fee
+	MinFee    sdk.Int `json:"min_fee" yaml:"min_fee"`
+	MaxFee    sdk.Int `json:"max_fee" yaml:"max_fee"`
+}
+
+// NewFeeTier returns a new FeeTier
+func NewFeeTier(minAmount, fixedFee sdk.Int, rate sdk.Dec, minFee, maxFee sdk.Int) FeeTier {
+	return FeeTier{
+		MinAmount: minAmount,
+		FixedFee:  fixedFee,
+		Rate:      rate,
+		MinFee:    minFee,
+		MaxFee:    maxFee,
+	}
+}
+
+// GetFee returns the tier's fee for a swap amount
+func (ft FeeTier) GetFee(amount sdk.Int) sdk.Int {
+	fee := ft.FixedFee.Add(amount.ToDec().Mul(ft.Rate).TruncateInt())
+	if fee.LT(ft.MinFee) {
+		return ft.MinFee
+	}
+	if fee.GT(ft.MaxFee) {
+		return ft.MaxFee
+	}
+	return fee
+}
+
+// Validate performs a basic check of a FeeTier
+func (ft FeeTier) Validate() error {
+	if ft.MinAmount.IsNil() || ft.MinAmount.IsNegative() {
+		return fmt.Errorf("fee tier min amount cannot be negative: %s", ft.MinAmount)
+	}
+	if ft.FixedFee.IsNil() || ft.FixedFee.IsNegative() {
+		return fmt.Errorf("fee tier fixed fee cannot be negative: %s", ft.FixedFee)
+	}
+	if ft.Rate.IsNil() || ft.Rate.IsNegative() || ft.Rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee tier rate must be between 0 and 1: %s", ft.Rate)
+	}
+	if ft.MinFee.IsNil() || ft.MinFee.IsNegative() {
+		return fmt.Errorf("fee tier min fee cannot be negative: %s", ft.MinFee)
+	}
+	if ft.MaxFee.IsNil() || ft.MaxFee.LT(ft.MinFee) {
+		return fmt.Errorf("fee tier max fee %s cannot be less than min fee %s", ft.MaxFee, ft.MinFee)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ft FeeTier) String() string {
+	return fmt.Sprintf("from %s: %s + %s (min %s, max %s)", ft.MinAmount, ft.FixedFee, ft.Rate, ft.MinFee, ft.MaxFee)
+}
+
+// FeeSchedule is a list of fee tiers, ordered by increasing min amount
+type FeeSchedule []FeeTier
+
+// GetFee returns the fee for a swap amount, charged by the tier with the largest min amount that the amount reaches
+func (fs FeeSchedule) GetFee(amount sdk.Int) sdk.Int {
+	fee := sdk.ZeroInt()
+	for _, tier := range fs {
+		if amount.LT(tier.MinAmount) {
+			break
+		}
+		fee = tier.GetFee(amount)
+	}
+	return fee
+}
+
+// Validate checks that all tiers are valid and ordered, and that the first tier applies to all swap amounts
+func (fs FeeSchedule) Validate() error {
+	for i, tier := range fs {
+		if err := tier.Validate(); err != nil {
+			return err
+		}
+		if i == 0 && !tier.MinAmount.IsZero() {
+			return fmt.Errorf("first fee tier must have a min amount of 0, got %s", tier.MinAmount)
+		}
+		if i > 0 && tier.MinAmount.LTE(fs[i-1].MinAmount) {
+			return fmt.Errorf("fee tiers must be ordered by increasing min amount: %s <= %s", tier.MinAmount, fs[i-1].MinAmount)
+		}
+	}
+	return nil
 }
 
 // AssetParams array of AssetParam
@@ -194,14 +352,45 @@ func validateAssetParams(i interface{}) error {
 			return fmt.Errorf("deputy address cannot be empty for %s", asset.Denom)
 		}
 
-		if len(asset.DeputyAddress.Bytes()) != sdk.AddrLen {
-			return fmt.Errorf("%s deputy address invalid bytes length got %d, want %d", asset.Denom, len(asset.DeputyAddress.Bytes()), sdk.AddrLen)
+		deputies := make(map[string]bool)
+		for _, deputy := range asset.GetDeputies() {
+			if deputy.Empty() {
+				return fmt.Errorf("deputy address cannot be empty for %s", asset.Denom)
+			}
+			if len(deputy.Bytes()) != sdk.AddrLen {
+				return fmt.Errorf("%s deputy address invalid bytes length got %d, want %d", asset.Denom, len(deputy.Bytes()), sdk.AddrLen)
+			}
+			if deputies[deputy.String()] {
+				return fmt.Errorf("asset %s cannot have duplicate deputy %s", asset.Denom, deputy)
+			}
+			deputies[deputy.String()] = true
+		}
+
+		for _, retiring := range asset.RetiringDeputies {
+			if len(retiring.Address.Bytes()) != sdk.AddrLen {
+				return fmt.Errorf("%s retiring deputy address invalid bytes length got %d, want %d", asset.Denom, len(retiring.Address.Bytes()), sdk.AddrLen)
+			}
+			if deputies[retiring.Address.String()] {
+				return fmt.Errorf("asset %s cannot have duplicate deputy %s", asset.Denom, retiring.Address)
+			}
+			if retiring.GracePeriodEnd.IsZero() {
+				return fmt.Errorf("asset %s retiring deputy %s must have a grace period end", asset.Denom, retiring.Address)
+			}
+			deputies[retiring.Address.String()] = true
 		}
 
 		if asset.FixedFee.IsNegative() {
 			return fmt.Errorf("asset %s cannot have a negative fixed fee %s", asset.Denom, asset.FixedFee)
 		}
 
+		if err := asset.FeeSchedule.Validate(); err != nil {
+			return fmt.Errorf("asset %s has invalid fee schedule: %w", asset.Denom, err)
+		}
+
+		if !asset.MinDeputyBond.IsValid() {
+			return fmt.Errorf("asset %s has invalid min deputy bond %s", asset.Denom, asset.MinDeputyBond)
+		}
+
 		if asset.MinBlockLock > asset.MaxBlockLock {
 			return fmt.Errorf("asset %s has minimum block lock > maximum block lock %d > %d", asset.Denom, asset.MinBlockLock, asset.MaxBlockLock)
 		}
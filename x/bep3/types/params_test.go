@@ -254,6 +254,113 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestDeputyValidation() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	gracePeriodEnd := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		additional  []sdk.AccAddress
+		retiring    types.RetiringDeputies
+		expectedErr string
+	}{
+		{"valid rotation", []sdk.AccAddress{addrs[1]}, types.RetiringDeputies{types.NewRetiringDeputy(addrs[2], gracePeriodEnd)}, ""},
+		{"empty additional deputy", []sdk.AccAddress{{}}, nil, "deputy address cannot be empty"},
+		{"duplicate additional deputy", []sdk.AccAddress{addrs[0]}, nil, "duplicate deputy"},
+		{"retiring deputy still active", []sdk.AccAddress{addrs[1]}, types.RetiringDeputies{types.NewRetiringDeputy(addrs[1], gracePeriodEnd)}, "duplicate deputy"},
+		{"retiring deputy without grace period", nil, types.RetiringDeputies{types.NewRetiringDeputy(addrs[2], time.Time{})}, "grace period end"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			asset := types.NewAssetParam(
+				"bnb", 714, suite.supply[0], true,
+				addrs[0], sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+				types.DefaultMinBlockLock, types.DefaultMaxBlockLock)
+			asset.AdditionalDeputies = tc.additional
+			asset.RetiringDeputies = tc.retiring
+			err := types.NewParams(types.AssetParams{asset}).Validate()
+			if tc.expectedErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestFeeScheduleValidation() {
+	d := sdk.MustNewDecFromStr
+	testCases := []struct {
+		name        string
+		schedule    types.FeeSchedule
+		expectedErr string
+	}{
+		{"empty schedule", nil, ""},
+		{"valid tiers", types.FeeSchedule{
+			types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(1000), d("0.01"), sdk.NewInt(1000), sdk.NewInt(5000)),
+			types.NewFeeTier(sdk.NewInt(1000000), sdk.ZeroInt(), d("0.001"), sdk.NewInt(5000), sdk.NewInt(50000)),
+		}, ""},
+		{"first tier not from zero", types.FeeSchedule{
+			types.NewFeeTier(sdk.NewInt(1), sdk.NewInt(1000), d("0.01"), sdk.ZeroInt(), sdk.NewInt(5000)),
+		}, "first fee tier"},
+		{"unordered tiers", types.FeeSchedule{
+			types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(1000), d("0.01"), sdk.ZeroInt(), sdk.NewInt(5000)),
+			types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(1000), d("0.01"), sdk.ZeroInt(), sdk.NewInt(5000)),
+		}, "increasing min amount"},
+		{"rate above one", types.FeeSchedule{
+			types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(1000), d("1.01"), sdk.ZeroInt(), sdk.NewInt(5000)),
+		}, "rate must be between 0 and 1"},
+		{"max fee below min fee", types.FeeSchedule{
+			types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(1000), d("0.01"), sdk.NewInt(5000), sdk.NewInt(1000)),
+		}, "cannot be less than min fee"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			asset := types.NewAssetParam(
+				"bnb", 714, suite.supply[0], true,
+				suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+				types.DefaultMinBlockLock, types.DefaultMaxBlockLock)
+			asset.FeeSchedule = tc.schedule
+			err := types.NewParams(types.AssetParams{asset}).Validate()
+			if tc.expectedErr == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestGetOutgoingSwapFee() {
+	d := sdk.MustNewDecFromStr
+	asset := types.NewAssetParam(
+		"bnb", 714, suite.supply[0], true,
+		suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+		types.DefaultMinBlockLock, types.DefaultMaxBlockLock)
+
+	// assets without a fee schedule charge the fixed fee
+	suite.Require().Equal(sdk.NewInt(1000), asset.GetOutgoingSwapFee(sdk.NewInt(1000000)))
+
+	asset.FeeSchedule = types.FeeSchedule{
+		types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(100), d("0.01"), sdk.NewInt(500), sdk.NewInt(5000)),
+		types.NewFeeTier(sdk.NewInt(1000000), sdk.NewInt(1000), d("0.001"), sdk.ZeroInt(), sdk.NewInt(50000)),
+	}
+	testCases := []struct {
+		amount      int64
+		expectedFee int64
+	}{
+		{10000, 500},       // 100 + 100, raised to the min fee
+		{100000, 1100},     // 100 + 1000
+		{999999, 5000},     // 100 + 9999, capped at the max fee
+		{1000000, 2000},    // second tier: 1000 + 1000
+		{100000000, 50000}, // 1000 + 100000, capped at the max fee
+	}
+	for _, tc := range testCases {
+		suite.Require().Equal(sdk.NewInt(tc.expectedFee), asset.GetOutgoingSwapFee(sdk.NewInt(tc.amount)), "amount %d", tc.amount)
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
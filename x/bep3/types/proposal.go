@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeDeputyBondSlash defines the type for a DeputyBondSlashProposal
+	ProposalTypeDeputyBondSlash = "Bep3DeputyBondSlash"
+)
+
+// Assert DeputyBondSlashProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = DeputyBondSlashProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDeputyBondSlash)
+	govtypes.RegisterProposalTypeCodec(DeputyBondSlashProposal{}, "kava/Bep3DeputyBondSlashProposal")
+}
+
+// DeputyBondSlashProposal burns a fraction of a deputy's bond, on evidence of misbehavior given in the description
+type DeputyBondSlashProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Deputy      sdk.AccAddress `json:"deputy" yaml:"deputy"`
+	Fraction    sdk.Dec        `json:"fraction" yaml:"fraction"` // fraction of the deputy's bond to slash, in (0, 1]
+}
+
+// NewDeputyBondSlashProposal creates a new deputy bond slash proposal.
+func NewDeputyBondSlashProposal(title, description string, deputy sdk.AccAddress, fraction sdk.Dec) DeputyBondSlashProposal {
+	return DeputyBondSlashProposal{
+		Title:       title,
+		Description: description,
+		Deputy:      deputy,
+		Fraction:    fraction,
+	}
+}
+
+// GetTitle returns the title of a deputy bond slash proposal.
+func (dsp DeputyBondSlashProposal) GetTitle() string { return dsp.Title }
+
+// GetDescription returns the description of a deputy bond slash proposal.
+func (dsp DeputyBondSlashProposal) GetDescription() string { return dsp.Description }
+
+// ProposalRoute returns the routing key of a deputy bond slash proposal.
+func (dsp DeputyBondSlashProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a deputy bond slash proposal.
+func (dsp DeputyBondSlashProposal) ProposalType() string { return ProposalTypeDeputyBondSlash }
+
+// ValidateBasic stateless validation of a deputy bond slash proposal.
+func (dsp DeputyBondSlashProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(dsp); err != nil {
+		return err
+	}
+	if dsp.Deputy.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "deputy address cannot be empty")
+	}
+	if dsp.Fraction.IsNil() || !dsp.Fraction.IsPositive() || dsp.Fraction.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "slash fraction must be in (0, 1], got %s", dsp.Fraction)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (dsp DeputyBondSlashProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Bep3 Deputy Bond Slash Proposal:
+  Title:       %s
+  Description: %s
+  Deputy:      %s
+  Fraction:    %s
+`, dsp.Title, dsp.Description, dsp.Deputy, dsp.Fraction))
+	return b.String()
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+func TestDeputyBondSlashProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		description string
+		title       string
+		deputy      sdk.AccAddress
+		fraction    sdk.Dec
+		expectPass  bool
+	}{
+		{"normal", "A Title", kavaAddrs[0], sdk.MustNewDecFromStr("0.1"), true},
+		{"full bond", "A Title", kavaAddrs[0], sdk.OneDec(), true},
+		{"empty title", "", kavaAddrs[0], sdk.MustNewDecFromStr("0.1"), false},
+		{"empty deputy", "A Title", sdk.AccAddress{}, sdk.MustNewDecFromStr("0.1"), false},
+		{"zero fraction", "A Title", kavaAddrs[0], sdk.ZeroDec(), false},
+		{"fraction above one", "A Title", kavaAddrs[0], sdk.MustNewDecFromStr("1.1"), false},
+		{"nil fraction", "A Title", kavaAddrs[0], sdk.Dec{}, false},
+	}
+
+	for _, tc := range tests {
+		proposal := types.NewDeputyBondSlashProposal(tc.title, "A description of the deputy's misbehavior.", tc.deputy, tc.fraction)
+		if tc.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), tc.description)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), tc.description)
+		}
+	}
+}
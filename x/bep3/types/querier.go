@@ -14,8 +14,24 @@ const (
 	QueryGetAtomicSwap = "swap"
 	// QueryGetAtomicSwaps command for getting a list of atomic swaps
 	QueryGetAtomicSwaps = "swaps"
+	// QueryGetPeerAtomicSwaps command for getting a list of peer to peer atomic swaps
+	QueryGetPeerAtomicSwaps = "peer-swaps"
 	// QueryGetParams command for getting module params
 	QueryGetParams = "parameters"
+	// QueryGetCapacity command for getting the amount of an asset that can still be swapped in
+	QueryGetCapacity = "capacity"
+	// QueryGetDeputyBonds command for getting a list of deputy bonds
+	QueryGetDeputyBonds = "deputy-bonds"
+	// QueryGetSwapFee command for getting the fee charged on an outgoing swap
+	QueryGetSwapFee = "swap-fee"
+	// QueryGetCollectedFees command for getting the swap fees collected by deputies
+	QueryGetCollectedFees = "collected-fees"
+	// QueryGetAtomicSwapsByRandomNumberHash command for getting the atomic swaps locked with a random number hash
+	QueryGetAtomicSwapsByRandomNumberHash = "swaps-by-random-number-hash"
+	// QueryGetAtomicSwapsBySenderOtherChain command for getting the atomic swaps created by an address on the other chain
+	QueryGetAtomicSwapsBySenderOtherChain = "swaps-by-sender-other-chain"
+	// QueryGetAtomicSwapsByExpiry command for getting the atomic swaps expiring in a range of block heights
+	QueryGetAtomicSwapsByExpiry = "swaps-by-expiry"
 )
 
 // QueryAssetSupply contains the params for query 'custom/bep3/supply'
@@ -30,6 +46,18 @@ func NewQueryAssetSupply(denom string) QueryAssetSupply {
 	}
 }
 
+// QueryCapacity contains the params for query 'custom/bep3/capacity'
+type QueryCapacity struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryCapacity creates a new QueryCapacity
+func NewQueryCapacity(denom string) QueryCapacity {
+	return QueryCapacity{
+		Denom: denom,
+	}
+}
+
 // QueryAssetSupplies contains the params for an AssetSupplies query
 type QueryAssetSupplies struct {
 	Page  int `json:"page" yaml:"page"`
@@ -78,3 +106,108 @@ func NewQueryAtomicSwaps(page, limit int, involve sdk.AccAddress, expiration uin
 		Direction:  direction,
 	}
 }
+
+// QueryPeerAtomicSwaps contains the params for a peer to peer AtomicSwaps query
+type QueryPeerAtomicSwaps struct {
+	Page      int            `json:"page" yaml:"page"`
+	Limit     int            `json:"limit" yaml:"limit"`
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	Status    SwapStatus     `json:"status" yaml:"status"`
+}
+
+// NewQueryPeerAtomicSwaps creates a new instance of QueryPeerAtomicSwaps
+func NewQueryPeerAtomicSwaps(page, limit int, sender, recipient sdk.AccAddress, denom string,
+	status SwapStatus) QueryPeerAtomicSwaps {
+	return QueryPeerAtomicSwaps{
+		Page:      page,
+		Limit:     limit,
+		Sender:    sender,
+		Recipient: recipient,
+		Denom:     denom,
+		Status:    status,
+	}
+}
+
+// QueryDeputyBonds contains the params for a deputy bonds query
+type QueryDeputyBonds struct {
+	Page   int            `json:"page" yaml:"page"`
+	Limit  int            `json:"limit" yaml:"limit"`
+	Deputy sdk.AccAddress `json:"deputy" yaml:"deputy"`
+}
+
+// NewQueryDeputyBonds creates a new instance of QueryDeputyBonds
+func NewQueryDeputyBonds(page, limit int, deputy sdk.AccAddress) QueryDeputyBonds {
+	return QueryDeputyBonds{
+		Page:   page,
+		Limit:  limit,
+		Deputy: deputy,
+	}
+}
+
+// QueryCollectedFees contains the params for a collected fees query
+type QueryCollectedFees struct {
+	Page   int            `json:"page" yaml:"page"`
+	Limit  int            `json:"limit" yaml:"limit"`
+	Deputy sdk.AccAddress `json:"deputy" yaml:"deputy"`
+}
+
+// NewQueryCollectedFees creates a new instance of QueryCollectedFees
+func NewQueryCollectedFees(page, limit int, deputy sdk.AccAddress) QueryCollectedFees {
+	return QueryCollectedFees{
+		Page:   page,
+		Limit:  limit,
+		Deputy: deputy,
+	}
+}
+
+// QueryAtomicSwapsByRandomNumberHash contains the params for a query of the AtomicSwaps locked with a random number hash
+type QueryAtomicSwapsByRandomNumberHash struct {
+	Page             int              `json:"page" yaml:"page"`
+	Limit            int              `json:"limit" yaml:"limit"`
+	RandomNumberHash tmbytes.HexBytes `json:"random_number_hash" yaml:"random_number_hash"`
+}
+
+// NewQueryAtomicSwapsByRandomNumberHash creates a new instance of QueryAtomicSwapsByRandomNumberHash
+func NewQueryAtomicSwapsByRandomNumberHash(page, limit int, randomNumberHash tmbytes.HexBytes) QueryAtomicSwapsByRandomNumberHash {
+	return QueryAtomicSwapsByRandomNumberHash{
+		Page:             page,
+		Limit:            limit,
+		RandomNumberHash: randomNumberHash,
+	}
+}
+
+// QueryAtomicSwapsBySenderOtherChain contains the params for a query of the AtomicSwaps created by an address on the other chain
+type QueryAtomicSwapsBySenderOtherChain struct {
+	Page             int    `json:"page" yaml:"page"`
+	Limit            int    `json:"limit" yaml:"limit"`
+	SenderOtherChain string `json:"sender_other_chain" yaml:"sender_other_chain"`
+}
+
+// NewQueryAtomicSwapsBySenderOtherChain creates a new instance of QueryAtomicSwapsBySenderOtherChain
+func NewQueryAtomicSwapsBySenderOtherChain(page, limit int, senderOtherChain string) QueryAtomicSwapsBySenderOtherChain {
+	return QueryAtomicSwapsBySenderOtherChain{
+		Page:             page,
+		Limit:            limit,
+		SenderOtherChain: senderOtherChain,
+	}
+}
+
+// QueryAtomicSwapsByExpiry contains the params for a query of the AtomicSwaps expiring in an inclusive range of block heights
+type QueryAtomicSwapsByExpiry struct {
+	Page            int    `json:"page" yaml:"page"`
+	Limit           int    `json:"limit" yaml:"limit"`
+	MinExpireHeight uint64 `json:"min_expire_height" yaml:"min_expire_height"`
+	MaxExpireHeight uint64 `json:"max_expire_height" yaml:"max_expire_height"`
+}
+
+// NewQueryAtomicSwapsByExpiry creates a new instance of QueryAtomicSwapsByExpiry
+func NewQueryAtomicSwapsByExpiry(page, limit int, minExpireHeight, maxExpireHeight uint64) QueryAtomicSwapsByExpiry {
+	return QueryAtomicSwapsByExpiry{
+		Page:            page,
+		Limit:           limit,
+		MinExpireHeight: minExpireHeight,
+		MaxExpireHeight: maxExpireHeight,
+	}
+}
@@ -8,7 +8,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// AssetSupply contains information about an asset's supply
+// AssetSupply contains information about an asset's supply.
+// TimeLimitedCurrentSupply is the net amount swapped in within the rolling window of the asset's time-based limit.
+// TimeElapsed is no longer used, as the window has no fixed start; it is kept so existing genesis files can be imported.
 type AssetSupply struct {
 	IncomingSupply           sdk.Coin      `json:"incoming_supply"  yaml:"incoming_supply"`
 	OutgoingSupply           sdk.Coin      `json:"outgoing_supply"  yaml:"outgoing_supply"`
@@ -89,11 +89,14 @@ func (a AtomicSwap) Validate() error {
 		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(a.Recipient))
 	}
 	// NOTE: These adresses may not have a bech32 prefix.
-	if strings.TrimSpace(a.SenderOtherChain) == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender other chain cannot be blank")
-	}
-	if strings.TrimSpace(a.RecipientOtherChain) == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient other chain cannot be blank")
+	// Peer to peer swaps only have addresses on another chain if they are cross-chain.
+	if a.Direction != PeerToPeer || a.CrossChain {
+		if strings.TrimSpace(a.SenderOtherChain) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender other chain cannot be blank")
+		}
+		if strings.TrimSpace(a.RecipientOtherChain) == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient other chain cannot be blank")
+		}
 	}
 	if a.Status == Completed && a.ClosedBlock == 0 {
 		return errors.New("closed block cannot be 0")
@@ -101,7 +104,7 @@ func (a AtomicSwap) Validate() error {
 	if a.Status == NULL || a.Status > 3 {
 		return errors.New("invalid swap status")
 	}
-	if a.Direction == INVALID || a.Direction > 2 {
+	if !a.Direction.IsValid() {
 		return errors.New("invalid swap direction")
 	}
 	return nil
@@ -207,13 +210,16 @@ func (status SwapStatus) IsValid() bool {
 	return false
 }
 
-// SwapDirection is the direction of an AtomicSwap
+// SwapDirection is the direction of an AtomicSwap.
+// Incoming and outgoing swaps move assets on or off chain through a deputy, while peer to peer swaps
+// exchange coins held in escrow between two accounts without changing any asset's supply.
 type SwapDirection byte
 
 const (
-	INVALID  SwapDirection = 0x00
-	Incoming SwapDirection = 0x01
-	Outgoing SwapDirection = 0x02
+	INVALID    SwapDirection = 0x00
+	Incoming   SwapDirection = 0x01
+	Outgoing   SwapDirection = 0x02
+	PeerToPeer SwapDirection = 0x03
 )
 
 // NewSwapDirectionFromString converts string to SwapDirection type
@@ -223,6 +229,8 @@ func NewSwapDirectionFromString(str string) SwapDirection {
 		return Incoming
 	case "Outgoing", "outgoing", "out", "O", "o":
 		return Outgoing
+	case "PeerToPeer", "peertopeer", "peer", "p2p", "P", "p":
+		return PeerToPeer
 	default:
 		return INVALID
 	}
@@ -235,6 +243,8 @@ func (direction SwapDirection) String() string {
 		return "Incoming"
 	case Outgoing:
 		return "Outgoing"
+	case PeerToPeer:
+		return "PeerToPeer"
 	default:
 		return "INVALID"
 	}
@@ -259,7 +269,8 @@ func (direction *SwapDirection) UnmarshalJSON(data []byte) error {
 // IsValid returns true if the swap direction is valid and false otherwise.
 func (direction SwapDirection) IsValid() bool {
 	if direction == Incoming ||
-		direction == Outgoing {
+		direction == Outgoing ||
+		direction == PeerToPeer {
 		return true
 	}
 	return false
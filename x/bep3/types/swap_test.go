@@ -67,6 +67,36 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 			},
 			true,
 		},
+		{
+			"valid peer to peer swap",
+			types.AtomicSwap{
+				Amount:           cs(c("ukava", 50000)),
+				RandomNumberHash: suite.randomNumberHashes[0],
+				ExpireHeight:     360,
+				Timestamp:        suite.timestamps[0],
+				Sender:           suite.addrs[0],
+				Recipient:        suite.addrs[5],
+				Status:           types.Open,
+				CrossChain:       false,
+				Direction:        types.PeerToPeer,
+			},
+			true,
+		},
+		{
+			"cross-chain peer to peer swap without other chain addresses",
+			types.AtomicSwap{
+				Amount:           cs(c("ukava", 50000)),
+				RandomNumberHash: suite.randomNumberHashes[0],
+				ExpireHeight:     360,
+				Timestamp:        suite.timestamps[0],
+				Sender:           suite.addrs[0],
+				Recipient:        suite.addrs[5],
+				Status:           types.Open,
+				CrossChain:       true,
+				Direction:        types.PeerToPeer,
+			},
+			false,
+		},
 		{
 			"invalid amount",
 			types.AtomicSwap{
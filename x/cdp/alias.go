@@ -8,8 +8,10 @@ import (
 )
 
 const (
+	AttributeKeyAmount              = types.AttributeKeyAmount
 	AttributeKeyCdpID               = types.AttributeKeyCdpID
 	AttributeKeyDeposit             = types.AttributeKeyDeposit
+	AttributeKeyDestination         = types.AttributeKeyDestination
 	AttributeKeyError               = types.AttributeKeyError
 	AttributeValueCategory          = types.AttributeValueCategory
 	DefaultParamspace               = types.DefaultParamspace
@@ -21,6 +23,7 @@ const (
 	EventTypeCdpRepay               = types.EventTypeCdpRepay
 	EventTypeCdpWithdrawal          = types.EventTypeCdpWithdrawal
 	EventTypeCreateCdp              = types.EventTypeCreateCdp
+	EventTypeSurplusDistribution    = types.EventTypeSurplusDistribution
 	LiquidatorMacc                  = types.LiquidatorMacc
 	ModuleName                      = types.ModuleName
 	QuerierRoute                    = types.QuerierRoute
@@ -36,6 +39,10 @@ const (
 	RestRatio                       = types.RestRatio
 	RouterKey                       = types.RouterKey
 	StoreKey                        = types.StoreKey
+	SurplusDestinationAuction       = types.SurplusDestinationAuction
+	SurplusDestinationBuyback       = types.SurplusDestinationBuyback
+	SurplusDestinationModuleAccount = types.SurplusDestinationModuleAccount
+	TreasuryMacc                    = types.TreasuryMacc
 )
 
 var (
@@ -78,6 +85,7 @@ var (
 	NewQueryCdpsByCollateralTypeParams = types.NewQueryCdpsByCollateralTypeParams
 	NewQueryCdpsByRatioParams          = types.NewQueryCdpsByRatioParams
 	NewQueryCdpsParams                 = types.NewQueryCdpsParams
+	NewSurplusDistribution             = types.NewSurplusDistribution
 	ParamKeyTable                      = types.ParamKeyTable
 	ParseDecBytes                      = types.ParseDecBytes
 	RegisterCodec                      = types.RegisterCodec
@@ -108,6 +116,7 @@ var (
 	DefaultGovDenom            = types.DefaultGovDenom
 	DefaultStableDenom         = types.DefaultStableDenom
 	DefaultSurplusLot          = types.DefaultSurplusLot
+	DefaultSurplusPolicy       = types.DefaultSurplusPolicy
 	DefaultSurplusThreshold    = types.DefaultSurplusThreshold
 	DepositKeyPrefix           = types.DepositKeyPrefix
 	ErrAccountNotFound         = types.ErrAccountNotFound
@@ -122,6 +131,7 @@ var (
 	ErrDepositNotFound         = types.ErrDepositNotFound
 	ErrExceedsDebtLimit        = types.ErrExceedsDebtLimit
 	ErrInsufficientBalance     = types.ErrInsufficientBalance
+	ErrInvalidBuyback          = types.ErrInvalidBuyback
 	ErrInvalidCollateral       = types.ErrInvalidCollateral
 	ErrInvalidCollateralLength = types.ErrInvalidCollateralLength
 	ErrInvalidCollateralRatio  = types.ErrInvalidCollateralRatio
@@ -140,6 +150,7 @@ var (
 	KeyDebtParam               = types.KeyDebtParam
 	KeyDebtThreshold           = types.KeyDebtThreshold
 	KeyGlobalDebtLimit         = types.KeyGlobalDebtLimit
+	KeySurplusDistribution     = types.KeySurplusDistribution
 	KeySurplusLot              = types.KeySurplusLot
 	KeySurplusThreshold        = types.KeySurplusThreshold
 	MaxSortableDec             = types.MaxSortableDec
@@ -183,5 +194,8 @@ type (
 	QueryCdpsByCollateralTypeParams = types.QueryCdpsByCollateralTypeParams
 	QueryCdpsByRatioParams          = types.QueryCdpsByRatioParams
 	QueryCdpsParams                 = types.QueryCdpsParams
+	SendRestriction                 = types.SendRestriction
 	SupplyKeeper                    = types.SupplyKeeper
+	SurplusDistribution             = types.SurplusDistribution
+	SurplusDistributionPolicy       = types.SurplusDistributionPolicy
 )
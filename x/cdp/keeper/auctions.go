@@ -142,7 +142,7 @@ func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName string) sdk.Int {
 	return acc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
 }
 
-// RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates surplus or debt auctions if the remaining balance is above the auction threshold parameter
+// RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates debt auctions or distributes surplus if the remaining balance is above the auction threshold parameter
 func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 	if err := k.NetSurplusAndDebt(ctx); err != nil {
 		return err
@@ -167,6 +167,5 @@ func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 	}
 
 	surplusLot := sdk.NewCoin(params.DebtParam.Denom, sdk.MinInt(params.SurplusAuctionLot, surplus))
-	_, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, surplusLot, k.GetGovDenom(ctx))
-	return err
+	return k.DistributeSurplus(ctx, surplusLot, params.SurplusDistribution)
 }
@@ -79,6 +79,28 @@ func (suite *AuctionTestSuite) TestSurplusAuction() {
 	suite.Equal(cs(c("usdx", 490000000000)), acc.GetCoins())
 }
 
+func (suite *AuctionTestSuite) TestDistributeSurplus() {
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 100000000000)))
+	suite.Require().NoError(err)
+	policy := types.SurplusDistributionPolicy{
+		types.NewSurplusDistribution(types.SurplusDestinationModuleAccount, types.TreasuryMacc, sdk.ZeroDec(), d("0.5")),
+		types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), d("0.3")),
+		types.NewSurplusDistribution(types.SurplusDestinationBuyback, "", d("0.05"), d("0.2")),
+	}
+
+	err = suite.keeper.DistributeSurplus(suite.ctx, c("usdx", 10000000000), policy)
+	suite.Require().NoError(err)
+
+	acc := sk.GetModuleAccount(suite.ctx, types.TreasuryMacc)
+	suite.Equal(cs(c("usdx", 5000000000)), acc.GetCoins())
+	acc = sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("usdx", 3000000000)), acc.GetCoins())
+	// there is no usdx:ukava swap pool, so the buyback share stays in the liquidator account
+	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("usdx", 92000000000)), acc.GetCoins())
+}
+
 func (suite *AuctionTestSuite) TestDebtAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 100000000000)))
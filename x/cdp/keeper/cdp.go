@@ -50,6 +50,14 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	}
 	cdp := types.NewCDP(id, owner, collateral, collateralType, principal, ctx.BlockHeader().Time, interestFactor)
 	deposit := types.NewDeposit(cdp.ID, owner, collateral)
+	err = k.validateTransfer(ctx, owner, k.supplyKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
+	err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleName), owner, sdk.NewCoins(principal))
+	if err != nil {
+		return err
+	}
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
 	if err != nil {
 		return err
@@ -34,6 +34,10 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddre
 	} else {
 		deposit = types.NewDeposit(cdp.ID, depositor, collateral)
 	}
+	err = k.validateTransfer(ctx, depositor, k.supplyKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(collateral))
 	if err != nil {
 		return err
@@ -84,6 +88,10 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddr
 		return sdkerrors.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, collateral ratio %s, liquidation ration %s", collateral.Denom, collateralizationRatio, liquidationRatio)
 	}
 
+	err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleName), depositor, sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
 	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(collateral))
 	if err != nil {
 		panic(err)
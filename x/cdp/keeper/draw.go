@@ -33,6 +33,11 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralTy
 		return err
 	}
 
+	err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleName), owner, sdk.NewCoins(principal))
+	if err != nil {
+		return err
+	}
+
 	// mint the principal and send it to the cdp owner
 	err = k.supplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(principal))
 	if err != nil {
@@ -100,6 +105,10 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateral
 	if err != nil {
 		return err
 	}
+	err = k.validateTransfer(ctx, owner, k.supplyKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(feePayment.Add(principalPayment)))
+	if err != nil {
+		return err
+	}
 	// send the payment from the sender to the cpd module
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(feePayment.Add(principalPayment)))
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/kava-labs/kava/buyback"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
@@ -21,13 +22,15 @@ type Keeper struct {
 	supplyKeeper    types.SupplyKeeper
 	auctionKeeper   types.AuctionKeeper
 	accountKeeper   types.AccountKeeper
+	buyback         buyback.Buyback
 	hooks           types.CDPHooks
+	sendRestriction types.SendRestriction
 	maccPerms       map[string][]string
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, pfk types.PricefeedKeeper,
-	ak types.AuctionKeeper, sk types.SupplyKeeper, ack types.AccountKeeper, maccs map[string][]string) Keeper {
+	ak types.AuctionKeeper, sk types.SupplyKeeper, ack types.AccountKeeper, swk types.SwapKeeper, maccs map[string][]string) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
@@ -40,6 +43,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		auctionKeeper:   ak,
 		supplyKeeper:    sk,
 		accountKeeper:   ack,
+		buyback:         buyback.NewBuyback(sk, swk, types.LiquidatorMacc),
 		hooks:           nil,
 		maccPerms:       maccs,
 	}
@@ -54,6 +58,23 @@ func (k *Keeper) SetHooks(hooks types.CDPHooks) *Keeper {
 	return k
 }
 
+// SetSendRestriction sets the restriction checked when coins move between accounts and the cdp module
+func (k *Keeper) SetSendRestriction(sr types.SendRestriction) *Keeper {
+	if k.sendRestriction != nil {
+		panic("cannot set cdp send restriction twice")
+	}
+	k.sendRestriction = sr
+	return k
+}
+
+// validateTransfer checks a transfer against the send restriction, if one is set
+func (k Keeper) validateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if k.sendRestriction == nil {
+		return nil
+	}
+	return k.sendRestriction.ValidateTransfer(ctx, from, to, coins)
+}
+
 // CdpDenomIndexIterator returns an sdk.Iterator for all cdps with matching collateral denom
 func (k Keeper) CdpDenomIndexIterator(ctx sdk.Context, collateralType string) sdk.Iterator {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// DistributeSurplus splits a lot of surplus held by the liquidator module account between the destinations of a surplus distribution policy.
+// An empty policy sends the whole lot to a surplus auction.
+// Shares that can't be distributed, such as buybacks exceeding the max slippage, stay in the liquidator module account and are distributed again later.
+func (k Keeper) DistributeSurplus(ctx sdk.Context, lot sdk.Coin, policy types.SurplusDistributionPolicy) error {
+	if len(policy) == 0 {
+		policy = types.DefaultSurplusPolicy
+	}
+
+	shares := policy.Split(lot.Amount)
+	for i, sd := range policy {
+		share := sdk.NewCoin(lot.Denom, shares[i])
+		if !share.IsPositive() {
+			continue
+		}
+
+		switch sd.Destination {
+		case types.SurplusDestinationAuction:
+			if _, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, share, k.GetGovDenom(ctx)); err != nil {
+				return err
+			}
+		case types.SurplusDestinationModuleAccount:
+			if k.supplyKeeper.GetModuleAddress(sd.ModuleAccount) == nil {
+				emitSurplusDistributionError(ctx, fmt.Errorf("module account %s does not exist", sd.ModuleAccount))
+				continue
+			}
+			if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.LiquidatorMacc, sd.ModuleAccount, sdk.NewCoins(share)); err != nil {
+				return err
+			}
+		case types.SurplusDestinationBuyback:
+			if err := k.BuybackAndBurn(ctx, share, sd.MaxSlippage); err != nil {
+				emitSurplusDistributionError(ctx, err)
+				continue
+			}
+		default:
+			return fmt.Errorf("invalid surplus destination: %s", sd.Destination)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSurplusDistribution,
+				sdk.NewAttribute(types.AttributeKeyDestination, sd.Destination),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// BuybackAndBurn swaps surplus held by the liquidator module account for the gov denom through x/swap and burns the gov denom bought.
+// The swap fails if its output is more than maxSlippage less than the surplus is worth in the gov denom at pricefeed prices.
+func (k Keeper) BuybackAndBurn(ctx sdk.Context, surplus sdk.Coin, maxSlippage sdk.Dec) error {
+	govDenom := k.GetGovDenom(ctx)
+	surplusPrice, err := k.getBuybackPrice(ctx, surplus.Denom)
+	if err != nil {
+		return err
+	}
+	govPrice, err := k.getBuybackPrice(ctx, govDenom)
+	if err != nil {
+		return err
+	}
+	if err := k.buyback.BuyAndBurn(ctx, surplus, surplusPrice, govDenom, govPrice, maxSlippage); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidBuyback, err.Error())
+	}
+	return nil
+}
+
+// getBuybackPrice returns the USD value of one base unit of a denom.
+// Debt is valued at one USD per whole token, and other denoms at the spot price of the first collateral type of the denom.
+func (k Keeper) getBuybackPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	if dp, found := k.GetDebtParam(ctx, denom); found {
+		return sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64()), nil
+	}
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		if cp.Denom != denom {
+			continue
+		}
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+		if err != nil {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidBuyback, "no price for %s: %s", denom, err)
+		}
+		return price.Price.Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())), nil
+	}
+	return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidBuyback, "no price for %s", denom)
+}
+
+func emitSurplusDistributionError(ctx sdk.Context, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBeginBlockerFatal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
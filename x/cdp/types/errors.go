@@ -51,4 +51,6 @@ var (
 	ErrInsufficientBalance = sdkerrors.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = sdkerrors.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrInvalidBuyback error for when surplus can't be swapped for the gov denom
+	ErrInvalidBuyback = sdkerrors.Register(ModuleName, 24, "surplus buyback failed")
 )
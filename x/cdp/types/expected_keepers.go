@@ -43,6 +43,11 @@ type AuctionKeeper interface {
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
 }
 
+// SwapKeeper expected interface for the swap keeper (noalias)
+type SwapKeeper interface {
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+}
+
 // AccountKeeper expected interface for the account keeper (noalias)
 type AccountKeeper interface {
 	IterateAccounts(ctx sdk.Context, cb func(account authexported.Account) (stop bool))
@@ -54,3 +59,8 @@ type CDPHooks interface {
 	AfterCDPCreated(ctx sdk.Context, cdp CDP)
 	BeforeCDPModified(ctx sdk.Context, cdp CDP)
 }
+
+// SendRestriction validates transfers of coins between accounts and the module, such as transfers of restricted issuance assets
+type SendRestriction interface {
+	ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+}
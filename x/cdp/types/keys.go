@@ -25,6 +25,9 @@ const (
 
 	// LiquidatorMacc module account for liquidator
 	LiquidatorMacc = "liquidator"
+
+	// TreasuryMacc module account that receives the protocol's share of surplus
+	TreasuryMacc = "treasury"
 )
 
 var sep = []byte(":")
@@ -19,6 +19,7 @@ var (
 	KeyDebtLot              = []byte("DebtLot")
 	KeySurplusThreshold     = []byte("SurplusThreshold")
 	KeySurplusLot           = []byte("SurplusLot")
+	KeySurplusDistribution  = []byte("SurplusDistributionPolicy")
 	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker   = false
 	DefaultCollateralParams = CollateralParams{}
@@ -36,21 +37,25 @@ var (
 	DefaultDebtThreshold    = sdk.NewInt(100000000000)
 	DefaultSurplusLot       = sdk.NewInt(10000000000)
 	DefaultDebtLot          = sdk.NewInt(10000000000)
-	minCollateralPrefix     = 0
-	maxCollateralPrefix     = 255
-	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
+	DefaultSurplusPolicy    = SurplusDistributionPolicy{
+		NewSurplusDistribution(SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.OneDec()),
+	}
+	minCollateralPrefix = 0
+	maxCollateralPrefix = 255
+	stabilityFeeMax     = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 )
 
 // Params governance parameters for cdp module
 type Params struct {
-	CollateralParams        CollateralParams `json:"collateral_params" yaml:"collateral_params"`
-	DebtParam               DebtParam        `json:"debt_param" yaml:"debt_param"`
-	GlobalDebtLimit         sdk.Coin         `json:"global_debt_limit" yaml:"global_debt_limit"`
-	SurplusAuctionThreshold sdk.Int          `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
-	SurplusAuctionLot       sdk.Int          `json:"surplus_auction_lot" yaml:"surplus_auction_lot"`
-	DebtAuctionThreshold    sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
-	DebtAuctionLot          sdk.Int          `json:"debt_auction_lot" yaml:"debt_auction_lot"`
-	CircuitBreaker          bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
+	CollateralParams        CollateralParams          `json:"collateral_params" yaml:"collateral_params"`
+	DebtParam               DebtParam                 `json:"debt_param" yaml:"debt_param"`
+	GlobalDebtLimit         sdk.Coin                  `json:"global_debt_limit" yaml:"global_debt_limit"`
+	SurplusAuctionThreshold sdk.Int                   `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
+	SurplusAuctionLot       sdk.Int                   `json:"surplus_auction_lot" yaml:"surplus_auction_lot"`
+	DebtAuctionThreshold    sdk.Int                   `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
+	DebtAuctionLot          sdk.Int                   `json:"debt_auction_lot" yaml:"debt_auction_lot"`
+	CircuitBreaker          bool                      `json:"circuit_breaker" yaml:"circuit_breaker"`
+	SurplusDistribution     SurplusDistributionPolicy `json:"surplus_distribution_policy" yaml:"surplus_distribution_policy"`
 }
 
 // String implements fmt.Stringer
@@ -63,16 +68,17 @@ func (p Params) String() string {
 	Surplus Auction Lot: %s
 	Debt Auction Threshold: %s
 	Debt Auction Lot: %s
-	Circuit Breaker: %t`,
+	Circuit Breaker: %t
+	%s`,
 		p.GlobalDebtLimit, p.CollateralParams, p.DebtParam, p.SurplusAuctionThreshold, p.SurplusAuctionLot,
-		p.DebtAuctionThreshold, p.DebtAuctionLot, p.CircuitBreaker,
+		p.DebtAuctionThreshold, p.DebtAuctionLot, p.CircuitBreaker, p.SurplusDistribution,
 	)
 }
 
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool,
+	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool, surplusPolicy SurplusDistributionPolicy,
 ) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
@@ -83,6 +89,7 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		SurplusDistribution:     surplusPolicy,
 	}
 }
 
@@ -91,7 +98,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker,
+		DefaultCircuitBreaker, DefaultSurplusPolicy,
 	)
 }
 
@@ -223,6 +230,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		params.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		params.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		params.NewParamSetPair(KeySurplusDistribution, &p.SurplusDistribution, validateSurplusDistributionParam),
 	}
 }
 
@@ -260,6 +268,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSurplusDistributionParam(p.SurplusDistribution); err != nil {
+		return err
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -458,3 +470,12 @@ func validateDebtAuctionLotParam(i interface{}) error {
 
 	return nil
 }
+
+func validateSurplusDistributionParam(i interface{}) error {
+	policy, ok := i.(SurplusDistributionPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return policy.Validate()
+}
@@ -873,7 +873,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, types.DefaultSurplusPolicy)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -885,6 +885,92 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestSurplusDistributionPolicyValidation() {
+	testCases := []struct {
+		name     string
+		policy   types.SurplusDistributionPolicy
+		contains string
+	}{
+		{
+			name:   "empty",
+			policy: types.SurplusDistributionPolicy{},
+		},
+		{
+			name: "valid split",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+				types.NewSurplusDistribution(types.SurplusDestinationBuyback, "", sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.25")),
+				types.NewSurplusDistribution(types.SurplusDestinationModuleAccount, types.TreasuryMacc, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.25")),
+			},
+		},
+		{
+			name: "weights don't sum to one",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+			},
+			contains: "must sum to 1",
+		},
+		{
+			name: "zero weight",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.OneDec()),
+				types.NewSurplusDistribution(types.SurplusDestinationBuyback, "", sdk.ZeroDec(), sdk.ZeroDec()),
+			},
+			contains: "weight must be > 0",
+		},
+		{
+			name: "invalid destination",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution("burn", "", sdk.ZeroDec(), sdk.OneDec()),
+			},
+			contains: "invalid surplus destination",
+		},
+		{
+			name: "buyback slippage too high",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution(types.SurplusDestinationBuyback, "", sdk.MustNewDecFromStr("1.1"), sdk.OneDec()),
+			},
+			contains: "max slippage",
+		},
+		{
+			name: "blank module account",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution(types.SurplusDestinationModuleAccount, "", sdk.ZeroDec(), sdk.OneDec()),
+			},
+			contains: "module account cannot be blank",
+		},
+		{
+			name: "module account on auction destination",
+			policy: types.SurplusDistributionPolicy{
+				types.NewSurplusDistribution(types.SurplusDestinationAuction, types.TreasuryMacc, sdk.ZeroDec(), sdk.OneDec()),
+			},
+			contains: "module account can only be set",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.policy.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestSurplusDistributionPolicySplit() {
+	policy := types.SurplusDistributionPolicy{
+		types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.333333333333333333")),
+		types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.333333333333333333")),
+		types.NewSurplusDistribution(types.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.333333333333333334")),
+	}
+	// the remainder goes to the last distribution
+	suite.Equal([]sdk.Int{sdk.NewInt(33), sdk.NewInt(33), sdk.NewInt(34)}, policy.Split(sdk.NewInt(100)))
+	suite.Equal([]sdk.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}, policy.Split(sdk.ZeroInt()))
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
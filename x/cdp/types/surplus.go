@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Destinations surplus can be distributed to
+const (
+	SurplusDestinationAuction       = "auction"        // sold in surplus auctions for the gov denom, which is burned
+	SurplusDestinationBuyback       = "buyback"        // swapped for the gov denom through x/swap, which is burned
+	SurplusDestinationModuleAccount = "module_account" // sent to a module account, such as the treasury or the kavadist incentive pool
+)
+
+// SurplusDistribution sends a share of surplus to one destination
+type SurplusDistribution struct {
+	Destination   string  `json:"destination" yaml:"destination"`
+	ModuleAccount string  `json:"module_account" yaml:"module_account"` // recipient module account, only used by module account destinations
+	MaxSlippage   sdk.Dec `json:"max_slippage" yaml:"max_slippage"`     // buybacks more than this much worse than the swap pool's spot price are skipped, only used by buyback destinations
+	Weight        sdk.Dec `json:"weight" yaml:"weight"`                 // share of the distributed surplus, the weights of a policy sum to one
+}
+
+// NewSurplusDistribution returns a new SurplusDistribution
+func NewSurplusDistribution(destination, moduleAccount string, maxSlippage, weight sdk.Dec) SurplusDistribution {
+	return SurplusDistribution{
+		Destination:   destination,
+		ModuleAccount: moduleAccount,
+		MaxSlippage:   maxSlippage,
+		Weight:        weight,
+	}
+}
+
+// Validate performs basic validation of a surplus distribution
+func (sd SurplusDistribution) Validate() error {
+	switch sd.Destination {
+	case SurplusDestinationAuction:
+	case SurplusDestinationBuyback:
+		if sd.MaxSlippage.IsNil() || sd.MaxSlippage.IsNegative() || sd.MaxSlippage.GT(sdk.OneDec()) {
+			return fmt.Errorf("buyback max slippage must be between 0 and 1, is %s", sd.MaxSlippage)
+		}
+	case SurplusDestinationModuleAccount:
+		if strings.TrimSpace(sd.ModuleAccount) == "" {
+			return fmt.Errorf("module account cannot be blank for %s destinations", SurplusDestinationModuleAccount)
+		}
+	default:
+		return fmt.Errorf("invalid surplus destination: %s", sd.Destination)
+	}
+	if sd.Destination != SurplusDestinationModuleAccount && sd.ModuleAccount != "" {
+		return fmt.Errorf("module account can only be set for %s destinations, got %s", SurplusDestinationModuleAccount, sd.Destination)
+	}
+	if sd.Weight.IsNil() || !sd.Weight.IsPositive() || sd.Weight.GT(sdk.OneDec()) {
+		return fmt.Errorf("surplus distribution weight must be > 0 and ≤ 1, is %s", sd.Weight)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sd SurplusDistribution) String() string {
+	return fmt.Sprintf(`Surplus Distribution:
+	Destination: %s
+	Module Account: %s
+	Max Slippage: %s
+	Weight: %s`, sd.Destination, sd.ModuleAccount, sd.MaxSlippage, sd.Weight)
+}
+
+// SurplusDistributionPolicy splits surplus between burn auctions, module accounts and market buybacks
+type SurplusDistributionPolicy []SurplusDistribution
+
+// Validate performs basic validation of a surplus distribution policy.
+// An empty policy sends all surplus to auctions.
+func (p SurplusDistributionPolicy) Validate() error {
+	if len(p) == 0 {
+		return nil
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, sd := range p {
+		if err := sd.Validate(); err != nil {
+			return err
+		}
+		totalWeight = totalWeight.Add(sd.Weight)
+	}
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("surplus distribution weights must sum to 1, sum to %s", totalWeight)
+	}
+	return nil
+}
+
+// Split divides an amount between the policy's distributions by weight.
+// Rounding remainders are added to the last distribution so the whole amount is distributed.
+func (p SurplusDistributionPolicy) Split(amount sdk.Int) []sdk.Int {
+	shares := make([]sdk.Int, len(p))
+	remaining := amount
+	for i, sd := range p {
+		if i == len(p)-1 {
+			shares[i] = remaining
+			break
+		}
+		shares[i] = amount.ToDec().Mul(sd.Weight).TruncateInt()
+		remaining = remaining.Sub(shares[i])
+	}
+	return shares
+}
+
+// String implements fmt.Stringer
+func (p SurplusDistributionPolicy) String() string {
+	out := "Surplus Distribution Policy\n"
+	for _, sd := range p {
+		out += fmt.Sprintf("%s\n", sd)
+	}
+	return out
+}
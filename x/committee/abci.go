@@ -8,5 +8,8 @@ import (
 
 // BeginBlocker runs at the start of every block.
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
+	k.ProcessQueuedProposals(ctx)
 	k.ProcessProposals(ctx)
+	k.DeleteExpiredVoteDelegations(ctx)
+	k.DeleteExpiredPauses(ctx)
 }
@@ -126,6 +126,136 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.True(found, "expected non passed proposal to be not closed")
 }
 
+func (suite *ModuleTestSuite) TestBeginBlock_EnactsQueuedAfterDelay() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup committee with an enactment delay
+	delayedCom := committee.NewMemberCommittee(12, "committee description", suite.addresses[:2],
+		[]committee.Permission{committee.GodPermission{}}, d("0.8"), time.Hour*24*7, types.FirstPastThePost)
+	delayedCom.EnactmentDelay = time.Hour * 24
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+
+	previousCDPDebtThreshold := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold
+	newDebtThreshold := previousCDPDebtThreshold.Add(i(1000000))
+	pprop := params.NewParameterChangeProposal("Title 1", "A description of this proposal.",
+		[]params.ParamChange{{
+			Subspace: cdptypes.ModuleName,
+			Key:      string(cdp.KeyDebtThreshold),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(newDebtThreshold)),
+		}},
+	)
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, pprop)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], types.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], types.Yes))
+
+	// Run BeginBlocker, the passed proposal is queued rather than enacted
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	_, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.False(found, "expected passed proposal to be closed")
+	queued, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.True(found, "expected passed proposal to be queued")
+	suite.Equal(suite.ctx.BlockTime().Add(delayedCom.EnactmentDelay), queued.EnactmentTime)
+	suite.Equal(previousCDPDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+
+	// Run BeginBlocker before the enactment time
+	beforeCtx := suite.ctx.WithBlockTime(queued.EnactmentTime.Add(-time.Second))
+	committee.BeginBlocker(beforeCtx, abci.RequestBeginBlock{}, suite.keeper)
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.True(found, "expected queued proposal to not be enacted before the enactment time")
+
+	// Run BeginBlocker at the enactment time
+	enactCtx := suite.ctx.WithBlockTime(queued.EnactmentTime)
+	committee.BeginBlocker(enactCtx, abci.RequestBeginBlock{}, suite.keeper)
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.False(found, "expected queued proposal to be enacted")
+	suite.Equal(newDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactVetoed() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup a delayed committee and a veto committee without general permissions
+	delayedCom := committee.NewMemberCommittee(12, "committee description", suite.addresses[:2],
+		[]committee.Permission{committee.GodPermission{}}, d("0.8"), time.Hour*24*7, types.FirstPastThePost)
+	delayedCom.EnactmentDelay = time.Hour * 24
+	delayedCom.VetoCommitteeIDs = []uint64{13}
+	vetoCom := committee.NewMemberCommittee(13, "veto committee description", suite.addresses[2:4],
+		[]committee.Permission{}, d("0.5"), time.Hour*24, types.FirstPastThePost)
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+	suite.keeper.SetCommittee(suite.ctx, vetoCom)
+
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], types.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], types.Yes))
+
+	// the veto committee cannot veto proposals that are not queued
+	_, err = suite.keeper.SubmitProposal(suite.ctx, vetoCom.Members[0], vetoCom.ID, committee.NewCommitteeVetoProposal("A Title", "A veto.", id))
+	suite.Error(err)
+
+	committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	queued, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.True(found, "expected passed proposal to be queued")
+
+	// the delayed committee is not designated to veto its own proposals
+	_, err = suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], vetoCom.ID, committee.NewCommitteeVetoProposal("A Title", "A veto.", id))
+	suite.Error(err)
+
+	vetoID, err := suite.keeper.SubmitProposal(suite.ctx, vetoCom.Members[0], vetoCom.ID, committee.NewCommitteeVetoProposal("A Title", "A veto.", id))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, vetoID, vetoCom.Members[0], types.Yes))
+
+	committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	_, found = suite.keeper.GetProposal(suite.ctx, vetoID)
+	suite.False(found, "expected veto proposal to be enacted")
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.False(found, "expected queued proposal to be vetoed")
+
+	// nothing is enacted once the enactment time is reached
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx.WithBlockTime(queued.EnactmentTime), abci.RequestBeginBlock{}, suite.keeper)
+	})
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_EnactsVetoWithoutDelay() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup a delayed committee and a veto committee with a longer enactment delay of its own
+	delayedCom := committee.NewMemberCommittee(12, "committee description", suite.addresses[:2],
+		[]committee.Permission{committee.GodPermission{}}, d("0.8"), time.Hour*24*7, types.FirstPastThePost)
+	delayedCom.EnactmentDelay = time.Hour * 24
+	delayedCom.VetoCommitteeIDs = []uint64{13}
+	vetoCom := committee.NewMemberCommittee(13, "veto committee description", suite.addresses[2:4],
+		[]committee.Permission{}, d("0.5"), time.Hour*24, types.FirstPastThePost)
+	vetoCom.EnactmentDelay = time.Hour * 48
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+	suite.keeper.SetCommittee(suite.ctx, vetoCom)
+
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], types.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], types.Yes))
+	committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	_, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.True(found, "expected passed proposal to be queued")
+
+	vetoID, err := suite.keeper.SubmitProposal(suite.ctx, vetoCom.Members[0], vetoCom.ID, committee.NewCommitteeVetoProposal("A Title", "A veto.", id))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, vetoID, vetoCom.Members[0], types.Yes))
+
+	// the veto is enacted as soon as it passes rather than queued behind the vetoed proposal
+	committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	_, found = suite.keeper.GetProposal(suite.ctx, vetoID)
+	suite.False(found, "expected veto proposal to be closed")
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, vetoID)
+	suite.False(found, "expected veto proposal to not be queued")
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.False(found, "expected queued proposal to be vetoed")
+}
+
 func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.app.InitializeFromGenesisStates()
 
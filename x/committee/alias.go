@@ -9,51 +9,105 @@ import (
 )
 
 const (
+	AttributeKeyAmendedProposalID   = types.AttributeKeyAmendedProposalID
 	AttributeKeyCommitteeID         = types.AttributeKeyCommitteeID
+	AttributeKeyDelegatee           = types.AttributeKeyDelegatee
+	AttributeKeyDelegator           = types.AttributeKeyDelegator
+	AttributeKeyEnactmentTime       = types.AttributeKeyEnactmentTime
+	AttributeKeyEndTime             = types.AttributeKeyEndTime
+	AttributeKeyMsgType             = types.AttributeKeyMsgType
 	AttributeKeyProposalCloseStatus = types.AttributeKeyProposalCloseStatus
 	AttributeKeyProposalID          = types.AttributeKeyProposalID
+	AttributeKeyRoute               = types.AttributeKeyRoute
+	AttributeKeyScope               = types.AttributeKeyScope
+	AttributeKeyStartTime           = types.AttributeKeyStartTime
 	AttributeKeyVoter               = types.AttributeKeyVoter
+	AttributeKeyVoteWeight          = types.AttributeKeyVoteWeight
+	AttributeKeyWithdrawalRequests  = types.AttributeKeyWithdrawalRequests
+	AttributeKeyWithdrawer          = types.AttributeKeyWithdrawer
 	AttributeValueCategory          = types.AttributeValueCategory
 	DefaultNextProposalID           = types.DefaultNextProposalID
 	DefaultParamspace               = types.DefaultParamspace
+	EventTypeMsgPause               = types.EventTypeMsgPause
+	EventTypeMsgUnpause             = types.EventTypeMsgUnpause
+	EventTypeProposalAmend          = types.EventTypeProposalAmend
 	EventTypeProposalClose          = types.EventTypeProposalClose
+	EventTypeProposalEnact          = types.EventTypeProposalEnact
+	EventTypeProposalQueue          = types.EventTypeProposalQueue
 	EventTypeProposalSubmit         = types.EventTypeProposalSubmit
+	EventTypeProposalVeto           = types.EventTypeProposalVeto
 	EventTypeProposalVote           = types.EventTypeProposalVote
+	EventTypeProposalWithdraw       = types.EventTypeProposalWithdraw
+	EventTypeVoteDelegate           = types.EventTypeVoteDelegate
+	EventTypeVoteDelegationRevoke   = types.EventTypeVoteDelegationRevoke
 	MaxCommitteeDescriptionLength   = types.MaxCommitteeDescriptionLength
 	ModuleName                      = types.ModuleName
 	No                              = types.No
 	ProposalTypeCommitteeChange     = types.ProposalTypeCommitteeChange
 	ProposalTypeCommitteeDelete     = types.ProposalTypeCommitteeDelete
+	ProposalTypeCommitteeVeto       = types.ProposalTypeCommitteeVeto
+	ProposalTypePause               = types.ProposalTypePause
 	QuerierRoute                    = types.QuerierRoute
 	QueryCommittee                  = types.QueryCommittee
 	QueryCommittees                 = types.QueryCommittees
 	QueryNextProposalID             = types.QueryNextProposalID
+	QueryPauses                     = types.QueryPauses
 	QueryProposal                   = types.QueryProposal
 	QueryProposals                  = types.QueryProposals
+	QueryQueuedProposal             = types.QueryQueuedProposal
+	QueryQueuedProposals            = types.QueryQueuedProposals
 	QueryRawParams                  = types.QueryRawParams
 	QueryTally                      = types.QueryTally
 	QueryVote                       = types.QueryVote
+	QueryVoteDelegations            = types.QueryVoteDelegations
 	QueryVotes                      = types.QueryVotes
+	Queued                          = types.Queued
 	RouterKey                       = types.RouterKey
 	StoreKey                        = types.StoreKey
+	Superseded                      = types.Superseded
+	TypeMsgAmendProposal            = types.TypeMsgAmendProposal
+	TypeMsgDelegateVote             = types.TypeMsgDelegateVote
+	TypeMsgRevokeVoteDelegation     = types.TypeMsgRevokeVoteDelegation
 	TypeMsgSubmitProposal           = types.TypeMsgSubmitProposal
 	TypeMsgVote                     = types.TypeMsgVote
+	TypeMsgWithdrawProposal         = types.TypeMsgWithdrawProposal
+	Vetoed                          = types.Vetoed
+	Withdrawn                       = types.Withdrawn
 	Yes                             = types.Yes
 )
 
 var (
 	// function aliases
+	NewHardVotingPowerSource    = keeper.NewHardVotingPowerSource
 	NewKeeper                   = keeper.NewKeeper
 	NewQuerier                  = keeper.NewQuerier
+	NewSwapVotingPowerSource    = keeper.NewSwapVotingPowerSource
 	DefaultGenesisState         = types.DefaultGenesisState
 	GetKeyFromID                = types.GetKeyFromID
+	GetPauseKey                 = types.GetPauseKey
+	GetPauseMsgTypePrefix       = types.GetPauseMsgTypePrefix
+	GetVoteDelegationKey        = types.GetVoteDelegationKey
 	GetVoteKey                  = types.GetVoteKey
+	MsgPauseScopes              = types.MsgPauseScopes
 	NewAllowedCollateralParam   = types.NewAllowedCollateralParam
 	NewAllowedMoneyMarket       = types.NewAllowedMoneyMarket
+	NewAllowedParamBound        = types.NewAllowedParamBound
+	NewAllowedPause             = types.NewAllowedPause
+	NewAllowedRewardPeriod      = types.NewAllowedRewardPeriod
+	NewAllowedSwapPool          = types.NewAllowedSwapPool
 	NewCommitteeChangeProposal  = types.NewCommitteeChangeProposal
 	NewCommitteeDeleteProposal  = types.NewCommitteeDeleteProposal
+	NewCommitteeVetoProposal    = types.NewCommitteeVetoProposal
 	NewGenesisState             = types.NewGenesisState
 	NewMemberCommittee          = types.NewMemberCommittee
+	NewMemberWeight             = types.NewMemberWeight
+	NewMsgAmendProposal         = types.NewMsgAmendProposal
+	NewMsgDelegateVote          = types.NewMsgDelegateVote
+	NewMsgRevokeVoteDelegation  = types.NewMsgRevokeVoteDelegation
+	NewMsgWithdrawProposal      = types.NewMsgWithdrawProposal
+	NewPause                    = types.NewPause
+	NewPauseProposal            = types.NewPauseProposal
+	NewQueuedProposal           = types.NewQueuedProposal
 	NewTokenCommittee           = types.NewTokenCommittee
 	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
 	NewMsgVote                  = types.NewMsgVote
@@ -63,6 +117,7 @@ var (
 	NewQueryRawParamsParams     = types.NewQueryRawParamsParams
 	NewQueryVoteParams          = types.NewQueryVoteParams
 	NewVote                     = types.NewVote
+	NewVoteDelegation           = types.NewVoteDelegation
 	RegisterCodec               = types.RegisterCodec
 	RegisterPermissionTypeCodec = types.RegisterPermissionTypeCodec
 	RegisterProposalTypeCodec   = types.RegisterProposalTypeCodec
@@ -74,52 +129,92 @@ var (
 	ErrInvalidCommittee        = types.ErrInvalidCommittee
 	ErrInvalidGenesis          = types.ErrInvalidGenesis
 	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
+	ErrInvalidVoteDelegation   = types.ErrInvalidVoteDelegation
+	ErrMsgPaused               = types.ErrMsgPaused
 	ErrNoProposalHandlerExists = types.ErrNoProposalHandlerExists
 	ErrProposalExpired         = types.ErrProposalExpired
 	ErrUnknownCommittee        = types.ErrUnknownCommittee
 	ErrUnknownProposal         = types.ErrUnknownProposal
+	ErrUnknownQueuedProposal   = types.ErrUnknownQueuedProposal
 	ErrUnknownSubspace         = types.ErrUnknownSubspace
 	ErrUnknownVote             = types.ErrUnknownVote
+	ErrUnknownVoteDelegation   = types.ErrUnknownVoteDelegation
+	ErrWithdrawalRequested     = types.ErrWithdrawalRequested
 	ModuleCdc                  = types.ModuleCdc
 	NextProposalIDKey          = types.NextProposalIDKey
+	PauseKeyPrefix             = types.PauseKeyPrefix
 	ProposalKeyPrefix          = types.ProposalKeyPrefix
+	QueuedProposalKeyPrefix    = types.QueuedProposalKeyPrefix
+	VoteDelegationKeyPrefix    = types.VoteDelegationKeyPrefix
 	VoteKeyPrefix              = types.VoteKeyPrefix
 )
 
 type (
-	Keeper                      = keeper.Keeper
-	AllowedAssetParam           = types.AllowedAssetParam
-	AllowedAssetParams          = types.AllowedAssetParams
-	AllowedCollateralParam      = types.AllowedCollateralParam
-	AllowedCollateralParams     = types.AllowedCollateralParams
-	AllowedDebtParam            = types.AllowedDebtParam
-	AllowedMarket               = types.AllowedMarket
-	AllowedMarkets              = types.AllowedMarkets
-	AllowedMoneyMarket          = types.AllowedMoneyMarket
-	AllowedMoneyMarkets         = types.AllowedMoneyMarkets
-	AllowedParam                = types.AllowedParam
-	AllowedParams               = types.AllowedParams
-	Committee                   = types.Committee
-	BaseCommittee               = types.BaseCommittee
-	CommitteeChangeProposal     = types.CommitteeChangeProposal
-	CommitteeDeleteProposal     = types.CommitteeDeleteProposal
-	GenesisState                = types.GenesisState
-	GodPermission               = types.GodPermission
-	MsgSubmitProposal           = types.MsgSubmitProposal
-	MemberCommittee             = types.MemberCommittee
-	MsgVote                     = types.MsgVote
-	TokenCommittee              = types.TokenCommittee
-	ParamKeeper                 = types.ParamKeeper
-	Permission                  = types.Permission
-	Proposal                    = types.Proposal
-	PubProposal                 = types.PubProposal
-	QueryCommitteeParams        = types.QueryCommitteeParams
-	QueryProposalParams         = types.QueryProposalParams
-	QueryRawParamsParams        = types.QueryRawParamsParams
-	QueryVoteParams             = types.QueryVoteParams
-	SimpleParamChangePermission = types.SimpleParamChangePermission
-	SoftwareUpgradePermission   = types.SoftwareUpgradePermission
-	SubParamChangePermission    = types.SubParamChangePermission
-	TextPermission              = types.TextPermission
-	Vote                        = types.Vote
+	HardVotingPowerSource           = keeper.HardVotingPowerSource
+	Keeper                          = keeper.Keeper
+	SwapVotingPowerSource           = keeper.SwapVotingPowerSource
+	AllowedAssetParam               = types.AllowedAssetParam
+	AllowedAssetParams              = types.AllowedAssetParams
+	AllowedCollateralParam          = types.AllowedCollateralParam
+	AllowedCollateralParams         = types.AllowedCollateralParams
+	AllowedDebtParam                = types.AllowedDebtParam
+	AllowedIssuanceAsset            = types.AllowedIssuanceAsset
+	AllowedIssuanceAssets           = types.AllowedIssuanceAssets
+	AllowedMarket                   = types.AllowedMarket
+	AllowedMarkets                  = types.AllowedMarkets
+	AllowedMoneyMarket              = types.AllowedMoneyMarket
+	AllowedMoneyMarkets             = types.AllowedMoneyMarkets
+	AllowedMultiplier               = types.AllowedMultiplier
+	AllowedMultipliers              = types.AllowedMultipliers
+	AllowedParam                    = types.AllowedParam
+	AllowedParamBound               = types.AllowedParamBound
+	AllowedParamBounds              = types.AllowedParamBounds
+	AllowedParams                   = types.AllowedParams
+	AllowedPause                    = types.AllowedPause
+	AllowedPauses                   = types.AllowedPauses
+	AllowedRewardPeriod             = types.AllowedRewardPeriod
+	AllowedRewardPeriods            = types.AllowedRewardPeriods
+	AllowedSwapPool                 = types.AllowedSwapPool
+	AllowedSwapPools                = types.AllowedSwapPools
+	BoundedParamChangePermission    = types.BoundedParamChangePermission
+	Committee                       = types.Committee
+	BaseCommittee                   = types.BaseCommittee
+	CommitteeChangeProposal         = types.CommitteeChangeProposal
+	CommitteeDeleteProposal         = types.CommitteeDeleteProposal
+	CommitteeVetoProposal           = types.CommitteeVetoProposal
+	GenesisState                    = types.GenesisState
+	GodPermission                   = types.GodPermission
+	HardReserveWithdrawalPermission = types.HardReserveWithdrawalPermission
+	Bep3DeputyBondSlashPermission   = types.Bep3DeputyBondSlashPermission
+	MemberWeight                    = types.MemberWeight
+	MsgAmendProposal                = types.MsgAmendProposal
+	MsgDelegateVote                 = types.MsgDelegateVote
+	MsgRevokeVoteDelegation         = types.MsgRevokeVoteDelegation
+	MsgSubmitProposal               = types.MsgSubmitProposal
+	MemberCommittee                 = types.MemberCommittee
+	MsgVote                         = types.MsgVote
+	MsgWithdrawProposal             = types.MsgWithdrawProposal
+	Pause                           = types.Pause
+	PausePermission                 = types.PausePermission
+	PauseProposal                   = types.PauseProposal
+	Pauses                          = types.Pauses
+	QueuedProposal                  = types.QueuedProposal
+	QueuedProposals                 = types.QueuedProposals
+	TokenCommittee                  = types.TokenCommittee
+	ParamKeeper                     = types.ParamKeeper
+	Permission                      = types.Permission
+	Proposal                        = types.Proposal
+	PubProposal                     = types.PubProposal
+	QueryCommitteeParams            = types.QueryCommitteeParams
+	QueryProposalParams             = types.QueryProposalParams
+	QueryRawParamsParams            = types.QueryRawParamsParams
+	QueryVoteParams                 = types.QueryVoteParams
+	SimpleParamChangePermission     = types.SimpleParamChangePermission
+	SoftwareUpgradePermission       = types.SoftwareUpgradePermission
+	SubParamChangePermission        = types.SubParamChangePermission
+	TextPermission                  = types.TextPermission
+	Vote                            = types.Vote
+	VoteDelegation                  = types.VoteDelegation
+	VoteDelegations                 = types.VoteDelegations
+	VotingPowerSource               = types.VotingPowerSource
 )
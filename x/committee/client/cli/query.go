@@ -34,11 +34,15 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryNextProposalID(queryRoute, cdc),
 		GetCmdQueryProposal(queryRoute, cdc),
 		GetCmdQueryProposals(queryRoute, cdc),
+		GetCmdQueryQueuedProposal(queryRoute, cdc),
+		GetCmdQueryQueuedProposals(queryRoute, cdc),
 		// votes
 		GetCmdQueryVotes(queryRoute, cdc),
+		GetCmdQueryVoteDelegations(queryRoute, cdc),
 		// other
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryPauses(queryRoute, cdc),
 		GetCmdQueryRawParams(queryRoute, cdc))...)
 
 	return queryCmd
@@ -209,6 +213,80 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryQueuedProposal implements the query queued proposal command.
+func GetCmdQueryQueuedProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "queued-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query details of a passed proposal waiting to be enacted",
+		Example: fmt.Sprintf("%s query %s queued-proposal 2", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryProposalParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryQueuedProposal), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print result
+			var queuedProposal types.QueuedProposal
+			if err = cdc.UnmarshalJSON(res, &queuedProposal); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(queuedProposal)
+		},
+	}
+}
+
+// GetCmdQueryQueuedProposals implements a query queued proposals command.
+func GetCmdQueryQueuedProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "queued-proposals [committee-id]",
+		Short:   "Query all passed proposals of a committee that are waiting to be enacted",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s queued-proposals 1", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryCommitteeParams(committeeID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryQueuedProposals), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			queuedProposals := types.QueuedProposals{}
+			err = cdc.UnmarshalJSON(res, &queuedProposals)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(queuedProposals)
+		},
+	}
+	return cmd
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -250,6 +328,42 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdQueryVoteDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "vote-delegations [committee-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query vote delegations between members of a committee",
+		Example: fmt.Sprintf("%s query %s vote-delegations 1", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryCommitteeParams(committeeID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoteDelegations), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			delegations := types.VoteDelegations{} // using empty (not nil) slice so json returns [] instead of null when there's no data
+			err = cdc.UnmarshalJSON(res, &delegations)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(delegations)
+		},
+	}
+}
+
 // ------------------------------------------
 //				Other
 // ------------------------------------------
@@ -316,6 +430,32 @@ func GetCmdQueryProposer(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdQueryPauses implements a query pauses command.
+func GetCmdQueryPauses(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "pauses",
+		Args:    cobra.NoArgs,
+		Short:   "Query msg types currently paused by committees",
+		Example: fmt.Sprintf("%s query %s pauses", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPauses), nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print result
+			pauses := types.Pauses{} // using empty (not nil) slice so json returns [] instead of null when there's no data
+			if err = cdc.UnmarshalJSON(res, &pauses); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(pauses)
+		},
+	}
+}
+
 func GetCmdQueryRawParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:     "raw-params [subspace] [key]",
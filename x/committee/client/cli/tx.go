@@ -38,6 +38,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(flags.PostCommands(
 		GetCmdVote(cdc),
 		GetCmdSubmitProposal(cdc),
+		GetCmdWithdrawProposal(cdc),
+		GetCmdAmendProposal(cdc),
+		GetCmdDelegateVote(cdc),
+		GetCmdRevokeVoteDelegation(cdc),
 	)...)
 
 	return txCmd
@@ -98,6 +102,150 @@ For example:
 	return cmd
 }
 
+// GetCmdWithdrawProposal returns the command to withdraw a proposal, or request its withdrawal from the committee
+func GetCmdWithdrawProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw an active proposal",
+		Long: `Withdraw the proposal with id [proposal-id] if sent by its proposer.
+If sent by another committee member, a request to withdraw the proposal is recorded and the proposal is withdrawn once a majority of members have requested it.`,
+		Example: fmt.Sprintf("%s tx %s withdraw-proposal 2", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgWithdrawProposal(cliCtx.GetFromAddress(), proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdAmendProposal returns the command to supersede a proposal with an amended version
+func GetCmdAmendProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "amend-proposal [proposal-id] [proposal-file]",
+		Short: "Replace an active proposal with an amended version",
+		Long: `Supersede the proposal with id [proposal-id] with the proposal in [proposal-file].
+Only the original proposer can amend a proposal. The amended proposal is given a new id and deadline, and all votes on the original proposal are discarded.`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx %s amend-proposal 2 your-amended-proposal.json", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Get the amended proposal
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var pubProposal types.PubProposal
+			if err := cdc.UnmarshalJSON(bz, &pubProposal); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAmendProposal(pubProposal, cliCtx.GetFromAddress(), proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDelegateVote returns the command to let another member vote on the sender's behalf
+func GetCmdDelegateVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-vote [committee-id] [delegatee] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Delegate your member committee vote to another member for a time window",
+		Long: `Let [delegatee] vote on your behalf in the member committee [committee-id] between [start-time] and [end-time], given in RFC3339 format.
+Your weight is added to the delegatee's vote on proposals you haven't voted on yourself. Delegating again replaces any existing delegation.`,
+		Example: fmt.Sprintf("%s tx %s delegate-vote 1 kava1... 2021-01-01T00:00:00Z 2021-01-08T00:00:00Z", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the committee id is a uint
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int, please input a valid committee-id", args[0])
+			}
+			delegatee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			startTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("start-time %s not a valid RFC3339 time", args[2])
+			}
+			endTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("end-time %s not a valid RFC3339 time", args[3])
+			}
+
+			msg := types.NewMsgDelegateVote(committeeID, cliCtx.GetFromAddress(), delegatee, startTime, endTime)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevokeVoteDelegation returns the command to end the sender's vote delegation
+func GetCmdRevokeVoteDelegation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "revoke-vote-delegation [committee-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Revoke your member committee vote delegation",
+		Example: fmt.Sprintf("%s tx %s revoke-vote-delegation 1", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the committee id is a uint
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int, please input a valid committee-id", args[0])
+			}
+
+			msg := types.NewMsgRevokeVoteDelegation(committeeID, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdVote returns the command to vote on a proposal.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
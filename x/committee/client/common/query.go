@@ -121,6 +121,7 @@ func QueryProposalByID(cliCtx context.CLIContext, cdc *codec.Codec, queryRoute s
 					CommitteeID: subMsg.CommitteeID,
 					PubProposal: subMsg.PubProposal,
 					Deadline:    deadline,
+					Proposer:    subMsg.Proposer,
 				}, height, nil
 			}
 		}
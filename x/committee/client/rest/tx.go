@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -20,6 +21,10 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/committees/{%s}/proposals", types.ModuleName, RestCommitteeID), postProposalHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/proposals/{%s}/votes", types.ModuleName, RestProposalID), postVoteHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/proposals/{%s}/withdraw", types.ModuleName, RestProposalID), postWithdrawProposalHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/proposals/{%s}/amend", types.ModuleName, RestProposalID), postAmendProposalHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/committees/{%s}/vote-delegations", types.ModuleName, RestCommitteeID), postDelegateVoteHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/committees/{%s}/vote-delegations/revoke", types.ModuleName, RestCommitteeID), postRevokeVoteDelegationHandlerFn(cliCtx)).Methods("POST")
 }
 
 // PostProposalReq defines the properties of a proposal request's body.
@@ -130,6 +135,163 @@ func postVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// PostWithdrawProposalReq defines the properties of a withdraw proposal request's body.
+type PostWithdrawProposalReq struct {
+	BaseReq    rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Withdrawer sdk.AccAddress `json:"withdrawer" yaml:"withdrawer"`
+}
+
+func postWithdrawProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Parse and validate url params
+		vars := mux.Vars(r)
+		if len(vars[RestProposalID]) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s required but not specified", RestProposalID))
+			return
+		}
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestProposalID])
+		if !ok {
+			return
+		}
+
+		// Parse and validate http request body
+		var req PostWithdrawProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgWithdrawProposal(req.Withdrawer, proposalID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// PostDelegateVoteReq defines the properties of a vote delegation request's body.
+type PostDelegateVoteReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Delegatee sdk.AccAddress `json:"delegatee" yaml:"delegatee"`
+	StartTime time.Time      `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+func postDelegateVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Parse and validate url params
+		vars := mux.Vars(r)
+		if len(vars[RestCommitteeID]) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s required but not specified", RestCommitteeID))
+			return
+		}
+		committeeID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestCommitteeID])
+		if !ok {
+			return
+		}
+
+		// Parse and validate http request body
+		var req PostDelegateVoteReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgDelegateVote(committeeID, req.Delegator, req.Delegatee, req.StartTime, req.EndTime)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// PostRevokeVoteDelegationReq defines the properties of a vote delegation revocation request's body.
+type PostRevokeVoteDelegationReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+}
+
+func postRevokeVoteDelegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Parse and validate url params
+		vars := mux.Vars(r)
+		if len(vars[RestCommitteeID]) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s required but not specified", RestCommitteeID))
+			return
+		}
+		committeeID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestCommitteeID])
+		if !ok {
+			return
+		}
+
+		// Parse and validate http request body
+		var req PostRevokeVoteDelegationReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgRevokeVoteDelegation(committeeID, req.Delegator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postAmendProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Parse and validate url params
+		vars := mux.Vars(r)
+		if len(vars[RestProposalID]) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s required but not specified", RestProposalID))
+			return
+		}
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestProposalID])
+		if !ok {
+			return
+		}
+
+		// Parse and validate http request body
+		var req PostProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgAmendProposal(req.PubProposal, req.Proposer, proposalID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // This is a rest handler for for the gov module, that handles committee change/delete proposals.
 type PostGovProposalReq struct {
 	BaseReq  rest.BaseReq     `json:"base_req" yaml:"base_req"`
@@ -23,8 +23,21 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 		keeper.SetProposal(ctx, p)
 	}
 	for _, v := range gs.Votes {
+		if !v.HasWeight() {
+			// snapshot voting power for token committee votes exported before weights were recorded
+			v = keeper.SnapshotVoteWeight(ctx, v)
+		}
 		keeper.SetVote(ctx, v)
 	}
+	for _, qp := range gs.QueuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
+	for _, vd := range gs.VoteDelegations {
+		keeper.SetVoteDelegation(ctx, vd)
+	}
+	for _, p := range gs.Pauses {
+		keeper.SetPause(ctx, p)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -37,11 +50,17 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	committees := keeper.GetCommittees(ctx)
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
+	queuedProposals := keeper.GetQueuedProposals(ctx)
+	voteDelegations := keeper.GetVoteDelegations(ctx)
+	pauses := keeper.GetPauses(ctx)
 
 	return types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
+		queuedProposals,
+		voteDelegations,
+		pauses,
 	)
 }
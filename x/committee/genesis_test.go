@@ -9,6 +9,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/committee"
@@ -78,6 +79,9 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{memberCom},
 				[]types.Proposal{},
 				[]types.Vote{},
+				types.QueuedProposals{},
+				types.VoteDelegations{},
+				types.Pauses{},
 			),
 			expectPass: true,
 		},
@@ -88,6 +92,9 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{tokenCom},
 				[]types.Proposal{},
 				[]types.Vote{},
+				types.QueuedProposals{},
+				types.VoteDelegations{},
+				types.Pauses{},
 			),
 			expectPass: true,
 		},
@@ -98,6 +105,9 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{memberCom, memberCom},
 				[]types.Proposal{},
 				[]types.Vote{},
+				types.QueuedProposals{},
+				types.VoteDelegations{},
+				types.Pauses{},
 			),
 			expectPass: false,
 		},
@@ -108,6 +118,9 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{{ID: 1, CommitteeID: 57}},
 				[]types.Vote{},
+				types.QueuedProposals{},
+				types.VoteDelegations{},
+				types.Pauses{},
 			),
 			expectPass: false,
 		},
@@ -118,6 +131,41 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{},
 				[]types.Vote{{Voter: suite.addresses[0], ProposalID: 1, VoteType: types.Yes}},
+				types.QueuedProposals{},
+				types.VoteDelegations{},
+				types.Pauses{},
+			),
+			expectPass: false,
+		},
+		{
+			name: "queued proposal is correctly validated",
+			genState: types.NewGenesisState(
+				3,
+				[]types.Committee{memberCom},
+				[]types.Proposal{{ID: 1, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal.")}},
+				[]types.Vote{},
+				types.QueuedProposals{types.NewQueuedProposal(
+					types.Proposal{ID: 2, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal.")},
+					time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC),
+				)},
+				types.VoteDelegations{},
+				types.Pauses{},
+			),
+			expectPass: true,
+		},
+		{
+			name: "invalid: queued proposal has same ID as proposal",
+			genState: types.NewGenesisState(
+				3,
+				[]types.Committee{memberCom},
+				[]types.Proposal{{ID: 1, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal.")}},
+				[]types.Vote{},
+				types.QueuedProposals{types.NewQueuedProposal(
+					types.Proposal{ID: 1, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal.")},
+					time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC),
+				)},
+				types.VoteDelegations{},
+				types.Pauses{},
 			),
 			expectPass: false,
 		},
@@ -128,6 +176,9 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				[]types.Committee{memberCom},
 				[]types.Proposal{{ID: 3, CommitteeID: 1}, {ID: 4, CommitteeID: 1}},
 				[]types.Vote{},
+				types.QueuedProposals{},
+				types.VoteDelegations{},
+				types.Pauses{},
 			),
 			expectPass: false,
 		},
@@ -18,6 +18,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgSubmitProposal(ctx, k, msg)
 		case types.MsgVote:
 			return handleMsgVote(ctx, k, msg)
+		case types.MsgWithdrawProposal:
+			return handleMsgWithdrawProposal(ctx, k, msg)
+		case types.MsgAmendProposal:
+			return handleMsgAmendProposal(ctx, k, msg)
+		case types.MsgDelegateVote:
+			return handleMsgDelegateVote(ctx, k, msg)
+		case types.MsgRevokeVoteDelegation:
+			return handleMsgRevokeVoteDelegation(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -60,3 +68,74 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg types.MsgVote) (*sdk.Re
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgWithdrawProposal(ctx sdk.Context, k keeper.Keeper, msg types.MsgWithdrawProposal) (*sdk.Result, error) {
+	err := k.WithdrawProposal(ctx, msg.ProposalID, msg.Withdrawer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Withdrawer.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAmendProposal(ctx sdk.Context, k keeper.Keeper, msg types.MsgAmendProposal) (*sdk.Result, error) {
+	proposalID, err := k.AmendProposal(ctx, msg.ProposalID, msg.Proposer, msg.PubProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Data:   GetKeyFromID(proposalID),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgDelegateVote(ctx sdk.Context, k keeper.Keeper, msg types.MsgDelegateVote) (*sdk.Result, error) {
+	err := k.DelegateVote(ctx, msg.CommitteeID, msg.Delegator, msg.Delegatee, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeVoteDelegation(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeVoteDelegation) (*sdk.Result, error) {
+	err := k.RevokeVoteDelegation(ctx, msg.CommitteeID, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
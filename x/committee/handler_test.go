@@ -63,6 +63,9 @@ func (suite *HandlerTestSuite) SetupTest() {
 		},
 		[]types.Proposal{},
 		[]types.Vote{},
+		types.QueuedProposals{},
+		types.VoteDelegations{},
+		types.Pauses{},
 	)
 	suite.communityPoolAmt = cs(c("ukava", 1000))
 	suite.app.InitializeFromGenesisStates(
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// DelegateVote lets another member of a member committee vote on a member's behalf between the start and end times,
+// replacing any existing delegation from the member.
+func (k Keeper) DelegateVote(ctx sdk.Context, committeeID uint64, delegator, delegatee sdk.AccAddress, startTime, endTime time.Time) error {
+	com, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
+	}
+	if _, ok := com.(types.MemberCommittee); !ok {
+		return sdkerrors.Wrap(types.ErrInvalidVoteDelegation, "only member committee votes can be delegated")
+	}
+	if !com.HasMember(delegator) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "delegator must be a member of committee")
+	}
+	if !com.HasMember(delegatee) {
+		return sdkerrors.Wrap(types.ErrInvalidVoteDelegation, "delegatee must be a member of committee")
+	}
+
+	delegation := types.NewVoteDelegation(committeeID, delegator, delegatee, startTime, endTime)
+	if err := delegation.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVoteDelegation, err.Error())
+	}
+	if delegation.HasExpiredBy(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteDelegation, "end time %s is not after block time %s", endTime, ctx.BlockTime())
+	}
+
+	k.SetVoteDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDelegate,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", committeeID)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegatee.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, startTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+		),
+	)
+	return nil
+}
+
+// RevokeVoteDelegation ends a member's vote delegation before its end time.
+func (k Keeper) RevokeVoteDelegation(ctx sdk.Context, committeeID uint64, delegator sdk.AccAddress) error {
+	delegation, found := k.GetVoteDelegation(ctx, committeeID, delegator)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownVoteDelegation, "committee %d, delegator %s", committeeID, delegator)
+	}
+	k.DeleteVoteDelegation(ctx, committeeID, delegator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDelegationRevoke,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", committeeID)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegation.Delegatee.String()),
+		),
+	)
+	return nil
+}
+
+// DeleteExpiredVoteDelegations removes delegations whose time window has ended.
+func (k Keeper) DeleteExpiredVoteDelegations(ctx sdk.Context) {
+	var expired types.VoteDelegations
+	k.IterateVoteDelegations(ctx, func(delegation types.VoteDelegation) bool {
+		if delegation.HasExpiredBy(ctx.BlockTime()) {
+			expired = append(expired, delegation)
+		}
+		return false
+	})
+	for _, delegation := range expired {
+		k.DeleteVoteDelegation(ctx, delegation.CommitteeID, delegation.Delegator)
+	}
+}
+
+// PruneVoteDelegations removes a committee's delegations that no longer apply after the committee was changed or deleted.
+func (k Keeper) PruneVoteDelegations(ctx sdk.Context, committeeID uint64) {
+	com, found := k.GetCommittee(ctx, committeeID)
+	_, isMemberCommittee := com.(types.MemberCommittee)
+	for _, delegation := range k.GetVoteDelegationsByCommittee(ctx, committeeID) {
+		if found && isMemberCommittee && com.HasMember(delegation.Delegator) && com.HasMember(delegation.Delegatee) {
+			continue
+		}
+		k.DeleteVoteDelegation(ctx, committeeID, delegation.Delegator)
+	}
+}
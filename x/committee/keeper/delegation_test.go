@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func (suite *KeeperTestSuite) TestDelegateVote() {
+	memberCom := types.MemberCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               12,
+			Members:          suite.addresses[:3],
+			Permissions:      []types.Permission{types.GodPermission{}},
+			VoteThreshold:    d("0.5"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.Deadline,
+		},
+	}
+	tokenCom := types.TokenCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               13,
+			Members:          suite.addresses[:3],
+			Permissions:      []types.Permission{types.GodPermission{}},
+			VoteThreshold:    d("0.5"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.Deadline,
+		},
+		Quorum:     d("0.4"),
+		TallyDenom: "hard",
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name        string
+		committeeID uint64
+		delegator   int
+		delegatee   int
+		startTime   time.Time
+		endTime     time.Time
+		expectErr   bool
+	}{
+		{
+			name:        "normal",
+			committeeID: memberCom.ID,
+			delegator:   0,
+			delegatee:   1,
+			startTime:   firstBlockTime,
+			endTime:     firstBlockTime.Add(time.Hour),
+		},
+		{
+			name:        "window starts in the future",
+			committeeID: memberCom.ID,
+			delegator:   0,
+			delegatee:   1,
+			startTime:   firstBlockTime.Add(time.Hour),
+			endTime:     firstBlockTime.Add(2 * time.Hour),
+		},
+		{
+			name:        "window already ended",
+			committeeID: memberCom.ID,
+			delegator:   0,
+			delegatee:   1,
+			startTime:   firstBlockTime.Add(-2 * time.Hour),
+			endTime:     firstBlockTime,
+			expectErr:   true,
+		},
+		{
+			name:        "token committee",
+			committeeID: tokenCom.ID,
+			delegator:   0,
+			delegatee:   1,
+			startTime:   firstBlockTime,
+			endTime:     firstBlockTime.Add(time.Hour),
+			expectErr:   true,
+		},
+		{
+			name:        "delegator not a member",
+			committeeID: memberCom.ID,
+			delegator:   5,
+			delegatee:   1,
+			startTime:   firstBlockTime,
+			endTime:     firstBlockTime.Add(time.Hour),
+			expectErr:   true,
+		},
+		{
+			name:        "delegatee not a member",
+			committeeID: memberCom.ID,
+			delegator:   0,
+			delegatee:   5,
+			startTime:   firstBlockTime,
+			endTime:     firstBlockTime.Add(time.Hour),
+			expectErr:   true,
+		},
+		{
+			name:        "unknown committee",
+			committeeID: 99,
+			delegator:   0,
+			delegatee:   1,
+			startTime:   firstBlockTime,
+			endTime:     firstBlockTime.Add(time.Hour),
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			keeper := tApp.GetCommitteeKeeper()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+			tApp.InitializeFromGenesisStates()
+			keeper.SetCommittee(ctx, memberCom)
+			keeper.SetCommittee(ctx, tokenCom)
+
+			delegator, delegatee := suite.addresses[tc.delegator], suite.addresses[tc.delegatee]
+			err := keeper.DelegateVote(ctx, tc.committeeID, delegator, delegatee, tc.startTime, tc.endTime)
+
+			_, found := keeper.GetVoteDelegation(ctx, tc.committeeID, delegator)
+			if tc.expectErr {
+				suite.Error(err)
+				suite.False(found)
+			} else {
+				suite.NoError(err)
+				suite.True(found)
+
+				suite.NoError(keeper.RevokeVoteDelegation(ctx, tc.committeeID, delegator))
+				_, found = keeper.GetVoteDelegation(ctx, tc.committeeID, delegator)
+				suite.False(found)
+				suite.Error(keeper.RevokeVoteDelegation(ctx, tc.committeeID, delegator))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTallyMemberCommitteeVotes_WeightsAndDelegations() {
+	memberCom := types.MemberCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               12,
+			Members:          suite.addresses[:4],
+			Permissions:      []types.Permission{types.GodPermission{}},
+			VoteThreshold:    d("0.5"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.Deadline,
+			MemberWeights: []types.MemberWeight{
+				types.NewMemberWeight(suite.addresses[0], 5),
+				types.NewMemberWeight(suite.addresses[1], 3),
+			},
+		},
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates()
+	keeper.SetCommittee(ctx, memberCom)
+
+	id, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+
+	// votes are weighted, members without a weight have one vote
+	suite.Require().NoError(keeper.AddVote(ctx, id, memberCom.Members[2], types.Yes))
+	totalVotes, delegatedVotes, possibleVotes := keeper.TallyMemberCommitteeVotes(ctx, id, memberCom)
+	suite.Equal(d("1"), totalVotes)
+	suite.Equal(d("0"), delegatedVotes)
+	suite.Equal(d("10"), possibleVotes)
+	suite.False(keeper.GetMemberCommitteeProposalResult(ctx, id, memberCom))
+
+	// the heaviest member delegates to the member that voted for an hour
+	suite.Require().NoError(keeper.DelegateVote(ctx, memberCom.ID, memberCom.Members[0], memberCom.Members[2], firstBlockTime, firstBlockTime.Add(time.Hour)))
+	totalVotes, delegatedVotes, _ = keeper.TallyMemberCommitteeVotes(ctx, id, memberCom)
+	suite.Equal(d("6"), totalVotes)
+	suite.Equal(d("5"), delegatedVotes)
+	suite.True(keeper.GetMemberCommitteeProposalResult(ctx, id, memberCom))
+
+	// delegations aren't transitive, the delegatee must vote themselves
+	suite.Require().NoError(keeper.DelegateVote(ctx, memberCom.ID, memberCom.Members[1], memberCom.Members[0], firstBlockTime, firstBlockTime.Add(time.Hour)))
+	totalVotes, _, _ = keeper.TallyMemberCommitteeVotes(ctx, id, memberCom)
+	suite.Equal(d("6"), totalVotes)
+
+	// a delegator's own vote replaces their delegated vote
+	suite.Require().NoError(keeper.AddVote(ctx, id, memberCom.Members[0], types.Yes))
+	totalVotes, delegatedVotes, _ = keeper.TallyMemberCommitteeVotes(ctx, id, memberCom)
+	suite.Equal(d("9"), totalVotes)
+	suite.Equal(d("3"), delegatedVotes)
+
+	// delegations stop counting once their window ends and are removed at the start of the next block
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour))
+	totalVotes, delegatedVotes, _ = keeper.TallyMemberCommitteeVotes(ctx, id, memberCom)
+	suite.Equal(d("6"), totalVotes)
+	suite.Equal(d("0"), delegatedVotes)
+	keeper.DeleteExpiredVoteDelegations(ctx)
+	suite.Empty(keeper.GetVoteDelegationsByCommittee(ctx, memberCom.ID))
+}
@@ -22,6 +22,9 @@ type Keeper struct {
 
 	// Proposal router
 	router govtypes.Router
+
+	// Sources of token committee voting power in addition to liquid balances
+	votingPowerSources []types.VotingPowerSource
 }
 
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router,
@@ -40,6 +43,15 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router,
 	}
 }
 
+// SetVotingPowerSources sets the sources counted towards token committee voting power in addition to liquid balances
+func (k *Keeper) SetVotingPowerSources(sources ...types.VotingPowerSource) *Keeper {
+	if k.votingPowerSources != nil {
+		panic("cannot set committee voting power sources twice")
+	}
+	k.votingPowerSources = sources
+	return k
+}
+
 // ------------------------------------------
 //				Committees
 // ------------------------------------------
@@ -126,7 +138,7 @@ func (k Keeper) IncrementNextProposalID(ctx sdk.Context) error {
 }
 
 // StoreNewProposal stores a proposal, adding a new ID
-func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time) (uint64, error) {
+func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time, proposer sdk.AccAddress) (uint64, error) {
 	newProposalID, err := k.GetNextProposalID(ctx)
 	if err != nil {
 		return 0, err
@@ -137,6 +149,16 @@ func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal,
 		committeeID,
 		deadline,
 	)
+	proposal.Proposer = proposer
+
+	// snapshot the total voting power so the outcome can't be moved by supply changes during voting
+	com, found := k.GetCommittee(ctx, committeeID)
+	if found {
+		if tokenCom, ok := com.(types.TokenCommittee); ok {
+			possibleVotes := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(tokenCom.TallyDenom)
+			proposal.PossibleVotes = &possibleVotes
+		}
+	}
 
 	k.SetProposal(ctx, proposal)
 
@@ -219,6 +241,73 @@ func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	}
 }
 
+// ------------------------------------------
+//				Queued Proposals
+// ------------------------------------------
+
+// GetQueuedProposal gets a queued proposal from the store.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, proposalID uint64) (types.QueuedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queuedProposal types.QueuedProposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &queuedProposal)
+	return queuedProposal, true
+}
+
+// SetQueuedProposal puts a queued proposal into the store.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(queuedProposal)
+	store.Set(types.GetKeyFromID(queuedProposal.Proposal.ID), bz)
+}
+
+// DeleteQueuedProposal removes a queued proposal from the store.
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+// IterateQueuedProposals provides an iterator over all queued proposals.
+// For each queued proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queuedProposal types.QueuedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedProposal types.QueuedProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &queuedProposal)
+
+		if cb(queuedProposal) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns all queued proposals.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) types.QueuedProposals {
+	results := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		results = append(results, qp)
+		return false
+	})
+	return results
+}
+
+// GetQueuedProposalsByCommittee returns all queued proposals for one committee.
+func (k Keeper) GetQueuedProposalsByCommittee(ctx sdk.Context, committeeID uint64) types.QueuedProposals {
+	results := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		if qp.Proposal.CommitteeID == committeeID {
+			results = append(results, qp)
+		}
+		return false
+	})
+	return results
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -288,3 +377,143 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Vote Delegations
+// ------------------------------------------
+
+// GetVoteDelegation gets a member's vote delegation from the store.
+func (k Keeper) GetVoteDelegation(ctx sdk.Context, committeeID uint64, delegator sdk.AccAddress) (types.VoteDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	bz := store.Get(types.GetVoteDelegationKey(committeeID, delegator))
+	if bz == nil {
+		return types.VoteDelegation{}, false
+	}
+	var delegation types.VoteDelegation
+	k.cdc.MustUnmarshalBinaryBare(bz, &delegation)
+	return delegation, true
+}
+
+// SetVoteDelegation puts a vote delegation into the store.
+func (k Keeper) SetVoteDelegation(ctx sdk.Context, delegation types.VoteDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(delegation)
+	store.Set(types.GetVoteDelegationKey(delegation.CommitteeID, delegation.Delegator), bz)
+}
+
+// DeleteVoteDelegation removes a vote delegation from the store.
+func (k Keeper) DeleteVoteDelegation(ctx sdk.Context, committeeID uint64, delegator sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	store.Delete(types.GetVoteDelegationKey(committeeID, delegator))
+}
+
+// IterateVoteDelegations provides an iterator over all stored vote delegations.
+// For each delegation, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVoteDelegations(ctx sdk.Context, cb func(delegation types.VoteDelegation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetVoteDelegations returns all stored vote delegations.
+func (k Keeper) GetVoteDelegations(ctx sdk.Context) types.VoteDelegations {
+	results := types.VoteDelegations{}
+	k.IterateVoteDelegations(ctx, func(delegation types.VoteDelegation) bool {
+		results = append(results, delegation)
+		return false
+	})
+	return results
+}
+
+// GetVoteDelegationsByCommittee returns all vote delegations for one committee.
+func (k Keeper) GetVoteDelegationsByCommittee(ctx sdk.Context, committeeID uint64) types.VoteDelegations {
+	results := types.VoteDelegations{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), append(types.VoteDelegationKeyPrefix, types.GetKeyFromID(committeeID)...))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+		results = append(results, delegation)
+	}
+
+	return results
+}
+
+// ------------------------------------------
+//				Pauses
+// ------------------------------------------
+
+// GetPause gets a msg pause from the store.
+func (k Keeper) GetPause(ctx sdk.Context, route, msgType, scope string) (types.Pause, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PauseKeyPrefix)
+	bz := store.Get(types.GetPauseKey(route, msgType, scope))
+	if bz == nil {
+		return types.Pause{}, false
+	}
+	var pause types.Pause
+	k.cdc.MustUnmarshalBinaryBare(bz, &pause)
+	return pause, true
+}
+
+// SetPause puts a msg pause into the store.
+func (k Keeper) SetPause(ctx sdk.Context, pause types.Pause) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PauseKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(pause)
+	store.Set(types.GetPauseKey(pause.Route, pause.MsgType, pause.Scope), bz)
+}
+
+// DeletePause removes a msg pause from the store.
+func (k Keeper) DeletePause(ctx sdk.Context, route, msgType, scope string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PauseKeyPrefix)
+	store.Delete(types.GetPauseKey(route, msgType, scope))
+}
+
+// IteratePauses provides an iterator over all stored msg pauses.
+// For each pause, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePauses(ctx sdk.Context, cb func(pause types.Pause) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PauseKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.Pause
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pause)
+
+		if cb(pause) {
+			break
+		}
+	}
+}
+
+// GetPauses returns all stored msg pauses.
+func (k Keeper) GetPauses(ctx sdk.Context) types.Pauses {
+	results := types.Pauses{}
+	k.IteratePauses(ctx, func(pause types.Pause) bool {
+		results = append(results, pause)
+		return false
+	})
+	return results
+}
+
+// GetPausesByMsgType returns all stored pauses of one msg type.
+func (k Keeper) GetPausesByMsgType(ctx sdk.Context, route, msgType string) types.Pauses {
+	results := types.Pauses{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), append(types.PauseKeyPrefix, types.GetPauseMsgTypePrefix(route, msgType)...))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.Pause
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pause)
+		results = append(results, pause)
+	}
+
+	return results
+}
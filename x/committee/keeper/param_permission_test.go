@@ -12,10 +12,12 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 type PermissionTestSuite struct {
@@ -146,6 +148,22 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	copy(testMsUpdatedActive, testMs)
 	testMsUpdatedActive[1].Active = true
 
+	// swap Params
+	testSwapParams := swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("ukava", "usdx")),
+		d("0.003"),
+	)
+	testPoolsAdded := swaptypes.NewAllowedPools(
+		swaptypes.NewAllowedPool("ukava", "usdx"),
+		swaptypes.NewAllowedPool("hard", "ukava"),
+	)
+	swapFeeCommittee := types.SubParamChangePermission{
+		AllowedParams: types.AllowedParams{
+			{Subspace: swaptypes.ModuleName, Key: string(swaptypes.KeySwapFee)},
+			{Subspace: swaptypes.ModuleName, Key: string(swaptypes.KeyAllowedPools)},
+		},
+	}
+
 	testcases := []struct {
 		name          string
 		genState      []app.GenesisState
@@ -233,6 +251,45 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 			),
 			expectAllowed: true,
 		},
+		{
+			name:       "allowed swap fee change",
+			genState:   []app.GenesisState{newSwapGenesisState(testSwapParams)},
+			permission: swapFeeCommittee,
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: swaptypes.ModuleName,
+						Key:      string(swaptypes.KeySwapFee),
+						Value:    string(suite.cdc.MustMarshalJSON(d("0.002"))),
+					},
+					{
+						Subspace: swaptypes.ModuleName,
+						Key:      string(swaptypes.KeyAllowedPools),
+						Value:    string(suite.cdc.MustMarshalJSON(testSwapParams.AllowedPools)),
+					},
+				},
+			),
+			expectAllowed: true,
+		},
+		{
+			name:       "not allowed (swap pool added)",
+			genState:   []app.GenesisState{newSwapGenesisState(testSwapParams)},
+			permission: swapFeeCommittee,
+			pubProposal: paramstypes.NewParameterChangeProposal(
+				"A Title",
+				"A description for this proposal.",
+				[]paramstypes.ParamChange{
+					{
+						Subspace: swaptypes.ModuleName,
+						Key:      string(swaptypes.KeyAllowedPools),
+						Value:    string(suite.cdc.MustMarshalJSON(testPoolsAdded)),
+					},
+				},
+			),
+			expectAllowed: false,
+		},
 		{
 			name:          "not allowed (wrong pubproposal type)",
 			permission:    types.SubParamChangePermission{},
@@ -262,6 +319,173 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	}
 
 }
+
+func (suite *PermissionTestSuite) TestBoundedParamChangePermission_Allows() {
+	testCPs := cdptypes.CollateralParams{
+		{
+			Denom:               "bnb",
+			Type:                "bnb-a",
+			LiquidationRatio:    d("2.0"),
+			DebtLimit:           c("usdx", 1000000000000),
+			StabilityFee:        d("1.000000001547125958"), // 5% APR
+			LiquidationPenalty:  d("0.05"),
+			AuctionSize:         i(100),
+			Prefix:              0x20,
+			ConversionFactor:    i(6),
+			SpotMarketID:        "bnb:usd",
+			LiquidationMarketID: "bnb:usd",
+		},
+		{
+			Denom:               "btc",
+			Type:                "btc-a",
+			LiquidationRatio:    d("1.5"),
+			DebtLimit:           c("usdx", 1000000000),
+			StabilityFee:        d("1.000000001547125958"), // 5% APR
+			LiquidationPenalty:  d("0.10"),
+			AuctionSize:         i(1000),
+			Prefix:              0x30,
+			ConversionFactor:    i(8),
+			SpotMarketID:        "btc:usd",
+			LiquidationMarketID: "btc:usd",
+		},
+	}
+	updateCPs := func(update func(cdptypes.CollateralParams)) cdptypes.CollateralParams {
+		cps := make(cdptypes.CollateralParams, len(testCPs))
+		copy(cps, testCPs)
+		update(cps)
+		return cps
+	}
+	testCDPParams := cdptypes.DefaultParams()
+	testCDPParams.CollateralParams = testCPs
+	testCDPParams.GlobalDebtLimit = testCPs[0].DebtLimit.Add(testCPs[0].DebtLimit)
+
+	genState := []app.GenesisState{
+		newPricefeedGenState([]string{"bnb", "btc"}, []sdk.Dec{d("15.01"), d("9500")}),
+		newCDPGenesisState(testCDPParams),
+	}
+
+	// bnb-a stability fee can move 2% APR per proposal within [0%, 20%], auction surplus increment can move 0.05 within [0.01, 0.1]
+	permission := types.BoundedParamChangePermission{
+		AllowedParamBounds: types.AllowedParamBounds{
+			types.NewAllowedParamBound(
+				cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), "type", "bnb-a", "stability_fee",
+				d("0"), d("0.2"), d("0.02"), true,
+			),
+			types.NewAllowedParamBound(
+				auctiontypes.ModuleName, string(auctiontypes.KeyIncrementSurplus), "", "", "",
+				d("0.01"), d("0.1"), d("0.05"), false,
+			),
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		changes       []paramstypes.ParamChange
+		expectAllowed bool
+	}{
+		{
+			name: "allowed stability fee change within step",
+			changes: []paramstypes.ParamChange{{
+				Subspace: cdptypes.ModuleName,
+				Key:      string(cdptypes.KeyCollateralParams),
+				Value: string(suite.cdc.MustMarshalJSON(updateCPs(func(cps cdptypes.CollateralParams) {
+					cps[0].StabilityFee = d("1.000000001996917783") // 6.5% APR
+				}))),
+			}},
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (stability fee step too large)",
+			changes: []paramstypes.ParamChange{{
+				Subspace: cdptypes.ModuleName,
+				Key:      string(cdptypes.KeyCollateralParams),
+				Value: string(suite.cdc.MustMarshalJSON(updateCPs(func(cps cdptypes.CollateralParams) {
+					cps[0].StabilityFee = d("1.000000002440418608") // 8% APR
+				}))),
+			}},
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (unbounded collateral type)",
+			changes: []paramstypes.ParamChange{{
+				Subspace: cdptypes.ModuleName,
+				Key:      string(cdptypes.KeyCollateralParams),
+				Value: string(suite.cdc.MustMarshalJSON(updateCPs(func(cps cdptypes.CollateralParams) {
+					cps[1].StabilityFee = d("1.000000001996917783") // 6.5% APR
+				}))),
+			}},
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (unbounded field changed)",
+			changes: []paramstypes.ParamChange{{
+				Subspace: cdptypes.ModuleName,
+				Key:      string(cdptypes.KeyCollateralParams),
+				Value: string(suite.cdc.MustMarshalJSON(updateCPs(func(cps cdptypes.CollateralParams) {
+					cps[0].StabilityFee = d("1.000000001996917783") // 6.5% APR
+					cps[0].LiquidationRatio = d("1.8")
+				}))),
+			}},
+			expectAllowed: false,
+		},
+		{
+			name: "allowed increment change",
+			changes: []paramstypes.ParamChange{{
+				Subspace: auctiontypes.ModuleName,
+				Key:      string(auctiontypes.KeyIncrementSurplus),
+				Value:    string(suite.cdc.MustMarshalJSON(d("0.08"))),
+			}},
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (increment above max)",
+			changes: []paramstypes.ParamChange{{
+				Subspace: auctiontypes.ModuleName,
+				Key:      string(auctiontypes.KeyIncrementSurplus),
+				Value:    string(suite.cdc.MustMarshalJSON(d("0.1001"))),
+			}},
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (unbounded param)",
+			changes: []paramstypes.ParamChange{{
+				Subspace: auctiontypes.ModuleName,
+				Key:      string(auctiontypes.KeyIncrementDebt),
+				Value:    string(suite.cdc.MustMarshalJSON(d("0.08"))),
+			}},
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (invalid value)",
+			changes: []paramstypes.ParamChange{{
+				Subspace: auctiontypes.ModuleName,
+				Key:      string(auctiontypes.KeyIncrementSurplus),
+				Value:    `{"not": "a dec"}`,
+			}},
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{})
+			tApp.InitializeFromGenesisStates(genState...)
+
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(ctx, tApp.Codec(), tApp.GetParamsKeeper(), paramstypes.NewParameterChangeProposal("A Title", "A description for this proposal.", tc.changes)),
+			)
+		})
+	}
+}
+
+func newSwapGenesisState(params swaptypes.Params) app.GenesisState {
+	genesis := swaptypes.DefaultGenesisState()
+	genesis.Params = params
+	return app.GenesisState{swaptypes.ModuleName: swaptypes.ModuleCdc.MustMarshalJSON(genesis)}
+}
+
 func TestPermissionTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionTestSuite))
 }
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// ApplyPauses stores the pauses of a pause proposal, replacing existing pauses of the same msg type and scope.
+// Pauses with an end time that has passed lift the existing pause instead.
+func (k Keeper) ApplyPauses(ctx sdk.Context, pauses types.Pauses) {
+	for _, pause := range pauses {
+		if !pause.IsActiveAt(ctx.BlockTime()) {
+			k.DeletePause(ctx, pause.Route, pause.MsgType, pause.Scope)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMsgUnpause,
+					sdk.NewAttribute(types.AttributeKeyRoute, pause.Route),
+					sdk.NewAttribute(types.AttributeKeyMsgType, pause.MsgType),
+					sdk.NewAttribute(types.AttributeKeyScope, pause.Scope),
+				),
+			)
+			continue
+		}
+
+		k.SetPause(ctx, pause)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMsgPause,
+				sdk.NewAttribute(types.AttributeKeyRoute, pause.Route),
+				sdk.NewAttribute(types.AttributeKeyMsgType, pause.MsgType),
+				sdk.NewAttribute(types.AttributeKeyScope, pause.Scope),
+				sdk.NewAttribute(types.AttributeKeyEndTime, pause.EndTime.String()),
+			),
+		)
+	}
+}
+
+// IsMsgPaused returns whether a msg is disabled by an active pause.
+func (k Keeper) IsMsgPaused(ctx sdk.Context, msg sdk.Msg) bool {
+	for _, pause := range k.GetPausesByMsgType(ctx, msg.Route(), msg.Type()) {
+		if pause.IsActiveAt(ctx.BlockTime()) && pause.Matches(msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckMsgPaused returns an error if a msg is disabled by an active pause.
+func (k Keeper) CheckMsgPaused(ctx sdk.Context, msg sdk.Msg) error {
+	if k.IsMsgPaused(ctx, msg) {
+		return sdkerrors.Wrapf(types.ErrMsgPaused, "%s/%s", msg.Route(), msg.Type())
+	}
+	return nil
+}
+
+// DeleteExpiredPauses removes pauses whose end time has passed.
+func (k Keeper) DeleteExpiredPauses(ctx sdk.Context) {
+	var expired types.Pauses
+	k.IteratePauses(ctx, func(pause types.Pause) bool {
+		if !pause.IsActiveAt(ctx.BlockTime()) {
+			expired = append(expired, pause)
+		}
+		return false
+	})
+	for _, pause := range expired {
+		k.DeletePause(ctx, pause.Route, pause.MsgType, pause.Scope)
+	}
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/committee/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestApplyPauses() {
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates()
+
+	borrowBnb := hardtypes.NewMsgBorrow(suite.addresses[0], sdk.NewCoins(sdk.NewInt64Coin("bnb", 1e8)))
+	borrowKava := hardtypes.NewMsgBorrow(suite.addresses[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e8)))
+	deposit := hardtypes.NewMsgDeposit(suite.addresses[0], sdk.NewCoins(sdk.NewInt64Coin("bnb", 1e8)))
+
+	// a scoped pause only disables msgs acting on its scope
+	keeper.ApplyPauses(ctx, types.Pauses{types.NewPause("hard", "hard_borrow", "bnb", firstBlockTime.Add(time.Hour))})
+	suite.True(keeper.IsMsgPaused(ctx, borrowBnb))
+	suite.False(keeper.IsMsgPaused(ctx, borrowKava))
+	suite.False(keeper.IsMsgPaused(ctx, deposit))
+	suite.Error(keeper.CheckMsgPaused(ctx, borrowBnb))
+	suite.NoError(keeper.CheckMsgPaused(ctx, deposit))
+
+	// an unscoped pause disables all msgs of the type
+	keeper.ApplyPauses(ctx, types.Pauses{types.NewPause("hard", "hard_borrow", "", firstBlockTime.Add(2*time.Hour))})
+	suite.True(keeper.IsMsgPaused(ctx, borrowKava))
+	suite.Len(keeper.GetPauses(ctx), 2)
+
+	// a pause with an end time that has passed lifts the existing pause
+	keeper.ApplyPauses(ctx, types.Pauses{types.NewPause("hard", "hard_borrow", "", firstBlockTime)})
+	suite.False(keeper.IsMsgPaused(ctx, borrowKava))
+	suite.True(keeper.IsMsgPaused(ctx, borrowBnb))
+
+	// pauses stop applying once their end time passes and are removed at the start of the next block
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour))
+	suite.False(keeper.IsMsgPaused(ctx, borrowBnb))
+	keeper.DeleteExpiredPauses(ctx)
+	suite.Empty(keeper.GetPauses(ctx))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,41 +12,140 @@ import (
 
 // SubmitProposal adds a proposal to a committee so that it can be voted on.
 func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committeeID uint64, pubProposal types.PubProposal) (uint64, error) {
-	// Limit proposals to only be submitted by committee members
 	com, found := k.GetCommittee(ctx, committeeID)
 	if !found {
 		return 0, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
 	}
+	if err := k.validateProposal(ctx, proposer, com, pubProposal); err != nil {
+		return 0, err
+	}
+
+	// Get a new ID and store the proposal
+	deadline := ctx.BlockTime().Add(com.GetProposalDuration())
+	proposalID, err := k.StoreNewProposal(ctx, pubProposal, committeeID, deadline, proposer)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalSubmit,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyDeadline, deadline.String()),
+		),
+	)
+	return proposalID, nil
+}
+
+// validateProposal checks that a proposer can submit a pubproposal to a committee
+func (k Keeper) validateProposal(ctx sdk.Context, proposer sdk.AccAddress, com types.Committee, pubProposal types.PubProposal) error {
+	// Limit proposals to only be submitted by committee members
 	if !com.HasMember(proposer) {
-		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "proposer not member of committee")
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "proposer not member of committee")
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal) {
-		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
+	if !k.hasPermissionsFor(ctx, com, pubProposal) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
 	// Check proposal is valid
-	if err := k.ValidatePubProposal(ctx, pubProposal); err != nil {
+	return k.ValidatePubProposal(ctx, pubProposal)
+}
+
+// WithdrawProposal withdraws a proposal if the withdrawer is its proposer. Otherwise the withdrawer's request is
+// recorded, and the proposal is withdrawn once a majority of the committee's members have requested it.
+func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64, withdrawer sdk.AccAddress) error {
+	pr, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if pr.HasExpiredBy(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
+	}
+
+	withdrawn := pr.IsProposer(withdrawer)
+	if !withdrawn {
+		com, found := k.GetCommittee(ctx, pr.CommitteeID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
+		}
+		if !com.HasMember(withdrawer) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "withdrawer must be the proposer or a member of committee")
+		}
+		if pr.HasRequestedWithdrawal(withdrawer) {
+			return sdkerrors.Wrapf(types.ErrWithdrawalRequested, "%s", withdrawer)
+		}
+		pr.WithdrawalRequests = append(pr.WithdrawalRequests, withdrawer)
+
+		// only requests from current members count towards the majority
+		memberRequests := 0
+		for _, requester := range pr.WithdrawalRequests {
+			if com.HasMember(requester) {
+				memberRequests++
+			}
+		}
+		withdrawn = 2*memberRequests > len(com.GetMembers())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalWithdraw,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", pr.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, withdrawer.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalRequests, fmt.Sprintf("%d", len(pr.WithdrawalRequests))),
+		),
+	)
+
+	if withdrawn {
+		k.CloseProposal(ctx, pr, types.Withdrawn)
+	} else {
+		k.SetProposal(ctx, pr)
+	}
+	return nil
+}
+
+// AmendProposal supersedes a proposal with an amended pubproposal submitted by the same proposer.
+// The amended proposal is stored under a new ID with a new deadline and no votes.
+func (k Keeper) AmendProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress, pubProposal types.PubProposal) (uint64, error) {
+	pr, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if pr.HasExpiredBy(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrProposalExpired, "%s ≥ %s", ctx.BlockTime(), pr.Deadline)
+	}
+	if !pr.IsProposer(proposer) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the proposer can amend a proposal")
+	}
+	com, found := k.GetCommittee(ctx, pr.CommitteeID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
+	}
+	if err := k.validateProposal(ctx, proposer, com, pubProposal); err != nil {
 		return 0, err
 	}
 
-	// Get a new ID and store the proposal
+	k.CloseProposal(ctx, pr, types.Superseded)
+
 	deadline := ctx.BlockTime().Add(com.GetProposalDuration())
-	proposalID, err := k.StoreNewProposal(ctx, pubProposal, committeeID, deadline)
+	newProposalID, err := k.StoreNewProposal(ctx, pubProposal, pr.CommitteeID, deadline, proposer)
 	if err != nil {
 		return 0, err
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeProposalSubmit,
-			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			types.EventTypeProposalAmend,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", pr.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", newProposalID)),
+			sdk.NewAttribute(types.AttributeKeyAmendedProposalID, fmt.Sprintf("%d", pr.ID)),
 			sdk.NewAttribute(types.AttributeKeyDeadline, deadline.String()),
 		),
 	)
-	return proposalID, nil
+	return newProposalID, nil
 }
 
 // AddVote submits a vote on a proposal.
@@ -73,21 +173,64 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 		}
 	}
 
-	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType))
+	// Store vote, overwriting any prior vote and its weight
+	vote := k.SnapshotVoteWeight(ctx, types.NewVote(proposalID, voter, voteType))
+	k.SetVote(ctx, vote)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
-			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
-			sdk.NewAttribute(types.AttributeKeyVote, fmt.Sprintf("%d", voteType)),
-		),
+	event := sdk.NewEvent(
+		types.EventTypeProposalVote,
+		sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
+		sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+		sdk.NewAttribute(types.AttributeKeyVote, fmt.Sprintf("%d", voteType)),
 	)
+	if vote.HasWeight() {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyVoteWeight, vote.Weight.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
 	return nil
 }
 
+// SnapshotVoteWeight records the voter's current voting power on a token committee vote,
+// so the vote's weight doesn't change as balances move during voting.
+// Votes on member committee proposals are returned unchanged.
+func (k Keeper) SnapshotVoteWeight(ctx sdk.Context, vote types.Vote) types.Vote {
+	pr, found := k.GetProposal(ctx, vote.ProposalID)
+	if !found {
+		return vote
+	}
+	com, found := k.GetCommittee(ctx, pr.CommitteeID)
+	if !found {
+		return vote
+	}
+	if tokenCom, ok := com.(types.TokenCommittee); ok {
+		weight := k.GetVotingPower(ctx, vote.Voter, tokenCom.TallyDenom)
+		vote.Weight = &weight
+	}
+	return vote
+}
+
+// CapVoteWeights cuts the weight of each token committee vote on a proposal to the voter's current voting power
+// whenever that has fallen below it. It is run once before a proposal's result is acted on, so tokens moved after
+// voting stop counting for the vote and the same tokens can't pass a proposal from more than one address.
+// Votes on member committee proposals are left unchanged.
+func (k Keeper) CapVoteWeights(ctx sdk.Context, proposalID uint64, committee types.Committee) {
+	tokenCom, ok := committee.(types.TokenCommittee)
+	if !ok {
+		return
+	}
+	for _, vote := range k.GetVotesByProposal(ctx, proposalID) {
+		if !vote.HasWeight() {
+			continue
+		}
+		votingPower := k.GetVotingPower(ctx, vote.Voter, tokenCom.TallyDenom)
+		if votingPower.LT(*vote.Weight) {
+			vote.Weight = &votingPower
+			k.SetVote(ctx, vote)
+		}
+	}
+}
+
 // ValidatePubProposal checks if a pubproposal is valid.
 func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr error) {
 	if pubProposal == nil {
@@ -97,6 +240,19 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
+	// Veto proposals are handled by the keeper as the committee proposal handler is not registered on the committee router.
+	if veto, ok := pubProposal.(types.CommitteeVetoProposal); ok {
+		if _, found := k.GetQueuedProposal(ctx, veto.ProposalID); !found {
+			return sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", veto.ProposalID)
+		}
+		return nil
+	}
+
+	// Pause proposals are handled by the keeper for the same reason.
+	if _, ok := pubProposal.(types.PauseProposal); ok {
+		return nil
+	}
+
 	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
 		return sdkerrors.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
@@ -133,24 +289,115 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 
 		if !proposal.HasExpiredBy(ctx.BlockTime()) {
 			if committee.GetTallyOption() == types.FirstPastThePost {
+				// the recorded weights are only checked against current voting power once they're enough to pass
 				passed := k.GetProposalResult(ctx, proposal.ID, committee)
 				if passed {
-					outcome := k.attemptEnactProposal(ctx, proposal)
-					k.CloseProposal(ctx, proposal, outcome)
+					k.CapVoteWeights(ctx, proposal.ID, committee)
+					passed = k.GetProposalResult(ctx, proposal.ID, committee)
+				}
+				if passed {
+					k.passProposal(ctx, proposal, committee)
 				}
 			}
 		} else {
+			k.CapVoteWeights(ctx, proposal.ID, committee)
 			passed := k.GetProposalResult(ctx, proposal.ID, committee)
-			outcome := types.Failed
 			if passed {
-				outcome = k.attemptEnactProposal(ctx, proposal)
+				k.passProposal(ctx, proposal, committee)
+			} else {
+				k.CloseProposal(ctx, proposal, types.Failed)
 			}
-			k.CloseProposal(ctx, proposal, outcome)
 		}
 		return false
 	})
 }
 
+// passProposal enacts a passed proposal, or queues it if the committee has an enactment delay.
+// Veto proposals are always enacted immediately, as a queued veto could be enacted after the proposal it vetoes.
+func (k Keeper) passProposal(ctx sdk.Context, proposal types.Proposal, committee types.Committee) {
+	_, isVeto := proposal.PubProposal.(types.CommitteeVetoProposal)
+	if committee.GetEnactmentDelay() > 0 && !isVeto {
+		k.QueueProposal(ctx, proposal, ctx.BlockTime().Add(committee.GetEnactmentDelay()))
+		return
+	}
+	outcome := k.attemptEnactProposal(ctx, proposal)
+	k.CloseProposal(ctx, proposal, outcome)
+}
+
+// QueueProposal closes a passed proposal and stores it to be enacted at the enactment time.
+func (k Keeper) QueueProposal(ctx sdk.Context, proposal types.Proposal, enactmentTime time.Time) {
+	k.CloseProposal(ctx, proposal, types.Queued)
+	k.SetQueuedProposal(ctx, types.NewQueuedProposal(proposal, enactmentTime))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyEnactmentTime, enactmentTime.String()),
+		),
+	)
+}
+
+// ProcessQueuedProposals enacts queued proposals whose enactment time has been reached.
+// The committee's permissions are checked again as they may have changed while the proposal was queued.
+func (k Keeper) ProcessQueuedProposals(ctx sdk.Context) {
+	var dueProposals types.QueuedProposals
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		if qp.IsDueBy(ctx.BlockTime()) {
+			dueProposals = append(dueProposals, qp)
+		}
+		return false
+	})
+
+	for _, qp := range dueProposals {
+		k.DeleteQueuedProposal(ctx, qp.Proposal.ID)
+		outcome := k.attemptEnactProposal(ctx, qp.Proposal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalEnact,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", qp.Proposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", qp.Proposal.ID)),
+				sdk.NewAttribute(types.AttributeKeyProposalOutcome, outcome.String()),
+			),
+		)
+	}
+}
+
+// VetoQueuedProposal removes a queued proposal so that it is never enacted.
+func (k Keeper) VetoQueuedProposal(ctx sdk.Context, proposalID uint64) error {
+	qp, found := k.GetQueuedProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", proposalID)
+	}
+	k.DeleteQueuedProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVeto,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", qp.Proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", qp.Proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposalOutcome, types.Vetoed.String()),
+		),
+	)
+	return nil
+}
+
+// hasPermissionsFor returns whether a committee is authorized to enact a pubproposal.
+// Committees designated as a veto committee can always veto the queued proposals of the committees that designate them.
+func (k Keeper) hasPermissionsFor(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) bool {
+	if veto, ok := pubProposal.(types.CommitteeVetoProposal); ok {
+		if qp, found := k.GetQueuedProposal(ctx, veto.ProposalID); found {
+			vetoed, found := k.GetCommittee(ctx, qp.Proposal.CommitteeID)
+			if found && vetoed.HasVetoCommittee(com.GetID()) {
+				return true
+			}
+		}
+	}
+	return com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal)
+}
+
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	switch com := committee.(type) {
 	case types.MemberCommittee:
@@ -164,15 +411,37 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
 func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
-	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
-	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
+	currVotes, _, possibleVotes := k.TallyMemberCommitteeVotes(ctx, proposalID, committee)
 	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
 }
 
-// TallyMemberCommitteeVotes returns the polling status of a member committee vote
-func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64) (totalVotes sdk.Dec) {
+// TallyMemberCommitteeVotes returns the polling status of a member committee vote. Returns total votes,
+// the part of the total cast by delegatees on behalf of members who didn't vote, and total possible votes
+// (equal to the sum of member weights).
+func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64,
+	committee types.Committee) (totalVotes, delegatedVotes, possibleVotes sdk.Dec) {
 	votes := k.GetVotesByProposal(ctx, proposalID)
-	return sdk.NewDec(int64(len(votes)))
+
+	totalVotes = sdk.ZeroDec()
+	voted := make(map[string]bool, len(votes))
+	for _, vote := range votes {
+		totalVotes = totalVotes.Add(committee.GetMemberWeight(vote.Voter))
+		voted[vote.Voter.String()] = true
+	}
+
+	// members who haven't voted are counted with their delegatee's vote while their delegation is active
+	delegatedVotes = sdk.ZeroDec()
+	for _, delegation := range k.GetVoteDelegationsByCommittee(ctx, committee.GetID()) {
+		if !delegation.IsActiveAt(ctx.BlockTime()) || voted[delegation.Delegator.String()] {
+			continue
+		}
+		if !voted[delegation.Delegatee.String()] || !committee.HasMember(delegation.Delegatee) {
+			continue
+		}
+		delegatedVotes = delegatedVotes.Add(committee.GetMemberWeight(delegation.Delegator))
+	}
+
+	return totalVotes.Add(delegatedVotes), delegatedVotes, committee.GetTotalMemberWeight()
 }
 
 // GetTokenCommitteeProposalResult gets the result of a token committee proposal
@@ -198,20 +467,29 @@ func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 	noVotes = sdk.ZeroDec()
 	totalVotes = sdk.ZeroDec()
 	for _, vote := range votes {
-		// 1 token = 1 vote
-		acc := k.accountKeeper.GetAccount(ctx, vote.Voter)
-		accNumCoins := acc.GetCoins().AmountOf(tallyDenom)
+		// 1 token = 1 vote, including tokens that are staked, deposited or provided as liquidity.
+		// Votes cast before weights were recorded fall back to the voter's current voting power.
+		var votingPower sdk.Dec
+		if vote.HasWeight() {
+			votingPower = vote.Weight.ToDec()
+		} else {
+			votingPower = k.GetVotingPower(ctx, vote.Voter, tallyDenom).ToDec()
+		}
 
 		// Add votes to counters
-		totalVotes = totalVotes.Add(accNumCoins.ToDec())
+		totalVotes = totalVotes.Add(votingPower)
 		if vote.VoteType == types.Yes {
-			yesVotes = yesVotes.Add(accNumCoins.ToDec())
+			yesVotes = yesVotes.Add(votingPower)
 		} else if vote.VoteType == types.No {
-			noVotes = noVotes.Add(accNumCoins.ToDec())
+			noVotes = noVotes.Add(votingPower)
 		}
 	}
 
+	// use the total snapshotted at submission, falling back to the current supply for older proposals
 	possibleVotesInt := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(tallyDenom)
+	if pr, found := k.GetProposal(ctx, proposalID); found && pr.HasPossibleVotesSnapshot() {
+		possibleVotesInt = *pr.PossibleVotes
+	}
 	return yesVotes, noVotes, totalVotes, possibleVotesInt.ToDec()
 }
 
@@ -231,7 +509,7 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, proposal.PubProposal) {
+	if !k.hasPermissionsFor(ctx, com, proposal.PubProposal) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -239,6 +517,14 @@ func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
 		return err
 	}
 
+	if veto, ok := proposal.PubProposal.(types.CommitteeVetoProposal); ok {
+		return k.VetoQueuedProposal(ctx, veto.ProposalID)
+	}
+	if pause, ok := proposal.PubProposal.(types.PauseProposal); ok {
+		k.ApplyPauses(ctx, pause.Pauses)
+		return nil
+	}
+
 	// enact the proposal
 	handler := k.router.GetRoute(proposal.ProposalRoute())
 	if err := handler(ctx, proposal.PubProposal); err != nil {
@@ -256,10 +542,10 @@ func (k Keeper) CloseProposal(ctx sdk.Context, proposal types.Proposal, outcome
 	if found {
 		switch com := committee.(type) {
 		case types.MemberCommittee:
-			currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
-			possibleVotes := sdk.NewDec(int64(len(com.Members)))
+			currVotes, delegatedVotes, possibleVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID, com)
 			memberPollingStatus := types.NewProposalPollingStatus(proposal.ID, currVotes,
 				currVotes, possibleVotes, com.VoteThreshold, sdk.Dec{Int: nil})
+			memberPollingStatus.DelegatedVotes = delegatedVotes
 			proposalTally = memberPollingStatus
 		case types.TokenCommittee:
 			yesVotes, _, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
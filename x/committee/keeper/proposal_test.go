@@ -360,6 +360,259 @@ func (suite *KeeperTestSuite) TestAddVote() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAddVote_SnapshotsVotingPower() {
+	tokenCom := types.TokenCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               12,
+			Members:          suite.addresses[:2],
+			Permissions:      []types.Permission{types.GodPermission{}},
+			VoteThreshold:    d("0.667"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.Deadline,
+		},
+		Quorum:     d("0.4"),
+		TallyDenom: "hard",
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	voter := suite.addresses[2]
+	recipient := suite.addresses[3]
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		supplyGenState(tApp.Codec(), cs(c("hard", 100))),
+		app.NewAuthGenState(
+			[]sdk.AccAddress{voter, recipient},
+			[]sdk.Coins{cs(c("hard", 60)), cs(c("hard", 40))},
+		),
+	)
+
+	keeper.SetCommittee(ctx, tokenCom)
+	id, err := keeper.SubmitProposal(ctx, tokenCom.Members[0], tokenCom.GetID(), gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+
+	// total possible votes are recorded at submission
+	pr, found := keeper.GetProposal(ctx, id)
+	suite.Require().True(found)
+	suite.Require().True(pr.HasPossibleVotesSnapshot())
+	suite.Equal(i(100), *pr.PossibleVotes)
+
+	// voting power is recorded when the vote is cast
+	suite.Require().NoError(keeper.AddVote(ctx, id, voter, types.Yes))
+	vote, found := keeper.GetVote(ctx, id, voter)
+	suite.Require().True(found)
+	suite.Require().True(vote.HasWeight())
+	suite.Equal(i(60), *vote.Weight)
+
+	// the tally reads the recorded weight rather than the voter's current balance
+	suite.Require().NoError(tApp.GetBankKeeper().SendCoins(ctx, voter, recipient, cs(c("hard", 50))))
+	yesVotes, noVotes, totalVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, id, tokenCom.TallyDenom)
+	suite.Equal(d("60"), yesVotes)
+	suite.Equal(d("0"), noVotes)
+	suite.Equal(d("60"), totalVotes)
+	suite.Equal(d("100"), possibleVotes)
+
+	// voting again re-snapshots the voter's voting power
+	suite.Require().NoError(keeper.AddVote(ctx, id, voter, types.No))
+	yesVotes, noVotes, totalVotes, _ = keeper.TallyTokenCommitteeVotes(ctx, id, tokenCom.TallyDenom)
+	suite.Equal(d("0"), yesVotes)
+	suite.Equal(d("10"), noVotes)
+	suite.Equal(d("10"), totalVotes)
+}
+
+func (suite *KeeperTestSuite) TestCapVoteWeights() {
+	tokenCom := types.TokenCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               12,
+			Members:          suite.addresses[:2],
+			Permissions:      []types.Permission{types.GodPermission{}},
+			VoteThreshold:    d("0.5"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.FirstPastThePost,
+		},
+		Quorum:     d("0.4"),
+		TallyDenom: "hard",
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	voter := suite.addresses[2]
+	recipient := suite.addresses[3]
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	bank := tApp.GetBankKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		supplyGenState(tApp.Codec(), cs(c("hard", 100))),
+		app.NewAuthGenState(
+			[]sdk.AccAddress{voter, recipient},
+			[]sdk.Coins{cs(c("hard", 60)), cs(c("hard", 40))},
+		),
+	)
+
+	keeper.SetCommittee(ctx, tokenCom)
+	id, err := keeper.SubmitProposal(ctx, tokenCom.Members[0], tokenCom.GetID(), gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+
+	// the voter votes, then sends their tokens to the recipient who votes with them again
+	suite.Require().NoError(keeper.AddVote(ctx, id, voter, types.Yes))
+	suite.Require().NoError(bank.SendCoins(ctx, voter, recipient, cs(c("hard", 60))))
+	suite.Require().NoError(keeper.AddVote(ctx, id, recipient, types.Yes))
+	_, _, totalVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, id, tokenCom.TallyDenom)
+	suite.Equal(d("160"), totalVotes)
+	suite.Equal(d("100"), possibleVotes)
+
+	// the moved tokens stop counting for the voter before the proposal's result is acted on, so they only count once
+	keeper.CapVoteWeights(ctx, id, tokenCom)
+	vote, found := keeper.GetVote(ctx, id, voter)
+	suite.Require().True(found)
+	suite.Equal(i(0), *vote.Weight)
+	yesVotes, _, totalVotes, _ := keeper.TallyTokenCommitteeVotes(ctx, id, tokenCom.TallyDenom)
+	suite.Equal(d("100"), yesVotes)
+	suite.Equal(d("100"), totalVotes)
+
+	// the weight isn't restored when the tokens come back
+	suite.Require().NoError(bank.SendCoins(ctx, recipient, voter, cs(c("hard", 60))))
+	keeper.CapVoteWeights(ctx, id, tokenCom)
+	_, _, totalVotes, _ = keeper.TallyTokenCommitteeVotes(ctx, id, tokenCom.TallyDenom)
+	suite.Equal(d("40"), totalVotes)
+
+	// weights are capped before a passing proposal is enacted at the start of a block, so recycled stake can't pass a proposal
+	id, err = keeper.SubmitProposal(ctx, tokenCom.Members[0], tokenCom.GetID(), gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	accomplice := suite.addresses[4]
+	suite.Require().NoError(keeper.AddVote(ctx, id, voter, types.No))
+	suite.Require().NoError(keeper.AddVote(ctx, id, recipient, types.Yes))
+	suite.Require().NoError(bank.SendCoins(ctx, recipient, accomplice, cs(c("hard", 40))))
+	suite.Require().NoError(keeper.AddVote(ctx, id, accomplice, types.Yes))
+	suite.True(keeper.GetTokenCommitteeProposalResult(ctx, id, tokenCom))
+
+	committee.BeginBlocker(ctx, abci.RequestBeginBlock{}, keeper)
+	_, found = keeper.GetProposal(ctx, id)
+	suite.True(found)
+	suite.False(keeper.GetTokenCommitteeProposalResult(ctx, id, tokenCom))
+}
+
+func (suite *KeeperTestSuite) TestWithdrawProposal() {
+	memberCom := types.MemberCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               12,
+			Members:          suite.addresses[:3],
+			Permissions:      []types.Permission{types.GodPermission{}},
+			VoteThreshold:    d("0.667"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.FirstPastThePost,
+		},
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	suite.Run("proposer withdraws", func() {
+		tApp := app.NewTestApp()
+		keeper := tApp.GetCommitteeKeeper()
+		ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+		tApp.InitializeFromGenesisStates()
+		keeper.SetCommittee(ctx, memberCom)
+
+		id, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+		suite.Require().NoError(err)
+		suite.Require().NoError(keeper.AddVote(ctx, id, memberCom.Members[1], types.Yes))
+
+		suite.Require().NoError(keeper.WithdrawProposal(ctx, id, memberCom.Members[0]))
+		_, found := keeper.GetProposal(ctx, id)
+		suite.False(found)
+		suite.Empty(keeper.GetVotesByProposal(ctx, id))
+	})
+
+	suite.Run("majority of members withdraw", func() {
+		tApp := app.NewTestApp()
+		keeper := tApp.GetCommitteeKeeper()
+		ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+		tApp.InitializeFromGenesisStates()
+		keeper.SetCommittee(ctx, memberCom)
+
+		id, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(keeper.WithdrawProposal(ctx, id, memberCom.Members[1]))
+		pr, found := keeper.GetProposal(ctx, id)
+		suite.Require().True(found)
+		suite.Equal([]sdk.AccAddress{memberCom.Members[1]}, pr.WithdrawalRequests)
+
+		err = keeper.WithdrawProposal(ctx, id, memberCom.Members[1])
+		suite.True(types.ErrWithdrawalRequested.Is(err))
+
+		suite.Require().NoError(keeper.WithdrawProposal(ctx, id, memberCom.Members[2]))
+		_, found = keeper.GetProposal(ctx, id)
+		suite.False(found)
+	})
+
+	suite.Run("non member cannot withdraw", func() {
+		tApp := app.NewTestApp()
+		keeper := tApp.GetCommitteeKeeper()
+		ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+		tApp.InitializeFromGenesisStates()
+		keeper.SetCommittee(ctx, memberCom)
+
+		id, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+		suite.Require().NoError(err)
+
+		suite.Error(keeper.WithdrawProposal(ctx, id, suite.addresses[4]))
+		suite.Error(keeper.WithdrawProposal(ctx, id+1, memberCom.Members[0]))
+		_, found := keeper.GetProposal(ctx, id)
+		suite.True(found)
+	})
+}
+
+func (suite *KeeperTestSuite) TestAmendProposal() {
+	memberCom := types.MemberCommittee{
+		BaseCommittee: types.BaseCommittee{
+			ID:               12,
+			Members:          suite.addresses[:3],
+			Permissions:      []types.Permission{types.TextPermission{}},
+			VoteThreshold:    d("0.667"),
+			ProposalDuration: time.Hour * 24 * 7,
+			TallyOption:      types.FirstPastThePost,
+		},
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates()
+	keeper.SetCommittee(ctx, memberCom)
+
+	id, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, id, memberCom.Members[1], types.Yes))
+
+	amended := gov.NewTextProposal("A Title", "An amended description of this proposal.")
+
+	// only the proposer can amend
+	_, err = keeper.AmendProposal(ctx, id, memberCom.Members[1], amended)
+	suite.Error(err)
+
+	// the committee must have permission for the amended content
+	_, err = keeper.AmendProposal(ctx, id, memberCom.Members[0], UnregisteredPubProposal{gov.TextProposal{Title: "A Title", Description: "A description."}})
+	suite.Error(err)
+
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour))
+	newID, err := keeper.AmendProposal(ctx, id, memberCom.Members[0], amended)
+	suite.Require().NoError(err)
+	suite.NotEqual(id, newID)
+
+	_, found := keeper.GetProposal(ctx, id)
+	suite.False(found)
+	suite.Empty(keeper.GetVotesByProposal(ctx, id))
+
+	pr, found := keeper.GetProposal(ctx, newID)
+	suite.Require().True(found)
+	suite.Equal(amended, pr.PubProposal)
+	suite.Equal(memberCom.Members[0], pr.Proposer)
+	suite.Equal(ctx.BlockTime().Add(memberCom.ProposalDuration), pr.Deadline)
+	suite.Empty(keeper.GetVotesByProposal(ctx, newID))
+}
+
 func (suite *KeeperTestSuite) TestTallyMemberCommitteeVotes() {
 	memberCom := types.MemberCommittee{
 		BaseCommittee: types.BaseCommittee{
@@ -426,7 +679,7 @@ func (suite *KeeperTestSuite) TestTallyMemberCommitteeVotes() {
 		)
 
 		// Check that all votes are counted
-		currentVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID)
+		currentVotes, _, _ := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID, memberCom)
 		suite.Equal(tc.expectedVoteCount, currentVotes)
 	}
 }
@@ -852,6 +1105,9 @@ func committeeGenState(cdc *codec.Codec, committees []types.Committee, proposals
 		committees,
 		proposals,
 		votes,
+		types.QueuedProposals{},
+		types.VoteDelegations{},
+		types.Pauses{},
 	)
 	return app.GenesisState{committee.ModuleName: cdc.MustMarshalJSON(gs)}
 }
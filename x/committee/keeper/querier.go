@@ -22,10 +22,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryProposals(ctx, path[1:], req, keeper)
 		case types.QueryProposal:
 			return queryProposal(ctx, path[1:], req, keeper)
+		case types.QueryQueuedProposals:
+			return queryQueuedProposals(ctx, path[1:], req, keeper)
+		case types.QueryQueuedProposal:
+			return queryQueuedProposal(ctx, path[1:], req, keeper)
 		case types.QueryVotes:
 			return queryVotes(ctx, path[1:], req, keeper)
 		case types.QueryVote:
 			return queryVote(ctx, path[1:], req, keeper)
+		case types.QueryVoteDelegations:
+			return queryVoteDelegations(ctx, path[1:], req, keeper)
+		case types.QueryPauses:
+			return queryPauses(ctx, path[1:], req, keeper)
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
 		case types.QueryNextProposalID:
@@ -112,6 +120,41 @@ func queryProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+func queryQueuedProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryCommitteeParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	queuedProposals := keeper.GetQueuedProposalsByCommittee(ctx, params.CommitteeID)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, queuedProposals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryQueuedProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	queuedProposal, found := keeper.GetQueuedProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, queuedProposal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryNextProposalID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	nextProposalID, _ := keeper.GetNextProposalID(ctx)
 
@@ -162,6 +205,22 @@ func queryVote(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	return bz, nil
 }
 
+func queryVoteDelegations(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryCommitteeParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	delegations := keeper.GetVoteDelegationsByCommittee(ctx, params.CommitteeID)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, delegations)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // ------------------------------------------
 //				Tally
 // ------------------------------------------
@@ -186,10 +245,10 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	var pollingStatus types.ProposalPollingStatus
 	switch com := committee.(type) {
 	case types.MemberCommittee:
-		currVotes := keeper.TallyMemberCommitteeVotes(ctx, params.ProposalID)
-		possibleVotes := sdk.NewDec(int64(len(com.Members)))
+		currVotes, delegatedVotes, possibleVotes := keeper.TallyMemberCommitteeVotes(ctx, params.ProposalID, com)
 		memberPollingStatus := types.NewProposalPollingStatus(params.ProposalID, currVotes,
 			currVotes, possibleVotes, com.VoteThreshold, sdk.Dec{Int: nil})
+		memberPollingStatus.DelegatedVotes = delegatedVotes
 		pollingStatus = memberPollingStatus
 	case types.TokenCommittee:
 		yesVotes, _, currVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, params.ProposalID, com.TallyDenom)
@@ -205,6 +264,21 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// ------------------------------------------
+//				Pauses
+// ------------------------------------------
+
+func queryPauses(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+
+	pauses := keeper.GetPauses(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, pauses)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // ------------------------------------------
 //				Raw Params
 // ------------------------------------------
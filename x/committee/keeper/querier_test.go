@@ -82,6 +82,9 @@ func (suite *QuerierTestSuite) SetupTest() {
 			{ProposalID: 1, Voter: suite.addresses[1], VoteType: types.Yes},
 			{ProposalID: 2, Voter: suite.addresses[2], VoteType: types.Yes},
 		},
+		types.QueuedProposals{},
+		types.VoteDelegations{},
+		types.Pauses{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.cdc, suite.testGenesis),
@@ -243,12 +246,13 @@ func (suite *QuerierTestSuite) TestQueryTally() {
 	// Expected result
 	propID := suite.testGenesis.Proposals[0].ID
 	expectedPollingStatus := types.ProposalPollingStatus{
-		ProposalID:    1,
-		YesVotes:      sdk.NewDec(int64(len(suite.votes[propID]))),
-		CurrentVotes:  sdk.NewDec(int64(len(suite.votes[propID]))),
-		PossibleVotes: d("3.0"),
-		VoteThreshold: d("0.667"),
-		Quorum:        d("0"),
+		ProposalID:     1,
+		YesVotes:       sdk.NewDec(int64(len(suite.votes[propID]))),
+		CurrentVotes:   sdk.NewDec(int64(len(suite.votes[propID]))),
+		PossibleVotes:  d("3.0"),
+		VoteThreshold:  d("0.667"),
+		Quorum:         d("0"),
+		DelegatedVotes: d("0"),
 	}
 
 	// Set up request query
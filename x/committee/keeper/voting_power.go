@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+var (
+	_ types.VotingPowerSource = HardVotingPowerSource{}
+	_ types.VotingPowerSource = SwapVotingPowerSource{}
+)
+
+// HardVotingPowerSource counts tokens supplied to the hard protocol, net of tokens borrowed from it
+type HardVotingPowerSource struct {
+	hardKeeper types.HardKeeper
+}
+
+// NewHardVotingPowerSource returns a new HardVotingPowerSource
+func NewHardVotingPowerSource(hk types.HardKeeper) HardVotingPowerSource {
+	return HardVotingPowerSource{hardKeeper: hk}
+}
+
+// GetVotingPower returns the voter's hard deposit of the denom less their borrow of it, both including accrued interest.
+// It is negative when the voter has borrowed more of the denom than they deposited, so that borrowed tokens held in
+// their liquid balance don't count, and redepositing borrowed tokens can't add voting power.
+func (s HardVotingPowerSource) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress, denom string) sdk.Int {
+	votingPower := sdk.ZeroInt()
+	if deposit, found := s.hardKeeper.GetSyncedDeposit(ctx, voter); found {
+		votingPower = deposit.Amount.AmountOf(denom)
+	}
+	if borrow, found := s.hardKeeper.GetSyncedBorrow(ctx, voter); found {
+		votingPower = votingPower.Sub(borrow.Amount.AmountOf(denom))
+	}
+	return votingPower
+}
+
+// SwapVotingPowerSource counts the tokens underlying a depositor's swap pool shares
+type SwapVotingPowerSource struct {
+	swapKeeper types.SwapKeeper
+}
+
+// NewSwapVotingPowerSource returns a new SwapVotingPowerSource
+func NewSwapVotingPowerSource(sk types.SwapKeeper) SwapVotingPowerSource {
+	return SwapVotingPowerSource{swapKeeper: sk}
+}
+
+// GetVotingPower returns the voter's share of the denom's reserves across all swap pools
+func (s SwapVotingPowerSource) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress, denom string) sdk.Int {
+	votingPower := sdk.ZeroInt()
+	for _, record := range s.swapKeeper.GetAllDepositorSharesByOwner(ctx, voter) {
+		pool, found := s.swapKeeper.GetPool(ctx, record.PoolID)
+		if !found || !pool.TotalShares.IsPositive() {
+			continue
+		}
+		var reserves sdk.Int
+		switch denom {
+		case pool.ReservesA.Denom:
+			reserves = pool.ReservesA.Amount
+		case pool.ReservesB.Denom:
+			reserves = pool.ReservesB.Amount
+		default:
+			continue
+		}
+		votingPower = votingPower.Add(reserves.Mul(record.SharesOwned).Quo(pool.TotalShares))
+	}
+	return votingPower
+}
+
+// GetVotingPower returns the voting power of an address in a token committee tallying a denom.
+// It is the sum of the address's liquid balance and the amounts counted by each registered voting power source, floored at zero.
+func (k Keeper) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress, denom string) sdk.Int {
+	votingPower := sdk.ZeroInt()
+	if acc := k.accountKeeper.GetAccount(ctx, voter); acc != nil {
+		votingPower = acc.GetCoins().AmountOf(denom)
+	}
+	for _, source := range k.votingPowerSources {
+		votingPower = votingPower.Add(source.GetVotingPower(ctx, voter, denom))
+	}
+	if votingPower.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return votingPower
+}
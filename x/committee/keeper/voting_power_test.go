@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestGetVotingPower(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(addrs, []sdk.Coins{
+			sdk.NewCoins(sdk.NewInt64Coin("hard", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin("hard", 10)),
+		}),
+	)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	committeeKeeper := tApp.GetCommitteeKeeper()
+
+	require.Equal(t, sdk.NewInt(100), committeeKeeper.GetVotingPower(ctx, addrs[0], "hard"))
+
+	// hard deposits count towards voting power
+	tApp.GetHardKeeper().SetDeposit(ctx, hardtypes.NewDeposit(addrs[0], sdk.NewCoins(sdk.NewInt64Coin("hard", 50), sdk.NewInt64Coin("bnb", 20)), hardtypes.SupplyInterestFactors{}))
+	require.Equal(t, sdk.NewInt(150), committeeKeeper.GetVotingPower(ctx, addrs[0], "hard"))
+
+	// hard borrows are subtracted from voting power
+	tApp.GetHardKeeper().SetBorrow(ctx, hardtypes.NewBorrow(addrs[0], sdk.NewCoins(sdk.NewInt64Coin("hard", 30)), hardtypes.BorrowInterestFactors{}))
+	require.Equal(t, sdk.NewInt(120), committeeKeeper.GetVotingPower(ctx, addrs[0], "hard"))
+
+	// the hard side of a swap pool position counts towards voting power
+	swapKeeper := tApp.GetSwapKeeper()
+	pool := swaptypes.NewPoolRecord(sdk.NewCoins(sdk.NewInt64Coin("hard", 1000), sdk.NewInt64Coin("usdx", 4000)), sdk.NewInt(2000))
+	swapKeeper.SetPool_Raw(ctx, pool)
+	swapKeeper.SetDepositorShares_Raw(ctx, swaptypes.NewShareRecord(addrs[0], pool.PoolID, sdk.NewInt(500)))
+	swapKeeper.SetDepositorShares_Raw(ctx, swaptypes.NewShareRecord(addrs[1], pool.PoolID, sdk.NewInt(1500)))
+
+	require.Equal(t, sdk.NewInt(370), committeeKeeper.GetVotingPower(ctx, addrs[0], "hard"))
+	require.Equal(t, sdk.NewInt(760), committeeKeeper.GetVotingPower(ctx, addrs[1], "hard"))
+	require.Equal(t, sdk.NewInt(1000), committeeKeeper.GetVotingPower(ctx, addrs[0], "usdx"))
+	require.Equal(t, sdk.ZeroInt(), committeeKeeper.GetVotingPower(ctx, addrs[0], "swp"))
+
+	// voting power doesn't go below zero when borrows exceed everything else the voter holds
+	tApp.GetHardKeeper().SetBorrow(ctx, hardtypes.NewBorrow(addrs[1], sdk.NewCoins(sdk.NewInt64Coin("hard", 1000)), hardtypes.BorrowInterestFactors{}))
+	require.Equal(t, sdk.ZeroInt(), committeeKeeper.GetVotingPower(ctx, addrs[1], "hard"))
+}
@@ -14,6 +14,10 @@ func NewProposalHandler(k Keeper) govtypes.Handler {
 			return handleCommitteeChangeProposal(ctx, k, c)
 		case CommitteeDeleteProposal:
 			return handleCommitteeDeleteProposal(ctx, k, c)
+		case CommitteeVetoProposal:
+			return handleCommitteeVetoProposal(ctx, k, c)
+		case PauseProposal:
+			return handlePauseProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
@@ -34,6 +38,7 @@ func handleCommitteeChangeProposal(ctx sdk.Context, k Keeper, committeeProposal
 
 	// update/create the committee
 	k.SetCommittee(ctx, committeeProposal.NewCommittee)
+	k.PruneVoteDelegations(ctx, committeeProposal.NewCommittee.GetID())
 	return nil
 }
 
@@ -49,5 +54,23 @@ func handleCommitteeDeleteProposal(ctx sdk.Context, k Keeper, committeeProposal
 	}
 
 	k.DeleteCommittee(ctx, committeeProposal.CommitteeID)
+	k.PruneVoteDelegations(ctx, committeeProposal.CommitteeID)
+	return nil
+}
+
+func handleCommitteeVetoProposal(ctx sdk.Context, k Keeper, vetoProposal CommitteeVetoProposal) error {
+	if err := vetoProposal.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+
+	return k.VetoQueuedProposal(ctx, vetoProposal.ProposalID)
+}
+
+func handlePauseProposal(ctx sdk.Context, k Keeper, pauseProposal PauseProposal) error {
+	if err := pauseProposal.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+
+	k.ApplyPauses(ctx, pauseProposal.Pauses)
 	return nil
 }
@@ -67,6 +67,9 @@ func (suite *ProposalHandlerTestSuite) SetupTest() {
 		[]committee.Vote{
 			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.Yes},
 		},
+		committee.QueuedProposals{},
+		committee.VoteDelegations{},
+		committee.Pauses{},
 	)
 }
 
@@ -37,6 +37,24 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		proposalIDB := types.Uint64FromBytes(kvB.Value)
 		return fmt.Sprintf("%d\n%d", proposalIDA, proposalIDB)
 
+	case bytes.Equal(kvA.Key[:1], types.QueuedProposalKeyPrefix):
+		var queuedProposalA, queuedProposalB types.QueuedProposal
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &queuedProposalA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &queuedProposalB)
+		return fmt.Sprintf("%v\n%v", queuedProposalA, queuedProposalB)
+
+	case bytes.Equal(kvA.Key[:1], types.VoteDelegationKeyPrefix):
+		var delegationA, delegationB types.VoteDelegation
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &delegationA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &delegationB)
+		return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+
+	case bytes.Equal(kvA.Key[:1], types.PauseKeyPrefix):
+		var pauseA, pauseB types.Pause
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pauseA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &pauseB)
+		return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -60,6 +60,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		committees,
 		[]types.Proposal{},
 		[]types.Vote{},
+		types.QueuedProposals{},
+		types.VoteDelegations{},
+		types.Pauses{},
 	)
 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, genesisState))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
@@ -6,6 +6,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	bep3types "github.com/kava-labs/kava/x/bep3/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 )
 
 // ModuleCdc is a generic codec to be used throughout module
@@ -24,6 +27,8 @@ func init() {
 	RegisterProposalTypeCodec(govtypes.TextProposal{}, "cosmos-sdk/TextProposal")
 	RegisterProposalTypeCodec(upgrade.SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
 	RegisterProposalTypeCodec(upgrade.CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+	RegisterProposalTypeCodec(hardtypes.ReserveWithdrawalProposal{}, "kava/HardReserveWithdrawalProposal")
+	RegisterProposalTypeCodec(bep3types.DeputyBondSlashProposal{}, "kava/Bep3DeputyBondSlashProposal")
 }
 
 // RegisterCodec registers the necessary types for the module
@@ -33,6 +38,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(CommitteeVetoProposal{}, "kava/CommitteeVetoProposal", nil)
+	cdc.RegisterConcrete(PauseProposal{}, "kava/PauseProposal", nil)
 
 	// Committees
 	cdc.RegisterInterface((*Committee)(nil), nil)
@@ -47,10 +54,18 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(TextPermission{}, "kava/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(SubParamChangePermission{}, "kava/SubParamChangePermission", nil)
+	cdc.RegisterConcrete(BoundedParamChangePermission{}, "kava/BoundedParamChangePermission", nil)
+	cdc.RegisterConcrete(PausePermission{}, "kava/PausePermission", nil)
+	cdc.RegisterConcrete(HardReserveWithdrawalPermission{}, "kava/HardReserveWithdrawalPermission", nil)
+	cdc.RegisterConcrete(Bep3DeputyBondSlashPermission{}, "kava/Bep3DeputyBondSlashPermission", nil)
 
 	// Msgs
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "kava/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgVote{}, "kava/MsgVote", nil)
+	cdc.RegisterConcrete(MsgWithdrawProposal{}, "kava/MsgWithdrawProposal", nil)
+	cdc.RegisterConcrete(MsgAmendProposal{}, "kava/MsgAmendProposal", nil)
+	cdc.RegisterConcrete(MsgDelegateVote{}, "kava/MsgDelegateVote", nil)
+	cdc.RegisterConcrete(MsgRevokeVoteDelegation{}, "kava/MsgRevokeVoteDelegation", nil)
 }
 
 // RegisterPermissionTypeCodec allows external modules to register their own permission types on
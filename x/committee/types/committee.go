@@ -26,7 +26,7 @@ const (
 const (
 	BaseCommitteeType   = "kava/BaseCommittee"
 	MemberCommitteeType = "kava/MemberCommittee" // Committee is composed of member addresses that vote to enact proposals within their permissions
-	TokenCommitteeType  = "kava/TokenCommittee"  // Committee is composed of token holders with voting power determined by total token balance
+	TokenCommitteeType  = "kava/TokenCommittee"  // Committee is composed of token holders with voting power determined by liquid, staked, deposited and pooled tokens
 	BondDenom           = "ukava"
 )
 
@@ -130,6 +130,8 @@ type Committee interface {
 	GetMembers() []sdk.AccAddress
 	SetMembers([]sdk.AccAddress) BaseCommittee
 	HasMember(addr sdk.AccAddress) bool
+	GetMemberWeight(addr sdk.AccAddress) sdk.Dec
+	GetTotalMemberWeight() sdk.Dec
 
 	GetPermissions() []Permission
 	SetPermissions([]Permission) Committee
@@ -142,6 +144,11 @@ type Committee interface {
 	SetVoteThreshold(sdk.Dec) BaseCommittee
 
 	GetTallyOption() TallyOption
+
+	GetEnactmentDelay() time.Duration
+	GetVetoCommitteeIDs() []uint64
+	HasVetoCommittee(committeeID uint64) bool
+
 	Validate() error
 }
 
@@ -157,6 +164,23 @@ type BaseCommittee struct {
 	VoteThreshold    sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`       // Smallest percentage that must vote for a proposal to pass
 	ProposalDuration time.Duration    `json:"proposal_duration" yaml:"proposal_duration"` // The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	TallyOption      TallyOption      `json:"tally_option" yaml:"tally_option"`
+	EnactmentDelay   time.Duration    `json:"enactment_delay" yaml:"enactment_delay"`         // The length of time passed proposals are queued for before being enacted. Zero enacts proposals as soon as they pass.
+	VetoCommitteeIDs []uint64         `json:"veto_committee_ids" yaml:"veto_committee_ids"`   // Committees that can veto queued proposals during the enactment delay
+	MemberWeights    []MemberWeight   `json:"member_weights,omitempty" yaml:"member_weights"` // Votes per member for member committees. Members without a weight have one vote.
+}
+
+// MemberWeight is the number of votes a member committee member casts
+type MemberWeight struct {
+	Member sdk.AccAddress `json:"member" yaml:"member"`
+	Weight uint64         `json:"weight" yaml:"weight"`
+}
+
+// NewMemberWeight returns a new MemberWeight
+func NewMemberWeight(member sdk.AccAddress, weight uint64) MemberWeight {
+	return MemberWeight{
+		Member: member,
+		Weight: weight,
+	}
 }
 
 // GetType is a getter for committee type
@@ -187,6 +211,28 @@ func (c BaseCommittee) HasMember(addr sdk.AccAddress) bool {
 	return false
 }
 
+// GetMemberWeight returns the number of votes a member casts, zero for non members
+func (c BaseCommittee) GetMemberWeight(addr sdk.AccAddress) sdk.Dec {
+	if !c.HasMember(addr) {
+		return sdk.ZeroDec()
+	}
+	for _, mw := range c.MemberWeights {
+		if mw.Member.Equals(addr) {
+			return sdk.NewDecFromInt(sdk.NewIntFromUint64(mw.Weight))
+		}
+	}
+	return sdk.OneDec()
+}
+
+// GetTotalMemberWeight returns the number of votes all members can cast
+func (c BaseCommittee) GetTotalMemberWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, m := range c.Members {
+		total = total.Add(c.GetMemberWeight(m))
+	}
+	return total
+}
+
 // GetPermissions is a getter for committee permissions
 func (c BaseCommittee) GetPermissions() []Permission { return c.Permissions }
 
@@ -228,6 +274,22 @@ func (c BaseCommittee) SetProposalDuration(proposalDuration time.Duration) BaseC
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
+// GetEnactmentDelay is a getter for committee EnactmentDelay
+func (c BaseCommittee) GetEnactmentDelay() time.Duration { return c.EnactmentDelay }
+
+// GetVetoCommitteeIDs is a getter for committee VetoCommitteeIDs
+func (c BaseCommittee) GetVetoCommitteeIDs() []uint64 { return c.VetoCommitteeIDs }
+
+// HasVetoCommittee returns if a committee can be vetoed by the committee with a given ID
+func (c BaseCommittee) HasVetoCommittee(committeeID uint64) bool {
+	for _, id := range c.VetoCommitteeIDs {
+		if id == committeeID {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate validates BaseCommittee fields
 func (c BaseCommittee) Validate() error {
 	if len(c.Description) > MaxCommitteeDescriptionLength {
@@ -255,6 +317,11 @@ func (c BaseCommittee) Validate() error {
 		if p == nil {
 			return fmt.Errorf("committee cannot have a nil permission")
 		}
+		if bp, ok := p.(BoundedParamChangePermission); ok {
+			if err := bp.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	if c.ProposalDuration < 0 {
@@ -270,6 +337,39 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid tally option: %d", c.TallyOption)
 	}
 
+	if c.EnactmentDelay < 0 {
+		return fmt.Errorf("invalid enactment delay: %s", c.EnactmentDelay)
+	}
+
+	if len(c.VetoCommitteeIDs) > 0 && c.EnactmentDelay == 0 {
+		return fmt.Errorf("veto committees require a non zero enactment delay")
+	}
+
+	vetoMap := make(map[uint64]bool, len(c.VetoCommitteeIDs))
+	for _, id := range c.VetoCommitteeIDs {
+		if id == c.ID {
+			return fmt.Errorf("committee cannot be its own veto committee")
+		}
+		if vetoMap[id] {
+			return fmt.Errorf("committee cannot have duplicate veto committees, %d", id)
+		}
+		vetoMap[id] = true
+	}
+
+	weightMap := make(map[string]bool, len(c.MemberWeights))
+	for _, mw := range c.MemberWeights {
+		if !c.HasMember(mw.Member) {
+			return fmt.Errorf("committee cannot have weights for non members, %s", mw.Member)
+		}
+		if weightMap[mw.Member.String()] {
+			return fmt.Errorf("committee cannot have duplicate member weights, %s", mw.Member)
+		}
+		if mw.Weight == 0 {
+			return fmt.Errorf("member weight must be positive, %s", mw.Member)
+		}
+		weightMap[mw.Member.String()] = true
+	}
+
 	return nil
 }
 
@@ -281,10 +381,14 @@ func (c BaseCommittee) String() string {
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
+	TallyOption:   						%s
+	EnactmentDelay:        						%s
+	VetoCommitteeIDs:        						%v
+	MemberWeights:        						%v`,
 		c.ID, c.Description, c.GetMembers(), c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.EnactmentDelay.String(),
+		c.VetoCommitteeIDs, c.MemberWeights,
 	)
 }
 
@@ -378,6 +482,10 @@ func (c TokenCommittee) Validate() error {
 		return fmt.Errorf("invalid quorum: %s", c.Quorum)
 	}
 
+	if len(c.MemberWeights) > 0 {
+		return fmt.Errorf("token committees cannot have member weights")
+	}
+
 	return c.BaseCommittee.Validate()
 }
 
@@ -390,11 +498,14 @@ func (c TokenCommittee) String() string {
   VoteThreshold:            		  %s
   ProposalDuration:        						%s
   TallyOption:   						%d
+  EnactmentDelay:        						%s
+  VetoCommitteeIDs:        						%v
   Quorum:               %s
   TallyDenom:               %s`,
 		c.ID, c.GetType(), c.Description, c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption, c.Quorum, c.TallyDenom,
+		c.TallyOption, c.EnactmentDelay.String(), c.VetoCommitteeIDs,
+		c.Quorum, c.TallyDenom,
 	)
 }
 
@@ -409,10 +520,13 @@ type PubProposal govtypes.Content
 
 // Proposal is an internal record of a governance proposal submitted to a committee.
 type Proposal struct {
-	PubProposal `json:"pub_proposal" yaml:"pub_proposal"`
-	ID          uint64    `json:"id" yaml:"id"`
-	CommitteeID uint64    `json:"committee_id" yaml:"committee_id"`
-	Deadline    time.Time `json:"deadline" yaml:"deadline"`
+	PubProposal        `json:"pub_proposal" yaml:"pub_proposal"`
+	ID                 uint64           `json:"id" yaml:"id"`
+	CommitteeID        uint64           `json:"committee_id" yaml:"committee_id"`
+	Deadline           time.Time        `json:"deadline" yaml:"deadline"`
+	Proposer           sdk.AccAddress   `json:"proposer,omitempty" yaml:"proposer"`                       // empty for proposals submitted before proposers were recorded
+	WithdrawalRequests []sdk.AccAddress `json:"withdrawal_requests,omitempty" yaml:"withdrawal_requests"` // committee members that have asked for the proposal to be withdrawn
+	PossibleVotes      *sdk.Int         `json:"possible_votes,omitempty" yaml:"possible_votes"`           // token committee voting power snapshotted at submission, nil for member committees
 }
 
 func NewProposal(pubProposal PubProposal, id uint64, committeeID uint64, deadline time.Time) Proposal {
@@ -424,6 +538,26 @@ func NewProposal(pubProposal PubProposal, id uint64, committeeID uint64, deadlin
 	}
 }
 
+// IsProposer returns true if the address submitted the proposal
+func (p Proposal) IsProposer(addr sdk.AccAddress) bool {
+	return !p.Proposer.Empty() && p.Proposer.Equals(addr)
+}
+
+// HasRequestedWithdrawal returns true if the address has asked for the proposal to be withdrawn
+func (p Proposal) HasRequestedWithdrawal(addr sdk.AccAddress) bool {
+	for _, requester := range p.WithdrawalRequests {
+		if requester.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPossibleVotesSnapshot returns true if the total voting power was recorded when the proposal was submitted
+func (p Proposal) HasPossibleVotesSnapshot() bool {
+	return p.PossibleVotes != nil
+}
+
 // HasExpiredBy calculates if the proposal will have expired by a certain time.
 // All votes must be cast before deadline, those cast at time == deadline are not valid
 func (p Proposal) HasExpiredBy(time time.Time) bool {
@@ -436,6 +570,48 @@ func (p Proposal) String() string {
 	return string(bz)
 }
 
+// QueuedProposal is a passed proposal waiting for its committee's enactment delay to elapse.
+type QueuedProposal struct {
+	Proposal      Proposal  `json:"proposal" yaml:"proposal"`
+	EnactmentTime time.Time `json:"enactment_time" yaml:"enactment_time"`
+}
+
+// NewQueuedProposal returns a new QueuedProposal
+func NewQueuedProposal(proposal Proposal, enactmentTime time.Time) QueuedProposal {
+	return QueuedProposal{
+		Proposal:      proposal,
+		EnactmentTime: enactmentTime,
+	}
+}
+
+// IsDueBy returns true if the queued proposal can be enacted by a certain time
+func (qp QueuedProposal) IsDueBy(time time.Time) bool {
+	return !time.Before(qp.EnactmentTime)
+}
+
+// Validate performs basic validation of a queued proposal
+func (qp QueuedProposal) Validate() error {
+	if qp.Proposal.PubProposal == nil {
+		return fmt.Errorf("queued proposal %d has nil pub proposal", qp.Proposal.ID)
+	}
+	if err := qp.Proposal.PubProposal.ValidateBasic(); err != nil {
+		return fmt.Errorf("queued proposal %d invalid: %w", qp.Proposal.ID, err)
+	}
+	if qp.EnactmentTime.IsZero() {
+		return fmt.Errorf("queued proposal %d has zero enactment time", qp.Proposal.ID)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (qp QueuedProposal) String() string {
+	bz, _ := yaml.Marshal(qp)
+	return string(bz)
+}
+
+// QueuedProposals is a slice of QueuedProposal
+type QueuedProposals []QueuedProposal
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -444,6 +620,7 @@ type Vote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
 	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
+	Weight     *sdk.Int       `json:"weight,omitempty" yaml:"weight"` // token committee voting power snapshotted when the vote was cast
 }
 
 func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
@@ -454,10 +631,75 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
 	}
 }
 
+// HasWeight returns true if the voting power was snapshotted when the vote was cast
+func (v Vote) HasWeight() bool {
+	return v.Weight != nil
+}
+
 func (v Vote) Validate() error {
 	if v.Voter.Empty() {
 		return fmt.Errorf("voter address cannot be empty")
 	}
+	if v.HasWeight() && v.Weight.IsNegative() {
+		return fmt.Errorf("vote weight cannot be negative: %s", v.Weight)
+	}
 
 	return v.VoteType.Validate()
 }
+
+// VoteDelegation lets a member committee member's vote be cast by another member for a time window.
+// The delegated weight is added to the delegatee's vote unless the delegator votes themselves.
+type VoteDelegation struct {
+	CommitteeID uint64         `json:"committee_id" yaml:"committee_id"`
+	Delegator   sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Delegatee   sdk.AccAddress `json:"delegatee" yaml:"delegatee"`
+	StartTime   time.Time      `json:"start_time" yaml:"start_time"`
+	EndTime     time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewVoteDelegation returns a new VoteDelegation
+func NewVoteDelegation(committeeID uint64, delegator, delegatee sdk.AccAddress, startTime, endTime time.Time) VoteDelegation {
+	return VoteDelegation{
+		CommitteeID: committeeID,
+		Delegator:   delegator,
+		Delegatee:   delegatee,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+// IsActiveAt returns true if the delegation applies at a certain time
+func (vd VoteDelegation) IsActiveAt(time time.Time) bool {
+	return !time.Before(vd.StartTime) && time.Before(vd.EndTime)
+}
+
+// HasExpiredBy returns true if the delegation window will have ended by a certain time
+func (vd VoteDelegation) HasExpiredBy(time time.Time) bool {
+	return !time.Before(vd.EndTime)
+}
+
+// Validate performs basic validation of a vote delegation
+func (vd VoteDelegation) Validate() error {
+	if vd.Delegator.Empty() {
+		return fmt.Errorf("delegator address cannot be empty")
+	}
+	if vd.Delegatee.Empty() {
+		return fmt.Errorf("delegatee address cannot be empty")
+	}
+	if vd.Delegator.Equals(vd.Delegatee) {
+		return fmt.Errorf("member cannot delegate their vote to themselves")
+	}
+	if !vd.StartTime.Before(vd.EndTime) {
+		return fmt.Errorf("delegation start time %s must be before end time %s", vd.StartTime, vd.EndTime)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (vd VoteDelegation) String() string {
+	bz, _ := yaml.Marshal(vd)
+	return string(bz)
+}
+
+// VoteDelegations is a slice of VoteDelegation
+type VoteDelegations []VoteDelegation
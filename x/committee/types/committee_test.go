@@ -160,6 +160,135 @@ func TestBaseCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "enactment delay and veto committee",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				EnactmentDelay:   time.Hour * 24,
+				VetoCommitteeIDs: []uint64{2},
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative enactment delay",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				EnactmentDelay:   -time.Hour,
+			},
+			expectPass: false,
+		},
+		{
+			name: "veto committee without enactment delay",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				VetoCommitteeIDs: []uint64{2},
+			},
+			expectPass: false,
+		},
+		{
+			name: "committee is its own veto committee",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				EnactmentDelay:   time.Hour * 24,
+				VetoCommitteeIDs: []uint64{1},
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate veto committee",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				EnactmentDelay:   time.Hour * 24,
+				VetoCommitteeIDs: []uint64{2, 2},
+			},
+			expectPass: false,
+		},
+		{
+			name: "member weights",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				MemberWeights:    []MemberWeight{NewMemberWeight(addresses[0], 5), NewMemberWeight(addresses[1], 2)},
+			},
+			expectPass: true,
+		},
+		{
+			name: "weight for non member",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				MemberWeights:    []MemberWeight{NewMemberWeight(sdk.AccAddress(crypto.AddressHash([]byte("KavaTest4"))), 2)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate member weight",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				MemberWeights:    []MemberWeight{NewMemberWeight(addresses[0], 5), NewMemberWeight(addresses[0], 2)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero member weight",
+			committee: BaseCommittee{
+				ID:               1,
+				Description:      "This base committee is for testing.",
+				Members:          addresses[:3],
+				Permissions:      []Permission{GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				TallyOption:      FirstPastThePost,
+				MemberWeights:    []MemberWeight{NewMemberWeight(addresses[0], 0)},
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -319,6 +448,24 @@ func TestTokenCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "member weights",
+			committee: TokenCommittee{
+				BaseCommittee: BaseCommittee{
+					ID:               1,
+					Description:      "This token committee is for testing.",
+					Members:          addresses[:3],
+					Permissions:      []Permission{GodPermission{}},
+					VoteThreshold:    d("0.667"),
+					ProposalDuration: time.Hour * 24 * 7,
+					TallyOption:      FirstPastThePost,
+					MemberWeights:    []MemberWeight{NewMemberWeight(addresses[0], 5)},
+				},
+				Quorum:     d("0.4"),
+				TallyDenom: "hard",
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
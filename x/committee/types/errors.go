@@ -15,4 +15,9 @@ var (
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "pubproposal has no corresponding handler")
 	ErrUnknownSubspace         = sdkerrors.Register(ModuleName, 10, "subspace not found")
 	ErrInvalidVoteType         = sdkerrors.Register(ModuleName, 11, "invalid vote type")
+	ErrWithdrawalRequested     = sdkerrors.Register(ModuleName, 12, "withdrawal already requested")
+	ErrUnknownQueuedProposal   = sdkerrors.Register(ModuleName, 13, "queued proposal not found")
+	ErrInvalidVoteDelegation   = sdkerrors.Register(ModuleName, 14, "invalid vote delegation")
+	ErrUnknownVoteDelegation   = sdkerrors.Register(ModuleName, 15, "vote delegation not found")
+	ErrMsgPaused               = sdkerrors.Register(ModuleName, 16, "msg is paused")
 )
@@ -6,6 +6,18 @@ const (
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
 
+	EventTypeProposalWithdraw = "proposal_withdraw"
+	EventTypeProposalAmend    = "proposal_amend"
+	EventTypeProposalQueue    = "proposal_queue"
+	EventTypeProposalEnact    = "proposal_enact"
+	EventTypeProposalVeto     = "proposal_veto"
+
+	EventTypeVoteDelegate         = "vote_delegate"
+	EventTypeVoteDelegationRevoke = "vote_delegation_revoke"
+
+	EventTypeMsgPause   = "msg_pause"
+	EventTypeMsgUnpause = "msg_unpause"
+
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
@@ -13,6 +25,18 @@ const (
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
 	AttributeKeyVote                = "vote"
+	AttributeKeyVoteWeight          = "vote_weight"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyWithdrawer          = "withdrawer"
+	AttributeKeyWithdrawalRequests  = "withdrawal_requests"
+	AttributeKeyAmendedProposalID   = "amended_proposal_id"
+	AttributeKeyEnactmentTime       = "enactment_time"
+	AttributeKeyDelegator           = "delegator"
+	AttributeKeyDelegatee           = "delegatee"
+	AttributeKeyStartTime           = "start_time"
+	AttributeKeyEndTime             = "end_time"
+	AttributeKeyRoute               = "route"
+	AttributeKeyMsgType             = "msg_type"
+	AttributeKeyScope               = "scope"
 )
@@ -5,6 +5,9 @@ import (
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 type ParamKeeper interface {
@@ -20,3 +23,21 @@ type AccountKeeper interface {
 type SupplyKeeper interface {
 	GetSupply(ctx sdk.Context) (supply supplyexported.SupplyI)
 }
+
+// HardKeeper defines the expected hard keeper (noalias)
+type HardKeeper interface {
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetSyncedBorrow(ctx sdk.Context, borrower sdk.AccAddress) (hardtypes.Borrow, bool)
+}
+
+// SwapKeeper defines the expected swap keeper (noalias)
+type SwapKeeper interface {
+	GetAllDepositorSharesByOwner(ctx sdk.Context, owner sdk.AccAddress) swaptypes.ShareRecords
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+}
+
+// VotingPowerSource counts tokens an address holds outside of its liquid balance towards its token committee voting power.
+// Sources can return negative amounts for tokens the address owes, such as borrows held in its liquid balance.
+type VotingPowerSource interface {
+	GetVotingPower(ctx sdk.Context, voter sdk.AccAddress, denom string) sdk.Int
+}
@@ -10,19 +10,25 @@ const DefaultNextProposalID uint64 = 1
 
 // GenesisState is state that must be provided at chain genesis.
 type GenesisState struct {
-	NextProposalID uint64     `json:"next_proposal_id" yaml:"next_proposal_id"`
-	Committees     Committees `json:"committees" yaml:"committees"`
-	Proposals      []Proposal `json:"proposals" yaml:"proposals"`
-	Votes          []Vote     `json:"votes" yaml:"votes"`
+	NextProposalID  uint64          `json:"next_proposal_id" yaml:"next_proposal_id"`
+	Committees      Committees      `json:"committees" yaml:"committees"`
+	Proposals       []Proposal      `json:"proposals" yaml:"proposals"`
+	Votes           []Vote          `json:"votes" yaml:"votes"`
+	QueuedProposals QueuedProposals `json:"queued_proposals" yaml:"queued_proposals"`
+	VoteDelegations VoteDelegations `json:"vote_delegations" yaml:"vote_delegations"`
+	Pauses          Pauses          `json:"pauses" yaml:"pauses"`
 }
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(nextProposalID uint64, committees Committees, proposals []Proposal, votes []Vote) GenesisState {
+func NewGenesisState(nextProposalID uint64, committees Committees, proposals []Proposal, votes []Vote, queuedProposals QueuedProposals, voteDelegations VoteDelegations, pauses Pauses) GenesisState {
 	return GenesisState{
-		NextProposalID: nextProposalID,
-		Committees:     committees,
-		Proposals:      proposals,
-		Votes:          votes,
+		NextProposalID:  nextProposalID,
+		Committees:      committees,
+		Proposals:       proposals,
+		Votes:           votes,
+		QueuedProposals: queuedProposals,
+		VoteDelegations: voteDelegations,
+		Pauses:          pauses,
 	}
 }
 
@@ -33,6 +39,9 @@ func DefaultGenesisState() GenesisState {
 		Committees{},
 		[]Proposal{},
 		[]Vote{},
+		QueuedProposals{},
+		VoteDelegations{},
+		Pauses{},
 	)
 }
 
@@ -52,12 +61,16 @@ func (data GenesisState) IsEmpty() bool {
 func (gs GenesisState) Validate() error {
 	// validate committees
 	committeeMap := make(map[uint64]bool, len(gs.Committees))
+	memberCommittees := make(map[uint64]MemberCommittee)
 	for _, com := range gs.Committees {
 		// check there are no duplicate IDs
 		if _, ok := committeeMap[com.GetID()]; ok {
 			return fmt.Errorf("duplicate committee ID found in genesis state; id: %d", com.GetID())
 		}
 		committeeMap[com.GetID()] = true
+		if memberCom, ok := com.(MemberCommittee); ok {
+			memberCommittees[com.GetID()] = memberCom
+		}
 
 		// validate committee
 		if err := com.Validate(); err != nil {
@@ -88,6 +101,35 @@ func (gs GenesisState) Validate() error {
 		if err := p.PubProposal.ValidateBasic(); err != nil {
 			return fmt.Errorf("proposal %d invalid: %w", p.ID, err)
 		}
+
+		// validate possible votes snapshot
+		if p.HasPossibleVotesSnapshot() && p.PossibleVotes.IsNegative() {
+			return fmt.Errorf("proposal %d has negative possible votes: %s", p.ID, p.PossibleVotes)
+		}
+	}
+
+	// validate queued proposals
+	queuedMap := make(map[uint64]bool, len(gs.QueuedProposals))
+	for _, qp := range gs.QueuedProposals {
+		// check there are no duplicate IDs, including open proposals
+		if proposalMap[qp.Proposal.ID] || queuedMap[qp.Proposal.ID] {
+			return fmt.Errorf("duplicate proposal ID found in genesis state; id: %d", qp.Proposal.ID)
+		}
+		queuedMap[qp.Proposal.ID] = true
+
+		// validate next proposal ID
+		if qp.Proposal.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", qp.Proposal.ID)
+		}
+
+		// check committee exists
+		if !committeeMap[qp.Proposal.CommitteeID] {
+			return fmt.Errorf("queued proposal refers to non existent committee; proposal: %+v", qp.Proposal)
+		}
+
+		if err := qp.Validate(); err != nil {
+			return err
+		}
 	}
 
 	// validate votes
@@ -102,5 +144,34 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("vote refers to non existent proposal; vote: %+v", v)
 		}
 	}
+
+	// validate vote delegations
+	delegationMap := make(map[string]bool, len(gs.VoteDelegations))
+	for _, vd := range gs.VoteDelegations {
+		if err := vd.Validate(); err != nil {
+			return err
+		}
+
+		// check there is one delegation per member
+		key := fmt.Sprintf("%d:%s", vd.CommitteeID, vd.Delegator)
+		if delegationMap[key] {
+			return fmt.Errorf("duplicate vote delegation found in genesis state; committee: %d, delegator: %s", vd.CommitteeID, vd.Delegator)
+		}
+		delegationMap[key] = true
+
+		// check delegator and delegatee are members of a member committee
+		com, ok := memberCommittees[vd.CommitteeID]
+		if !ok {
+			return fmt.Errorf("vote delegation refers to non existent member committee; delegation: %+v", vd)
+		}
+		if !com.HasMember(vd.Delegator) || !com.HasMember(vd.Delegatee) {
+			return fmt.Errorf("vote delegation refers to non committee members; delegation: %+v", vd)
+		}
+	}
+
+	// validate pauses
+	if err := gs.Pauses.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
@@ -171,6 +171,53 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "vote delegation",
+			genState: GenesisState{
+				NextProposalID:  testGenesis.NextProposalID,
+				Committees:      testGenesis.Committees,
+				Proposals:       testGenesis.Proposals,
+				Votes:           testGenesis.Votes,
+				VoteDelegations: VoteDelegations{NewVoteDelegation(1, addresses[0], addresses[1], testTime, testTime.Add(time.Hour))},
+			},
+			expectPass: true,
+		},
+		{
+			name: "duplicate vote delegation",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				VoteDelegations: VoteDelegations{
+					NewVoteDelegation(1, addresses[0], addresses[1], testTime, testTime.Add(time.Hour)),
+					NewVoteDelegation(1, addresses[0], addresses[2], testTime, testTime.Add(time.Hour)),
+				},
+			},
+			expectPass: false,
+		},
+		{
+			name: "vote delegation in token committee",
+			genState: GenesisState{
+				NextProposalID:  testGenesis.NextProposalID,
+				Committees:      testGenesis.Committees,
+				Proposals:       testGenesis.Proposals,
+				Votes:           testGenesis.Votes,
+				VoteDelegations: VoteDelegations{NewVoteDelegation(3, addresses[0], addresses[1], testTime, testTime.Add(time.Hour))},
+			},
+			expectPass: false,
+		},
+		{
+			name: "vote delegation to non member",
+			genState: GenesisState{
+				NextProposalID:  testGenesis.NextProposalID,
+				Committees:      testGenesis.Committees,
+				Proposals:       testGenesis.Proposals,
+				Votes:           testGenesis.Votes,
+				VoteDelegations: VoteDelegations{NewVoteDelegation(1, addresses[0], addresses[4], testTime, testTime.Add(time.Hour))},
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,6 +31,10 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	QueuedProposalKeyPrefix = []byte{0x04} // prefix for keys that store passed proposals waiting to be enacted
+	VoteDelegationKeyPrefix = []byte{0x05} // prefix for keys that store member vote delegations
+	PauseKeyPrefix          = []byte{0x06} // prefix for keys that store msg pauses
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -41,6 +46,21 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetVoteDelegationKey returns the key of a member's vote delegation in a committee
+func GetVoteDelegationKey(committeeID uint64, delegator sdk.AccAddress) []byte {
+	return append(GetKeyFromID(committeeID), delegator.Bytes()...)
+}
+
+// GetPauseKey returns the key of a pause of a msg type, with an optional scope
+func GetPauseKey(route, msgType, scope string) []byte {
+	return append(GetPauseMsgTypePrefix(route, msgType), []byte(scope)...)
+}
+
+// GetPauseMsgTypePrefix returns the key prefix of all pauses of a msg type
+func GetPauseMsgTypePrefix(route, msgType string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", route, msgType))
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
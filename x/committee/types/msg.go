@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,11 +12,15 @@ import (
 )
 
 const (
-	TypeMsgSubmitProposal = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
-	TypeMsgVote           = "committee_vote"
+	TypeMsgSubmitProposal       = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
+	TypeMsgVote                 = "committee_vote"
+	TypeMsgWithdrawProposal     = "committee_withdraw_proposal"
+	TypeMsgAmendProposal        = "committee_amend_proposal"
+	TypeMsgDelegateVote         = "committee_delegate_vote"
+	TypeMsgRevokeVoteDelegation = "committee_revoke_vote_delegation"
 )
 
-var _, _ sdk.Msg = MsgSubmitProposal{}, MsgVote{}
+var _, _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgVote{}, MsgWithdrawProposal{}, MsgAmendProposal{}, MsgDelegateVote{}, MsgRevokeVoteDelegation{}
 
 // MsgSubmitProposal is used by committee members to create a new proposal that they can vote on.
 type MsgSubmitProposal struct {
@@ -202,3 +207,178 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgWithdrawProposal is submitted by a proposal's proposer to withdraw it, or by other committee members to request its withdrawal.
+type MsgWithdrawProposal struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Withdrawer sdk.AccAddress `json:"withdrawer" yaml:"withdrawer"`
+}
+
+// NewMsgWithdrawProposal creates a message to withdraw an active proposal
+func NewMsgWithdrawProposal(withdrawer sdk.AccAddress, proposalID uint64) MsgWithdrawProposal {
+	return MsgWithdrawProposal{
+		ProposalID: proposalID,
+		Withdrawer: withdrawer,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawProposal) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgWithdrawProposal) Type() string { return TypeMsgWithdrawProposal }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawProposal) ValidateBasic() error {
+	if msg.Withdrawer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "withdrawer address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Withdrawer}
+}
+
+// MsgAmendProposal is submitted by a proposal's proposer to replace it with an amended proposal, resetting all votes.
+type MsgAmendProposal struct {
+	ProposalID  uint64         `json:"proposal_id" yaml:"proposal_id"`
+	PubProposal PubProposal    `json:"pub_proposal" yaml:"pub_proposal"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
+
+// NewMsgAmendProposal creates a message to supersede an active proposal with an amended one
+func NewMsgAmendProposal(pubProposal PubProposal, proposer sdk.AccAddress, proposalID uint64) MsgAmendProposal {
+	return MsgAmendProposal{
+		ProposalID:  proposalID,
+		PubProposal: pubProposal,
+		Proposer:    proposer,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgAmendProposal) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgAmendProposal) Type() string { return TypeMsgAmendProposal }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgAmendProposal) ValidateBasic() error {
+	if msg.PubProposal == nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, "pub proposal cannot be nil")
+	}
+	if msg.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "proposer address cannot be empty")
+	}
+
+	return msg.PubProposal.ValidateBasic()
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgAmendProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgAmendProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgDelegateVote is submitted by a member committee member to have another member vote on their behalf for a time window.
+type MsgDelegateVote struct {
+	CommitteeID uint64         `json:"committee_id" yaml:"committee_id"`
+	Delegator   sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	Delegatee   sdk.AccAddress `json:"delegatee" yaml:"delegatee"`
+	StartTime   time.Time      `json:"start_time" yaml:"start_time"`
+	EndTime     time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewMsgDelegateVote creates a message to delegate a member's vote to another member
+func NewMsgDelegateVote(committeeID uint64, delegator, delegatee sdk.AccAddress, startTime, endTime time.Time) MsgDelegateVote {
+	return MsgDelegateVote{
+		CommitteeID: committeeID,
+		Delegator:   delegator,
+		Delegatee:   delegatee,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDelegateVote) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgDelegateVote) Type() string { return TypeMsgDelegateVote }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDelegateVote) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "delegator address cannot be empty")
+	}
+	if msg.Delegatee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "delegatee address cannot be empty")
+	}
+	delegation := NewVoteDelegation(msg.CommitteeID, msg.Delegator, msg.Delegatee, msg.StartTime, msg.EndTime)
+	if err := delegation.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVoteDelegation, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgRevokeVoteDelegation is submitted by a member committee member to end their vote delegation early.
+type MsgRevokeVoteDelegation struct {
+	CommitteeID uint64         `json:"committee_id" yaml:"committee_id"`
+	Delegator   sdk.AccAddress `json:"delegator" yaml:"delegator"`
+}
+
+// NewMsgRevokeVoteDelegation creates a message to revoke a member's vote delegation
+func NewMsgRevokeVoteDelegation(committeeID uint64, delegator sdk.AccAddress) MsgRevokeVoteDelegation {
+	return MsgRevokeVoteDelegation{
+		CommitteeID: committeeID,
+		Delegator:   delegator,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeVoteDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgRevokeVoteDelegation) Type() string { return TypeMsgRevokeVoteDelegation }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevokeVoteDelegation) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "delegator address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevokeVoteDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevokeVoteDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
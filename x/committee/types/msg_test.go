@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -99,3 +100,130 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgWithdrawProposal_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	tests := []struct {
+		name       string
+		msg        MsgWithdrawProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			msg:        MsgWithdrawProposal{5, addr},
+			expectPass: true,
+		},
+		{
+			name:       "empty address",
+			msg:        MsgWithdrawProposal{5, nil},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAmendProposal_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	tests := []struct {
+		name       string
+		msg        MsgAmendProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			msg:        MsgAmendProposal{5, govtypes.NewTextProposal("A Title", "A proposal description."), addr},
+			expectPass: true,
+		},
+		{
+			name:       "empty address",
+			msg:        MsgAmendProposal{5, govtypes.NewTextProposal("A Title", "A proposal description."), nil},
+			expectPass: false,
+		},
+		{
+			name:       "nil proposal",
+			msg:        MsgAmendProposal{5, nil, addr},
+			expectPass: false,
+		},
+		{
+			name:       "invalid proposal",
+			msg:        MsgAmendProposal{5, govtypes.TextProposal{}, addr},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDelegateVote_ValidateBasic(t *testing.T) {
+	delegator := sdk.AccAddress([]byte("someName"))
+	delegatee := sdk.AccAddress([]byte("otherName"))
+	start := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 24 * 7)
+	tests := []struct {
+		name       string
+		msg        MsgDelegateVote
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			msg:        MsgDelegateVote{1, delegator, delegatee, start, end},
+			expectPass: true,
+		},
+		{
+			name:       "empty delegator",
+			msg:        MsgDelegateVote{1, nil, delegatee, start, end},
+			expectPass: false,
+		},
+		{
+			name:       "empty delegatee",
+			msg:        MsgDelegateVote{1, delegator, nil, start, end},
+			expectPass: false,
+		},
+		{
+			name:       "delegate to self",
+			msg:        MsgDelegateVote{1, delegator, delegator, start, end},
+			expectPass: false,
+		},
+		{
+			name:       "end before start",
+			msg:        MsgDelegateVote{1, delegator, delegatee, end, start},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,7 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 	"github.com/tendermint/tendermint/crypto"
 )
 
@@ -616,6 +619,25 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 	newCoinidAndLimitAP.CoinID = 0
 	newCoinidAndLimitAP.SupplyLimit.Limit = i(1000)
 
+	newAdditionalDeputiesAP := testAP
+	newAdditionalDeputiesAP.AdditionalDeputies = []sdk.AccAddress{sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser2")))}
+
+	newFeeScheduleAP := testAP
+	newFeeScheduleAP.FeeSchedule = bep3types.FeeSchedule{
+		bep3types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(1000), sdk.MustNewDecFromStr("0.01"), sdk.NewInt(1000), sdk.NewInt(100000)),
+	}
+
+	newMinDeputyBondAP := testAP
+	newMinDeputyBondAP.MinDeputyBond = sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+
+	newMinDeputyBondDenomAP := testAP
+	newMinDeputyBondDenomAP.MinDeputyBond = sdk.NewCoins(sdk.NewInt64Coin("hard", 1000))
+
+	newRetiringDeputiesAP := testAP
+	newRetiringDeputiesAP.RetiringDeputies = bep3types.RetiringDeputies{
+		bep3types.NewRetiringDeputy(sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser3"))), time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedAssetParam
@@ -663,6 +685,86 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 			incoming:      newCoinidAndLimitAP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed deputies change",
+			allowed: AllowedAssetParam{
+				Denom:    "usdx",
+				Deputies: true,
+			},
+			current:       testAP,
+			incoming:      newAdditionalDeputiesAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed additional deputies change",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+				Limit: true,
+			},
+			current:       testAP,
+			incoming:      newAdditionalDeputiesAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed fee schedule change",
+			allowed: AllowedAssetParam{
+				Denom:       "usdx",
+				FeeSchedule: true,
+			},
+			current:       testAP,
+			incoming:      newFeeScheduleAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed fee schedule change",
+			allowed: AllowedAssetParam{
+				Denom:  "usdx",
+				Active: true,
+			},
+			current:       testAP,
+			incoming:      newFeeScheduleAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed min deputy bond change",
+			allowed: AllowedAssetParam{
+				Denom:         "usdx",
+				MinDeputyBond: true,
+			},
+			current:       newMinDeputyBondAP,
+			incoming:      testAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed min deputy bond change",
+			allowed: AllowedAssetParam{
+				Denom:  "usdx",
+				Active: true,
+			},
+			current:       newMinDeputyBondAP,
+			incoming:      testAP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed min deputy bond denom change",
+			allowed: AllowedAssetParam{
+				Denom:  "usdx",
+				Active: true,
+			},
+			current:       newMinDeputyBondAP,
+			incoming:      newMinDeputyBondDenomAP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed retiring deputies change",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+				Limit: true,
+			},
+			current:       testAP,
+			incoming:      newRetiringDeputiesAP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -703,6 +805,12 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 	newOraclesAndActiveM.Oracles = nil
 	newOraclesAndActiveM.Active = false
 
+	newCommitRevealM := testM
+	newCommitRevealM.CommitRevealPeriod = time.Minute
+
+	newDerivationM := testM
+	newDerivationM.Derivation = pricefeedtypes.NewMarketDerivation(pricefeedtypes.DerivationTypeInverse, []string{"usd:bnb"}, 0)
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedMarket
@@ -750,6 +858,36 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 			incoming:      newOraclesAndActiveM,
 			expectAllowed: false,
 		},
+		{
+			name: "un-allowed commit reveal change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newCommitRevealM,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed derivation change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newDerivationM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed derivation change",
+			allowed: AllowedMarket{
+				MarketID:   "bnb:usd",
+				Derivation: true,
+			},
+			current:       testM,
+			incoming:      newDerivationM,
+			expectAllowed: true,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -771,3 +909,473 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 		})
 	}
 }
+
+func (suite *PermissionsTestSuite) TestAllowedSwapPools_Allows() {
+	testPools := swaptypes.AllowedPools{
+		swaptypes.NewAllowedPool("hard", "ukava"),
+		swaptypes.NewAllowedPool("ukava", "usdx"),
+		swaptypes.NewAllowedPool("bnb", "usdx"),
+	}
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedSwapPools
+		current       swaptypes.AllowedPools
+		incoming      swaptypes.AllowedPools
+		expectAllowed bool
+	}{
+		{
+			name:          "allowed unchanged",
+			allowed:       nil,
+			current:       testPools,
+			incoming:      testPools,
+			expectAllowed: true,
+		},
+		{
+			name:          "allowed reorder",
+			allowed:       nil,
+			current:       testPools[:2],
+			incoming:      swaptypes.AllowedPools{testPools[1], testPools[0]},
+			expectAllowed: true,
+		},
+		{
+			name:          "allowed add",
+			allowed:       AllowedSwapPools{NewAllowedSwapPool("bnb", "usdx")},
+			current:       testPools[:2],
+			incoming:      testPools,
+			expectAllowed: true,
+		},
+		{
+			name:          "disallowed add",
+			allowed:       AllowedSwapPools{NewAllowedSwapPool("hard", "ukava")},
+			current:       testPools[:2],
+			incoming:      testPools,
+			expectAllowed: false,
+		},
+		{
+			name:          "allowed remove",
+			allowed:       AllowedSwapPools{NewAllowedSwapPool("hard", "ukava")},
+			current:       testPools[:2],
+			incoming:      testPools[1:2],
+			expectAllowed: true,
+		},
+		{
+			name:          "disallowed remove",
+			allowed:       AllowedSwapPools{NewAllowedSwapPool("bnb", "usdx")},
+			current:       testPools[:2],
+			incoming:      testPools[1:2],
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedRewardPeriods_Allows() {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	testRPs := incentivetypes.MultiRewardPeriods{
+		incentivetypes.NewMultiRewardPeriod(true, "bnb", start, start.Add(time.Hour), cs(c("hard", 100))),
+		incentivetypes.NewMultiRewardPeriod(true, "btcb", start, start.Add(time.Hour), cs(c("hard", 200))),
+	}
+	updatedTestRPs := make(incentivetypes.MultiRewardPeriods, len(testRPs))
+	updatedTestRPs[0] = testRPs[1]
+	updatedTestRPs[1] = testRPs[0]
+	updatedTestRPs[0].RewardsPerSecond = cs(c("hard", 300)) // btcb
+	updatedTestRPs[1].End = start.Add(2 * time.Hour)        // bnb
+
+	hardSupply := string(incentivetypes.KeyHardSupplyRewardPeriods)
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedRewardPeriods
+		rewardType    string
+		current       incentivetypes.MultiRewardPeriods
+		incoming      incentivetypes.MultiRewardPeriods
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change with different order",
+			allowed: AllowedRewardPeriods{
+				NewAllowedRewardPeriod(hardSupply, "bnb", false, false, true, false),
+				NewAllowedRewardPeriod(hardSupply, "btcb", false, false, false, true),
+			},
+			rewardType:    hardSupply,
+			current:       testRPs,
+			incoming:      updatedTestRPs,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedRewardPeriods{
+				NewAllowedRewardPeriod(hardSupply, "bnb", false, false, true, false),
+				NewAllowedRewardPeriod(hardSupply, "btcb", true, true, true, false),
+			},
+			rewardType:    hardSupply,
+			current:       testRPs,
+			incoming:      updatedTestRPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed change to other reward type",
+			allowed: AllowedRewardPeriods{
+				NewAllowedRewardPeriod(hardSupply, "bnb", true, true, true, true),
+				NewAllowedRewardPeriod(hardSupply, "btcb", true, true, true, true),
+			},
+			rewardType:    string(incentivetypes.KeyHardBorrowRewardPeriods),
+			current:       testRPs,
+			incoming:      updatedTestRPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed add",
+			allowed: AllowedRewardPeriods{
+				NewAllowedRewardPeriod(hardSupply, "bnb", true, true, true, true),
+				NewAllowedRewardPeriod(hardSupply, "btcb", true, true, true, true),
+			},
+			rewardType:    hardSupply,
+			current:       testRPs[:1],
+			incoming:      testRPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedRewardPeriods{
+				NewAllowedRewardPeriod(hardSupply, "bnb", true, true, true, true),
+				NewAllowedRewardPeriod(hardSupply, "btcb", true, true, true, true),
+			},
+			rewardType:    hardSupply,
+			current:       testRPs,
+			incoming:      testRPs[:1],
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.rewardType, tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedMultipliers_Allows() {
+	testMPDs := incentivetypes.MultipliersPerDenom{
+		{
+			Denom: "hard",
+			Multipliers: incentivetypes.Multipliers{
+				incentivetypes.NewMultiplier(incentivetypes.Small, 1, d("0.2")),
+				incentivetypes.NewMultiplier(incentivetypes.Large, 12, d("1.0")),
+			},
+		},
+	}
+	updatedFactor := incentivetypes.MultipliersPerDenom{
+		{
+			Denom: "hard",
+			Multipliers: incentivetypes.Multipliers{
+				incentivetypes.NewMultiplier(incentivetypes.Small, 1, d("0.25")),
+				incentivetypes.NewMultiplier(incentivetypes.Large, 12, d("1.0")),
+			},
+		},
+	}
+	addedMultiplier := incentivetypes.MultipliersPerDenom{
+		{
+			Denom: "hard",
+			Multipliers: incentivetypes.Multipliers{
+				incentivetypes.NewMultiplier(incentivetypes.Small, 1, d("0.2")),
+				incentivetypes.NewMultiplier(incentivetypes.Medium, 6, d("0.5")),
+				incentivetypes.NewMultiplier(incentivetypes.Large, 12, d("1.0")),
+			},
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedMultipliers
+		current       incentivetypes.MultipliersPerDenom
+		incoming      incentivetypes.MultipliersPerDenom
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change",
+			allowed: AllowedMultipliers{
+				{Denom: "hard", Name: incentivetypes.Small, Factor: true},
+				{Denom: "hard", Name: incentivetypes.Large},
+			},
+			current:       testMPDs,
+			incoming:      updatedFactor,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedMultipliers{
+				{Denom: "hard", Name: incentivetypes.Small, MonthsLockup: true},
+				{Denom: "hard", Name: incentivetypes.Large},
+			},
+			current:       testMPDs,
+			incoming:      updatedFactor,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed add",
+			allowed: AllowedMultipliers{
+				{Denom: "hard", Name: incentivetypes.Small, MonthsLockup: true, Factor: true},
+				{Denom: "hard", Name: incentivetypes.Medium, MonthsLockup: true, Factor: true},
+				{Denom: "hard", Name: incentivetypes.Large, MonthsLockup: true, Factor: true},
+			},
+			current:       testMPDs,
+			incoming:      addedMultiplier,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed denom remove",
+			allowed: AllowedMultipliers{
+				{Denom: "hard", Name: incentivetypes.Small, MonthsLockup: true, Factor: true},
+				{Denom: "hard", Name: incentivetypes.Large, MonthsLockup: true, Factor: true},
+			},
+			current:       testMPDs,
+			incoming:      incentivetypes.MultipliersPerDenom{},
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedIssuanceAssets_Allows() {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1")))
+	testAssets := issuancetypes.Assets{
+		issuancetypes.NewAsset(owner, "usdtoken", false, true, false, issuancetypes.NewRateLimit(false, i(0), 0)),
+		issuancetypes.NewAsset(owner, "hardtoken", false, false, false, issuancetypes.NewRateLimit(false, i(0), 0)),
+	}
+	updatedTestAssets := make(issuancetypes.Assets, len(testAssets))
+	copy(updatedTestAssets, testAssets)
+	updatedTestAssets[0].Blockable = false
+	updatedTestAssets[1].Paused = true
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedIssuanceAssets
+		current       issuancetypes.Assets
+		incoming      issuancetypes.Assets
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change",
+			allowed: AllowedIssuanceAssets{
+				{Denom: "usdtoken", Blockable: true},
+				{Denom: "hardtoken", Paused: true},
+			},
+			current:       testAssets,
+			incoming:      updatedTestAssets,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed change",
+			allowed: AllowedIssuanceAssets{
+				{Denom: "usdtoken", Paused: true},
+				{Denom: "hardtoken", Paused: true},
+			},
+			current:       testAssets,
+			incoming:      updatedTestAssets,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed add",
+			allowed: AllowedIssuanceAssets{
+				{Denom: "usdtoken", Owner: true, Paused: true, Blockable: true, RateLimit: true},
+				{Denom: "hardtoken", Owner: true, Paused: true, Blockable: true, RateLimit: true},
+			},
+			current:       testAssets[:1],
+			incoming:      testAssets,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed rate limit change",
+			allowed: AllowedIssuanceAssets{
+				{Denom: "usdtoken", Owner: true, Paused: true, Blockable: true},
+			},
+			current: testAssets[:1],
+			incoming: issuancetypes.Assets{
+				issuancetypes.NewAsset(owner, "usdtoken", false, true, false, issuancetypes.NewRateLimit(true, i(1000), time.Hour)),
+			},
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed allowlist change",
+			allowed: AllowedIssuanceAssets{
+				{Denom: "usdtoken", Owner: true, Paused: true, Blockable: true, RateLimit: true},
+			},
+			current: testAssets[:1],
+			incoming: issuancetypes.Assets{
+				issuancetypes.NewAsset(owner, "usdtoken", false, true, true, issuancetypes.NewRateLimit(false, i(0), 0)),
+			},
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedParamBound_Allows() {
+	testcases := []struct {
+		name          string
+		bound         AllowedParamBound
+		current       sdk.Dec
+		incoming      sdk.Dec
+		expectAllowed bool
+	}{
+		{
+			name:          "allowed change",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1"), MaxStep: d("0.1")},
+			current:       d("0.5"),
+			incoming:      d("0.4"),
+			expectAllowed: true,
+		},
+		{
+			name:          "disallowed step",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1"), MaxStep: d("0.1")},
+			current:       d("0.5"),
+			incoming:      d("0.61"),
+			expectAllowed: false,
+		},
+		{
+			name:          "disallowed below min",
+			bound:         AllowedParamBound{Min: d("0.5"), Max: d("1"), MaxStep: d("0.1")},
+			current:       d("0.55"),
+			incoming:      d("0.45"),
+			expectAllowed: false,
+		},
+		{
+			name:          "allowed unchanged outside bounds",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1"), MaxStep: d("0.1")},
+			current:       d("2"),
+			incoming:      d("2"),
+			expectAllowed: true,
+		},
+		{
+			name:          "allowed move towards bounds",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1"), MaxStep: d("1.5")},
+			current:       d("2"),
+			incoming:      d("1"),
+			expectAllowed: true,
+		},
+		{
+			name:          "allowed per second rate",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("0.2"), MaxStep: d("0.02"), PerSecondRate: true},
+			current:       d("1.000000001547125958"), // 5% APR
+			incoming:      d("1.000000001996917783"), // 6.5% APR
+			expectAllowed: true,
+		},
+		{
+			name:          "disallowed per second rate above max",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("0.2"), MaxStep: d("1"), PerSecondRate: true},
+			current:       d("1.000000001547125958"), // 5% APR
+			incoming:      d("1.000000007075835620"), // 25% APR
+			expectAllowed: false,
+		},
+		{
+			name:          "disallowed largest convertible per second rate above max",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1000000"), MaxStep: d("1000000"), PerSecondRate: true},
+			current:       d("1.000000001547125958"), // 5% APR
+			incoming:      maxPerSecondRate,
+			expectAllowed: false,
+		},
+		{
+			name:          "disallowed extreme per second rate",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1000000"), MaxStep: d("1000000"), PerSecondRate: true},
+			current:       d("1.000000001547125958"), // 5% APR
+			incoming:      d("2"),
+			expectAllowed: false,
+		},
+		{
+			name:          "disallowed change from extreme per second rate",
+			bound:         AllowedParamBound{Min: d("0"), Max: d("1000000"), MaxStep: d("1000000"), PerSecondRate: true},
+			current:       d("1000"),
+			incoming:      d("1.000000001547125958"), // 5% APR
+			expectAllowed: false,
+		},
+		{
+			name:          "disallowed nil bounds",
+			bound:         AllowedParamBound{},
+			current:       d("0.5"),
+			incoming:      d("0.5"),
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.bound.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestAllowedParamBound_Validate() {
+	testcases := []struct {
+		name      string
+		bound     AllowedParamBound
+		expectErr bool
+	}{
+		{
+			name:      "valid",
+			bound:     NewAllowedParamBound("cdp", "CollateralParams", "type", "bnb-a", "stability_fee", d("0"), d("0.2"), d("0.02"), true),
+			expectErr: false,
+		},
+		{
+			name:      "missing key",
+			bound:     NewAllowedParamBound("cdp", "", "", "", "", d("0"), d("0.2"), d("0.02"), false),
+			expectErr: true,
+		},
+		{
+			name:      "entry id without entry field",
+			bound:     NewAllowedParamBound("cdp", "CollateralParams", "", "bnb-a", "stability_fee", d("0"), d("0.2"), d("0.02"), true),
+			expectErr: true,
+		},
+		{
+			name:      "min greater than max",
+			bound:     NewAllowedParamBound("auction", "IncrementSurplus", "", "", "", d("0.3"), d("0.2"), d("0.02"), false),
+			expectErr: true,
+		},
+		{
+			name:      "zero max step",
+			bound:     NewAllowedParamBound("auction", "IncrementSurplus", "", "", "", d("0"), d("0.2"), d("0"), false),
+			expectErr: true,
+		},
+		{
+			name:      "nil bounds",
+			bound:     AllowedParamBound{Subspace: "auction", Key: "IncrementSurplus"},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			err := tc.bound.Validate()
+			if tc.expectErr {
+				suite.Error(err)
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
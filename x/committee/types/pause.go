@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	yaml "gopkg.in/yaml.v2"
+
+	bep3types "github.com/kava-labs/kava/x/bep3/types"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// Pause disables a msg type until its end time.
+// A pause with a scope only disables msgs acting on that swap pool, denom or cdp collateral type.
+type Pause struct {
+	Route   string    `json:"route" yaml:"route"`       // Route of the msg, eg "hard"
+	MsgType string    `json:"msg_type" yaml:"msg_type"` // Type of the msg, eg "hard_borrow"
+	Scope   string    `json:"scope" yaml:"scope"`       // Optional swap pool ID, denom or collateral type the pause is limited to
+	EndTime time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewPause returns a new Pause
+func NewPause(route, msgType, scope string, endTime time.Time) Pause {
+	return Pause{
+		Route:   route,
+		MsgType: msgType,
+		Scope:   scope,
+		EndTime: endTime,
+	}
+}
+
+// Validate performs stateless checks on the pause
+func (p Pause) Validate() error {
+	if p.Route == "" {
+		return fmt.Errorf("pause route cannot be empty")
+	}
+	if p.Route == RouterKey {
+		return fmt.Errorf("committee msgs cannot be paused")
+	}
+	if p.MsgType == "" {
+		return fmt.Errorf("pause msg type cannot be empty")
+	}
+	if p.EndTime.IsZero() {
+		return fmt.Errorf("pause end time cannot be zero")
+	}
+	return nil
+}
+
+// IsActiveAt returns whether the pause disables msgs at the given time
+func (p Pause) IsActiveAt(t time.Time) bool {
+	return t.Before(p.EndTime)
+}
+
+// Matches returns whether the pause applies to a msg, regardless of its end time
+func (p Pause) Matches(msg sdk.Msg) bool {
+	if p.Route != msg.Route() || p.MsgType != msg.Type() {
+		return false
+	}
+	if p.Scope == "" {
+		return true
+	}
+	for _, scope := range MsgPauseScopes(msg) {
+		if scope == p.Scope {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Pause) String() string {
+	bz, _ := yaml.Marshal(p)
+	return string(bz)
+}
+
+// Pauses is a slice of Pause
+type Pauses []Pause
+
+// Validate checks each pause is valid and that no msg type and scope is paused twice
+func (ps Pauses) Validate() error {
+	seen := make(map[string]bool)
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		key := string(GetPauseKey(p.Route, p.MsgType, p.Scope))
+		if seen[key] {
+			return fmt.Errorf("duplicate pause for msg %s/%s with scope '%s'", p.Route, p.MsgType, p.Scope)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// MsgPauseScopes returns the swap pool IDs, denoms or cdp collateral types a msg acts on, which pauses can be limited to.
+func MsgPauseScopes(msg sdk.Msg) []string {
+	switch msg := msg.(type) {
+	case swaptypes.MsgDeposit:
+		return []string{swaptypes.PoolID(msg.TokenA.Denom, msg.TokenB.Denom)}
+	case swaptypes.MsgWithdraw:
+		return []string{swaptypes.PoolID(msg.MinTokenA.Denom, msg.MinTokenB.Denom)}
+	case swaptypes.MsgSwapExactForTokens:
+		return []string{swaptypes.PoolID(msg.ExactTokenA.Denom, msg.TokenB.Denom)}
+	case swaptypes.MsgSwapForExactTokens:
+		return []string{swaptypes.PoolID(msg.TokenA.Denom, msg.ExactTokenB.Denom)}
+	case hardtypes.MsgDeposit:
+		return coinDenoms(msg.Amount)
+	case hardtypes.MsgWithdraw:
+		return coinDenoms(msg.Amount)
+	case hardtypes.MsgBorrow:
+		return coinDenoms(msg.Amount)
+	case hardtypes.MsgRepay:
+		return coinDenoms(msg.Amount)
+	case cdptypes.MsgCreateCDP:
+		return []string{msg.CollateralType}
+	case cdptypes.MsgDeposit:
+		return []string{msg.CollateralType}
+	case cdptypes.MsgWithdraw:
+		return []string{msg.CollateralType}
+	case cdptypes.MsgDrawDebt:
+		return []string{msg.CollateralType}
+	case cdptypes.MsgRepayDebt:
+		return []string{msg.CollateralType}
+	case cdptypes.MsgLiquidate:
+		return []string{msg.CollateralType}
+	case bep3types.MsgCreateAtomicSwap:
+		return coinDenoms(msg.Amount)
+	case issuancetypes.MsgIssueTokens:
+		return []string{msg.Tokens.Denom}
+	case issuancetypes.MsgRedeemTokens:
+		return []string{msg.Tokens.Denom}
+	default:
+		return nil
+	}
+}
+
+func coinDenoms(coins sdk.Coins) []string {
+	denoms := make([]string, len(coins))
+	for i, c := range coins {
+		denoms[i] = c.Denom
+	}
+	return denoms
+}
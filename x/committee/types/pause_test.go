@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestPause_Matches(t *testing.T) {
+	endTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.AccAddress("test1")
+
+	borrow := hardtypes.NewMsgBorrow(addr, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1e8), sdk.NewInt64Coin("ukava", 1e8)))
+	swapDeposit := swaptypes.NewMsgDeposit(addr, sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 5e6), sdk.MustNewDecFromStr("0.01"), 0)
+	drawDebt := cdptypes.NewMsgDrawDebt(addr, "bnb-a", sdk.NewInt64Coin("usdx", 1e6))
+
+	testcases := []struct {
+		name          string
+		pause         Pause
+		msg           sdk.Msg
+		expectMatches bool
+	}{
+		{"unscoped", NewPause("hard", "hard_borrow", "", endTime), borrow, true},
+		{"denom scope", NewPause("hard", "hard_borrow", "ukava", endTime), borrow, true},
+		{"denom scope not in msg", NewPause("hard", "hard_borrow", "btcb", endTime), borrow, false},
+		{"other msg type", NewPause("hard", "hard_deposit", "", endTime), borrow, false},
+		{"pool scope", NewPause("swap", "swap_deposit", "ukava:usdx", endTime), swapDeposit, true},
+		{"other pool scope", NewPause("swap", "swap_deposit", "hard:usdx", endTime), swapDeposit, false},
+		{"collateral type scope", NewPause("cdp", "draw_cdp", "bnb-a", endTime), drawDebt, true},
+		{"other collateral type scope", NewPause("cdp", "draw_cdp", "btcb-a", endTime), drawDebt, false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectMatches, tc.pause.Matches(tc.msg))
+		})
+	}
+}
+
+func TestPauses_Validate(t *testing.T) {
+	endTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name      string
+		pauses    Pauses
+		expectErr bool
+	}{
+		{"normal", Pauses{NewPause("hard", "hard_borrow", "", endTime), NewPause("hard", "hard_borrow", "bnb", endTime)}, false},
+		{"empty", Pauses{}, false},
+		{"duplicate", Pauses{NewPause("hard", "hard_borrow", "bnb", endTime), NewPause("hard", "hard_borrow", "bnb", endTime.Add(time.Hour))}, true},
+		{"missing route", Pauses{NewPause("", "hard_borrow", "", endTime)}, true},
+		{"missing msg type", Pauses{NewPause("hard", "", "", endTime)}, true},
+		{"zero end time", Pauses{NewPause("hard", "hard_borrow", "", time.Time{})}, true},
+		{"committee msg", Pauses{NewPause(RouterKey, TypeMsgVote, "", endTime)}, true},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pauses.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,17 +1,27 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/hard"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
 )
 
 func init() {
@@ -23,6 +33,10 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(TextPermission{}, "kava/TextPermission")
 	govtypes.RegisterProposalTypeCodec(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission")
 	govtypes.RegisterProposalTypeCodec(SubParamChangePermission{}, "kava/SubParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(BoundedParamChangePermission{}, "kava/BoundedParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(PausePermission{}, "kava/PausePermission")
+	govtypes.RegisterProposalTypeCodec(HardReserveWithdrawalPermission{}, "kava/HardReserveWithdrawalPermission")
+	govtypes.RegisterProposalTypeCodec(Bep3DeputyBondSlashPermission{}, "kava/Bep3DeputyBondSlashPermission")
 }
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -158,6 +172,136 @@ func (SoftwareUpgradePermission) MarshalYAML() (interface{}, error) {
 	return valueToMarshal, nil
 }
 
+// ------------------------------------------
+//				PausePermission
+// ------------------------------------------
+
+// PausePermission allows pause proposals for certain msg types, for a limited duration
+type PausePermission struct {
+	AllowedPauses AllowedPauses `json:"allowed_pauses" yaml:"allowed_pauses"`
+}
+
+var _ Permission = PausePermission{}
+
+// Allows implement permission interface
+func (perm PausePermission) Allows(ctx sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(PauseProposal)
+	if !ok {
+		return false
+	}
+	for _, pause := range proposal.Pauses {
+		if !perm.AllowedPauses.Allows(ctx.BlockTime(), pause) {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm PausePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type          string        `yaml:"type"`
+		AllowedPauses AllowedPauses `yaml:"allowed_pauses"`
+	}{
+		Type:          "pause_permission",
+		AllowedPauses: perm.AllowedPauses,
+	}
+	return valueToMarshal, nil
+}
+
+// AllowedPause permission type for pausing a msg type
+type AllowedPause struct {
+	Route       string        `json:"route" yaml:"route"`
+	MsgType     string        `json:"msg_type" yaml:"msg_type"`
+	MaxDuration time.Duration `json:"max_duration" yaml:"max_duration"` // Longest time a pause can last from when it's enacted
+}
+
+// NewAllowedPause returns a new AllowedPause
+func NewAllowedPause(route, msgType string, maxDuration time.Duration) AllowedPause {
+	return AllowedPause{
+		Route:       route,
+		MsgType:     msgType,
+		MaxDuration: maxDuration,
+	}
+}
+
+// AllowedPauses slice of AllowedPause
+type AllowedPauses []AllowedPause
+
+// Allows checks if a pause, of any scope, is permitted at the given time
+func (aps AllowedPauses) Allows(blockTime time.Time, pause Pause) bool {
+	for _, ap := range aps {
+		if ap.Route == pause.Route && ap.MsgType == pause.MsgType && !pause.EndTime.After(blockTime.Add(ap.MaxDuration)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ------------------------------------------
+//				HardReserveWithdrawalPermission
+// ------------------------------------------
+
+// HardReserveWithdrawalPermission allows hard reserve withdrawal proposals up to a maximum amount per proposal
+type HardReserveWithdrawalPermission struct {
+	MaxWithdrawal sdk.Coins `json:"max_withdrawal" yaml:"max_withdrawal"`
+}
+
+var _ Permission = HardReserveWithdrawalPermission{}
+
+// Allows implement permission interface
+func (perm HardReserveWithdrawalPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(hard.ReserveWithdrawalProposal)
+	if !ok {
+		return false
+	}
+	return proposal.Amount.IsAllLTE(perm.MaxWithdrawal)
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm HardReserveWithdrawalPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type          string    `yaml:"type"`
+		MaxWithdrawal sdk.Coins `yaml:"max_withdrawal"`
+	}{
+		Type:          "hard_reserve_withdrawal_permission",
+		MaxWithdrawal: perm.MaxWithdrawal,
+	}
+	return valueToMarshal, nil
+}
+
+// ------------------------------------------
+//				Bep3DeputyBondSlashPermission
+// ------------------------------------------
+
+// Bep3DeputyBondSlashPermission allows bep3 deputy bond slash proposals up to a maximum fraction of the bond per proposal
+type Bep3DeputyBondSlashPermission struct {
+	MaxSlashFraction sdk.Dec `json:"max_slash_fraction" yaml:"max_slash_fraction"`
+}
+
+var _ Permission = Bep3DeputyBondSlashPermission{}
+
+// Allows implement permission interface
+func (perm Bep3DeputyBondSlashPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(bep3types.DeputyBondSlashProposal)
+	if !ok {
+		return false
+	}
+	return proposal.Fraction.LTE(perm.MaxSlashFraction)
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm Bep3DeputyBondSlashPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type             string  `yaml:"type"`
+		MaxSlashFraction sdk.Dec `yaml:"max_slash_fraction"`
+	}{
+		Type:             "bep3_deputy_bond_slash_permission",
+		MaxSlashFraction: perm.MaxSlashFraction,
+	}
+	return valueToMarshal, nil
+}
+
 // ------------------------------------------
 //				SubParamChangePermission
 // ------------------------------------------
@@ -170,6 +314,10 @@ type SubParamChangePermission struct {
 	AllowedAssetParams      AllowedAssetParams      `json:"allowed_asset_params" yaml:"allowed_asset_params"`
 	AllowedMarkets          AllowedMarkets          `json:"allowed_markets" yaml:"allowed_markets"`
 	AllowedMoneyMarkets     AllowedMoneyMarkets     `json:"allowed_money_markets" yaml:"allowed_money_markets"`
+	AllowedSwapPools        AllowedSwapPools        `json:"allowed_swap_pools" yaml:"allowed_swap_pools"`
+	AllowedRewardPeriods    AllowedRewardPeriods    `json:"allowed_reward_periods" yaml:"allowed_reward_periods"`
+	AllowedMultipliers      AllowedMultipliers      `json:"allowed_multipliers" yaml:"allowed_multipliers"`
+	AllowedIssuanceAssets   AllowedIssuanceAssets   `json:"allowed_issuance_assets" yaml:"allowed_issuance_assets"`
 }
 
 var _ Permission = SubParamChangePermission{}
@@ -184,6 +332,10 @@ func (perm SubParamChangePermission) MarshalYAML() (interface{}, error) {
 		AllowedAssetParams      AllowedAssetParams      `yaml:"allowed_asset_params" json:"allowed_asset_params"`
 		AllowedMarkets          AllowedMarkets          `yaml:"allowed_markets" json:"allowed_markets"`
 		AllowedMoneyMarkets     AllowedMoneyMarkets     `json:"allowed_money_markets" yaml:"allowed_money_markets"`
+		AllowedSwapPools        AllowedSwapPools        `json:"allowed_swap_pools" yaml:"allowed_swap_pools"`
+		AllowedRewardPeriods    AllowedRewardPeriods    `json:"allowed_reward_periods" yaml:"allowed_reward_periods"`
+		AllowedMultipliers      AllowedMultipliers      `json:"allowed_multipliers" yaml:"allowed_multipliers"`
+		AllowedIssuanceAssets   AllowedIssuanceAssets   `json:"allowed_issuance_assets" yaml:"allowed_issuance_assets"`
 	}{
 		Type:                    "param_change_permission",
 		AllowedParams:           perm.AllowedParams,
@@ -192,6 +344,10 @@ func (perm SubParamChangePermission) MarshalYAML() (interface{}, error) {
 		AllowedAssetParams:      perm.AllowedAssetParams,
 		AllowedMarkets:          perm.AllowedMarkets,
 		AllowedMoneyMarkets:     perm.AllowedMoneyMarkets,
+		AllowedSwapPools:        perm.AllowedSwapPools,
+		AllowedRewardPeriods:    perm.AllowedRewardPeriods,
+		AllowedMultipliers:      perm.AllowedMultipliers,
+		AllowedIssuanceAssets:   perm.AllowedIssuanceAssets,
 	}
 	return valueToMarshal, nil
 }
@@ -364,6 +520,118 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		}
 	}
 
+	// Check any swap AllowedPools changes are allowed
+
+	var foundIncomingPools bool
+	var incomingPools swaptypes.AllowedPools
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == swaptypes.ModuleName && change.Key == string(swaptypes.KeyAllowedPools)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingPools = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingPools); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	if foundIncomingPools {
+		subspace, found := pk.GetSubspace(swaptypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentPools swaptypes.AllowedPools
+		subspace.Get(ctx, swaptypes.KeyAllowedPools, &currentPools) // panics if something goes wrong
+
+		if !perm.AllowedSwapPools.Allows(currentPools, incomingPools) {
+			return false
+		}
+	}
+
+	// Check any incentive reward period changes are allowed
+
+	for _, key := range rewardPeriodKeys {
+		var foundIncomingRPs bool
+		var incomingRPs incentivetypes.MultiRewardPeriods
+		for _, change := range proposal.Changes {
+			if !(change.Subspace == incentivetypes.ModuleName && change.Key == string(key)) {
+				continue
+			}
+			// note: in case of duplicates take the last value
+			foundIncomingRPs = true
+			var err error
+			incomingRPs, err = unmarshalRewardPeriods(appCdc, key, change.Value)
+			if err != nil {
+				return false // invalid json value, so just disallow
+			}
+		}
+		if !foundIncomingRPs {
+			continue
+		}
+		subspace, found := pk.GetSubspace(incentivetypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		currentRPs := getRewardPeriods(ctx, subspace, key) // panics if something goes wrong
+
+		if !perm.AllowedRewardPeriods.Allows(string(key), currentRPs, incomingRPs) {
+			return false
+		}
+	}
+
+	// Check any incentive ClaimMultipliers changes are allowed
+
+	var foundIncomingMPDs bool
+	var incomingMPDs incentivetypes.MultipliersPerDenom
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == incentivetypes.ModuleName && change.Key == string(incentivetypes.KeyMultipliers)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingMPDs = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingMPDs); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	if foundIncomingMPDs {
+		subspace, found := pk.GetSubspace(incentivetypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentMPDs incentivetypes.MultipliersPerDenom
+		subspace.Get(ctx, incentivetypes.KeyMultipliers, &currentMPDs) // panics if something goes wrong
+
+		if !perm.AllowedMultipliers.Allows(currentMPDs, incomingMPDs) {
+			return false
+		}
+	}
+
+	// Check any issuance Assets changes are allowed
+
+	var foundIncomingIAs bool
+	var incomingIAs issuancetypes.Assets
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == issuancetypes.ModuleName && change.Key == string(issuancetypes.KeyAssets)) {
+			continue
+		}
+		// note: in case of duplicates take the last value
+		foundIncomingIAs = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingIAs); err != nil {
+			return false // invalid json value, so just disallow
+		}
+	}
+	if foundIncomingIAs {
+		subspace, found := pk.GetSubspace(issuancetypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentIAs issuancetypes.Assets
+		subspace.Get(ctx, issuancetypes.KeyAssets, &currentIAs) // panics if something goes wrong
+
+		if !perm.AllowedIssuanceAssets.Allows(currentIAs, incomingIAs) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -553,6 +821,9 @@ type AllowedAssetParam struct {
 	Active        bool   `json:"active" yaml:"active"`
 	MaxSwapAmount bool   `json:"max_swap_amount" yaml:"max_swap_amount"`
 	MinBlockLock  bool   `json:"min_block_lock" yaml:"min_block_lock"`
+	Deputies      bool   `json:"deputies" yaml:"deputies"` // allows changes to the additional and retiring deputies
+	FeeSchedule   bool   `json:"fee_schedule" yaml:"fee_schedule"`
+	MinDeputyBond bool   `json:"min_deputy_bond" yaml:"min_deputy_bond"`
 }
 
 // Allows bep3 AssetParam parameters than can be changed by committee
@@ -563,10 +834,58 @@ func (aap AllowedAssetParam) Allows(current, incoming bep3types.AssetParam) bool
 		(current.SupplyLimit.Equals(incoming.SupplyLimit) || aap.Limit) &&
 		((current.Active == incoming.Active) || aap.Active) &&
 		((current.MaxSwapAmount.Equal(incoming.MaxSwapAmount)) || aap.MaxSwapAmount) &&
-		((current.MinBlockLock == incoming.MinBlockLock) || aap.MinBlockLock)
+		((current.MinBlockLock == incoming.MinBlockLock) || aap.MinBlockLock) &&
+		((addressesEqual(current.AdditionalDeputies, incoming.AdditionalDeputies) &&
+			retiringDeputiesEqual(current.RetiringDeputies, incoming.RetiringDeputies)) || aap.Deputies) &&
+		(feeSchedulesEqual(current.FeeSchedule, incoming.FeeSchedule) || aap.FeeSchedule) &&
+		(coinsEqual(current.MinDeputyBond, incoming.MinDeputyBond) || aap.MinDeputyBond)
 	return allowed
 }
 
+// retiringDeputiesEqual checks if slices of retiring deputies are equal, the order matters
+func retiringDeputiesEqual(deputies1, deputies2 bep3types.RetiringDeputies) bool {
+	if len(deputies1) != len(deputies2) {
+		return false
+	}
+	for i := range deputies1 {
+		if !deputies1[i].Address.Equals(deputies2[i].Address) || !deputies1[i].GracePeriodEnd.Equal(deputies2[i].GracePeriodEnd) {
+			return false
+		}
+	}
+	return true
+}
+
+// feeSchedulesEqual checks if two fee schedules have the same tiers, the order matters
+func feeSchedulesEqual(fs1, fs2 bep3types.FeeSchedule) bool {
+	if len(fs1) != len(fs2) {
+		return false
+	}
+	for i := range fs1 {
+		if !fs1[i].MinAmount.Equal(fs2[i].MinAmount) ||
+			!fs1[i].FixedFee.Equal(fs2[i].FixedFee) ||
+			!fs1[i].Rate.Equal(fs2[i].Rate) ||
+			!fs1[i].MinFee.Equal(fs2[i].MinFee) ||
+			!fs1[i].MaxFee.Equal(fs2[i].MaxFee) {
+			return false
+		}
+	}
+	return true
+}
+
+// coinsEqual checks if two sets of coins have the same amount of each denom.
+// Unlike sdk.Coins.IsEqual it doesn't panic when the denoms differ.
+func coinsEqual(coins1, coins2 sdk.Coins) bool {
+	if len(coins1) != len(coins2) {
+		return false
+	}
+	for _, coin := range coins1 {
+		if !coin.Amount.Equal(coins2.AmountOf(coin.Denom)) {
+			return false
+		}
+	}
+	return true
+}
+
 // AllowedMarkets slice of AllowedMarket
 type AllowedMarkets []AllowedMarket
 
@@ -621,11 +940,13 @@ func (ams AllowedMarkets) Allows(current, incoming pricefeedtypes.Markets) bool
 
 // AllowedMarket permission struct for market parameters (pricefeed module)
 type AllowedMarket struct {
-	MarketID   string `json:"market_id" yaml:"market_id"`
-	BaseAsset  bool   `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset bool   `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    bool   `json:"oracles" yaml:"oracles"`
-	Active     bool   `json:"active" yaml:"active"`
+	MarketID           string `json:"market_id" yaml:"market_id"`
+	BaseAsset          bool   `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset         bool   `json:"quote_asset" yaml:"quote_asset"`
+	Oracles            bool   `json:"oracles" yaml:"oracles"`
+	Active             bool   `json:"active" yaml:"active"`
+	CommitRevealPeriod bool   `json:"commit_reveal_period" yaml:"commit_reveal_period"`
+	Derivation         bool   `json:"derivation" yaml:"derivation"`
 }
 
 // Allows determines if market param changes are permitted
@@ -634,7 +955,9 @@ func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
 		((current.BaseAsset == incoming.BaseAsset) || am.BaseAsset) &&
 		((current.QuoteAsset == incoming.QuoteAsset) || am.QuoteAsset) &&
 		(addressesEqual(current.Oracles, incoming.Oracles) || am.Oracles) &&
-		((current.Active == incoming.Active) || am.Active)
+		((current.Active == incoming.Active) || am.Active) &&
+		((current.CommitRevealPeriod == incoming.CommitRevealPeriod) || am.CommitRevealPeriod) &&
+		(current.Derivation.Equal(incoming.Derivation) || am.Derivation)
 	return allowed
 }
 
@@ -731,3 +1054,629 @@ func (amms AllowedMoneyMarkets) Allows(current, incoming hard.MoneyMarkets) bool
 
 	return allAllowed
 }
+
+// AllowedSwapPools slice of AllowedSwapPool
+type AllowedSwapPools []AllowedSwapPool
+
+// Allows determines if swap allowed pools changes are permitted
+func (asps AllowedSwapPools) Allows(current, incoming swaptypes.AllowedPools) bool {
+	// pools can be reordered, but only pools in the allowed list can be added or removed
+	currentPools := make(map[string]bool)
+	for _, p := range current {
+		currentPools[p.Name()] = true
+	}
+	incomingPools := make(map[string]bool)
+	for _, p := range incoming {
+		incomingPools[p.Name()] = true
+	}
+
+	for _, p := range incoming {
+		if !currentPools[p.Name()] && !asps.Contains(p) {
+			return false // not allowed to add pool to list
+		}
+	}
+	for _, p := range current {
+		if !incomingPools[p.Name()] && !asps.Contains(p) {
+			return false // not allowed to remove pool from list
+		}
+	}
+	return true
+}
+
+// Contains checks if a pool is included in the allowed swap pools
+func (asps AllowedSwapPools) Contains(pool swaptypes.AllowedPool) bool {
+	for _, asp := range asps {
+		if asp.Name() == pool.Name() {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedSwapPool permission struct for a pool that can be added to or removed from the allowed pools (swap module)
+type AllowedSwapPool struct {
+	TokenA string `json:"token_a" yaml:"token_a"`
+	TokenB string `json:"token_b" yaml:"token_b"`
+}
+
+// NewAllowedSwapPool returns a new AllowedSwapPool
+func NewAllowedSwapPool(tokenA, tokenB string) AllowedSwapPool {
+	return AllowedSwapPool{
+		TokenA: tokenA,
+		TokenB: tokenB,
+	}
+}
+
+// Name returns the name of the pool the permission applies to
+func (asp AllowedSwapPool) Name() string {
+	return swaptypes.PoolID(asp.TokenA, asp.TokenB)
+}
+
+// rewardPeriodKeys are the incentive param keys holding reward periods
+var rewardPeriodKeys = [][]byte{
+	incentivetypes.KeyUSDXMintingRewardPeriods,
+	incentivetypes.KeyHardSupplyRewardPeriods,
+	incentivetypes.KeyHardBorrowRewardPeriods,
+	incentivetypes.KeyDelegatorRewardPeriods,
+	incentivetypes.KeySwapRewardPeriods,
+}
+
+// unmarshalRewardPeriods decodes the value of a reward periods param change, converting usdx minting reward periods to multi reward periods
+func unmarshalRewardPeriods(cdc *codec.Codec, key []byte, value string) (incentivetypes.MultiRewardPeriods, error) {
+	if string(key) != string(incentivetypes.KeyUSDXMintingRewardPeriods) {
+		var mrps incentivetypes.MultiRewardPeriods
+		err := cdc.UnmarshalJSON([]byte(value), &mrps)
+		return mrps, err
+	}
+	var rps incentivetypes.RewardPeriods
+	if err := cdc.UnmarshalJSON([]byte(value), &rps); err != nil {
+		return nil, err
+	}
+	return toMultiRewardPeriods(rps), nil
+}
+
+// getRewardPeriods returns the current value of a reward periods param, converting usdx minting reward periods to multi reward periods
+func getRewardPeriods(ctx sdk.Context, subspace params.Subspace, key []byte) incentivetypes.MultiRewardPeriods {
+	if string(key) != string(incentivetypes.KeyUSDXMintingRewardPeriods) {
+		var mrps incentivetypes.MultiRewardPeriods
+		subspace.Get(ctx, key, &mrps)
+		return mrps
+	}
+	var rps incentivetypes.RewardPeriods
+	subspace.Get(ctx, key, &rps)
+	return toMultiRewardPeriods(rps)
+}
+
+func toMultiRewardPeriods(rps incentivetypes.RewardPeriods) incentivetypes.MultiRewardPeriods {
+	mrps := make(incentivetypes.MultiRewardPeriods, len(rps))
+	for i, rp := range rps {
+		mrps[i] = incentivetypes.NewMultiRewardPeriodFromRewardPeriod(rp)
+	}
+	return mrps
+}
+
+// AllowedRewardPeriods slice of AllowedRewardPeriod
+type AllowedRewardPeriods []AllowedRewardPeriod
+
+// Allows determines if reward period changes for an incentive param key are permitted
+func (arps AllowedRewardPeriods) Allows(rewardType string, current, incoming incentivetypes.MultiRewardPeriods) bool {
+	allAllowed := true
+
+	// do not allow reward periods to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	for _, incomingRP := range incoming {
+		// 1) check incoming reward period is in list of allowed reward periods
+		var foundAllowedRP bool
+		var allowedRP AllowedRewardPeriod
+		for _, p := range arps {
+			if p.RewardType != rewardType || p.CollateralType != incomingRP.CollateralType {
+				continue
+			}
+			foundAllowedRP = true
+			allowedRP = p
+		}
+		if !foundAllowedRP {
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		currentRP, foundCurrentRP := current.GetMultiRewardPeriod(incomingRP.CollateralType)
+		if !foundCurrentRP {
+			return false // not allowed to add reward period to list
+		}
+		allowed := allowedRP.Allows(currentRP, incomingRP)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+// AllowedRewardPeriod permission struct for reward period parameters (incentive module)
+type AllowedRewardPeriod struct {
+	RewardType       string `json:"reward_type" yaml:"reward_type"` // incentive param key, eg "HardSupplyRewardPeriods"
+	CollateralType   string `json:"collateral_type" yaml:"collateral_type"`
+	Active           bool   `json:"active" yaml:"active"`
+	Start            bool   `json:"start" yaml:"start"`
+	End              bool   `json:"end" yaml:"end"`
+	RewardsPerSecond bool   `json:"rewards_per_second" yaml:"rewards_per_second"`
+}
+
+// NewAllowedRewardPeriod returns a new AllowedRewardPeriod
+func NewAllowedRewardPeriod(rewardType, collateralType string, active, start, end, rewardsPerSecond bool) AllowedRewardPeriod {
+	return AllowedRewardPeriod{
+		RewardType:       rewardType,
+		CollateralType:   collateralType,
+		Active:           active,
+		Start:            start,
+		End:              end,
+		RewardsPerSecond: rewardsPerSecond,
+	}
+}
+
+// Allows determines if reward period changes are permitted
+func (arp AllowedRewardPeriod) Allows(current, incoming incentivetypes.MultiRewardPeriod) bool {
+	allowed := ((arp.CollateralType == current.CollateralType) && (arp.CollateralType == incoming.CollateralType)) &&
+		((current.Active == incoming.Active) || arp.Active) &&
+		(current.Start.Equal(incoming.Start) || arp.Start) &&
+		(current.End.Equal(incoming.End) || arp.End) &&
+		(current.RewardsPerSecond.IsEqual(incoming.RewardsPerSecond) || arp.RewardsPerSecond)
+	return allowed
+}
+
+// AllowedMultipliers slice of AllowedMultiplier
+type AllowedMultipliers []AllowedMultiplier
+
+// Allows determines if claim multiplier changes are permitted
+func (ams AllowedMultipliers) Allows(current, incoming incentivetypes.MultipliersPerDenom) bool {
+	allAllowed := true
+
+	// do not allow denoms to be added or removed
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	for _, incomingMPD := range incoming {
+		var foundCurrentMPD bool
+		var currentMs incentivetypes.Multipliers
+		for _, p := range current {
+			if p.Denom != incomingMPD.Denom {
+				continue
+			}
+			foundCurrentMPD = true
+			currentMs = p.Multipliers
+		}
+		if !foundCurrentMPD {
+			return false // not allowed to add denom to list
+		}
+
+		// do not allow multipliers to be added or removed
+		if len(incomingMPD.Multipliers) != len(currentMs) {
+			return false
+		}
+
+		for _, incomingM := range incomingMPD.Multipliers {
+			// 1) check incoming multiplier is in list of allowed multipliers
+			var foundAllowedM bool
+			var allowedM AllowedMultiplier
+			for _, p := range ams {
+				if p.Denom != incomingMPD.Denom || p.Name != incomingM.Name {
+					continue
+				}
+				foundAllowedM = true
+				allowedM = p
+			}
+			if !foundAllowedM {
+				return false
+			}
+
+			// 2) Check incoming changes are individually allowed
+			currentM, foundCurrentM := currentMs.Get(incomingM.Name)
+			if !foundCurrentM {
+				return false // not allowed to add multiplier to list
+			}
+			allowed := allowedM.Allows(currentM, incomingM)
+
+			allAllowed = allAllowed && allowed
+		}
+	}
+	return allAllowed
+}
+
+// AllowedMultiplier permission struct for claim multiplier parameters (incentive module)
+type AllowedMultiplier struct {
+	Denom        string                        `json:"denom" yaml:"denom"`
+	Name         incentivetypes.MultiplierName `json:"name" yaml:"name"`
+	MonthsLockup bool                          `json:"months_lockup" yaml:"months_lockup"`
+	Factor       bool                          `json:"factor" yaml:"factor"`
+}
+
+// Allows determines if claim multiplier changes are permitted
+func (am AllowedMultiplier) Allows(current, incoming incentivetypes.Multiplier) bool {
+	allowed := ((am.Name == current.Name) && (am.Name == incoming.Name)) &&
+		((current.MonthsLockup == incoming.MonthsLockup) || am.MonthsLockup) &&
+		(current.Factor.Equal(incoming.Factor) || am.Factor)
+	return allowed
+}
+
+// AllowedIssuanceAssets slice of AllowedIssuanceAsset
+type AllowedIssuanceAssets []AllowedIssuanceAsset
+
+// Allows determines if issuance asset changes are permitted
+func (aias AllowedIssuanceAssets) Allows(current, incoming issuancetypes.Assets) bool {
+	allAllowed := true
+
+	// do not allow assets to be added or removed
+	// this checks both lists are the same size, then below checks each incoming matches a current
+	if len(incoming) != len(current) {
+		return false
+	}
+
+	for _, incomingA := range incoming {
+		// 1) check incoming asset is in list of allowed assets
+		var foundAllowedA bool
+		var allowedA AllowedIssuanceAsset
+		for _, p := range aias {
+			if p.Denom != incomingA.Denom {
+				continue
+			}
+			foundAllowedA = true
+			allowedA = p
+		}
+		if !foundAllowedA {
+			return false
+		}
+
+		// 2) Check incoming changes are individually allowed
+		var foundCurrentA bool
+		var currentA issuancetypes.Asset
+		for _, p := range current {
+			if p.Denom != incomingA.Denom {
+				continue
+			}
+			foundCurrentA = true
+			currentA = p
+		}
+		if !foundCurrentA {
+			return false // not allowed to add asset to list
+		}
+		allowed := allowedA.Allows(currentA, incomingA)
+
+		allAllowed = allAllowed && allowed
+	}
+	return allAllowed
+}
+
+// AllowedIssuanceAsset permission struct for asset parameters (issuance module)
+type AllowedIssuanceAsset struct {
+	Denom            string `json:"denom" yaml:"denom"`
+	Owner            bool   `json:"owner" yaml:"owner"`
+	Paused           bool   `json:"paused" yaml:"paused"`
+	Blockable        bool   `json:"blockable" yaml:"blockable"`
+	AllowlistEnabled bool   `json:"allowlist_enabled" yaml:"allowlist_enabled"`
+	RateLimit        bool   `json:"rate_limit" yaml:"rate_limit"`
+}
+
+// Allows determines if issuance asset param changes are permitted
+func (aia AllowedIssuanceAsset) Allows(current, incoming issuancetypes.Asset) bool {
+	allowed := ((aia.Denom == current.Denom) && (aia.Denom == incoming.Denom)) &&
+		(current.Owner.Equals(incoming.Owner) || aia.Owner) &&
+		((current.Paused == incoming.Paused) || aia.Paused) &&
+		((current.Blockable == incoming.Blockable) || aia.Blockable) &&
+		((current.AllowlistEnabled == incoming.AllowlistEnabled) || aia.AllowlistEnabled) &&
+		(rateLimitsEqual(current.RateLimit, incoming.RateLimit) || aia.RateLimit)
+	return allowed
+}
+
+// rateLimitsEqual checks if two issuance rate limits are equal
+func rateLimitsEqual(rl1, rl2 issuancetypes.RateLimit) bool {
+	return rl1.Active == rl2.Active &&
+		rl1.Limit.Equal(rl2.Limit) &&
+		rl1.TimePeriod == rl2.TimePeriod
+}
+
+// ------------------------------------------
+//				BoundedParamChangePermission
+// ------------------------------------------
+
+// secondsPerYear is used to convert per second interest factors to APRs
+const secondsPerYear = 31536000
+
+// maxPerSecondRate is the largest per second interest factor that can be converted to an APR, about 5e13 APR.
+// Raising larger factors to the power of secondsPerYear can overflow sdk.Dec.
+var maxPerSecondRate = sdk.MustNewDecFromStr("1.000001")
+
+// BoundedParamChangePermission permission type for allowing changes to numeric param values within a range, and by a limited amount per proposal
+type BoundedParamChangePermission struct {
+	AllowedParamBounds AllowedParamBounds `json:"allowed_param_bounds" yaml:"allowed_param_bounds"`
+}
+
+var _ Permission = BoundedParamChangePermission{}
+
+// MarshalYAML implement yaml marshalling
+func (perm BoundedParamChangePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type               string             `yaml:"type"`
+		AllowedParamBounds AllowedParamBounds `yaml:"allowed_param_bounds"`
+	}{
+		Type:               "bounded_param_change_permission",
+		AllowedParamBounds: perm.AllowedParamBounds,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks each param bound is valid
+func (perm BoundedParamChangePermission) Validate() error {
+	for _, apb := range perm.AllowedParamBounds {
+		if err := apb.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Allows implement permission interface
+func (perm BoundedParamChangePermission) Allows(ctx sdk.Context, _ *codec.Codec, pk ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(paramstypes.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	// note: in case of duplicates take the last value
+	incomingValues := make(map[paramKey]string)
+	for _, change := range proposal.Changes {
+		incomingValues[paramKey{change.Subspace, change.Key}] = change.Value
+	}
+
+	for key, value := range incomingValues {
+		bounds := perm.AllowedParamBounds.For(key.subspace, key.key)
+		if len(bounds) == 0 {
+			return false
+		}
+		subspace, found := pk.GetSubspace(key.subspace)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		current, err := decodeParamValue(subspace.GetRaw(ctx, []byte(key.key)))
+		if err != nil {
+			return false
+		}
+		incomingBz, err := applyParamChange(ctx, subspace, key.key, value)
+		if err != nil {
+			return false
+		}
+		incoming, err := decodeParamValue(incomingBz)
+		if err != nil {
+			return false
+		}
+
+		// check every bounded value, then reset them so that any other changed value is disallowed
+		for _, apb := range bounds {
+			currentParent, field, found := apb.locate(current)
+			if !found {
+				return false
+			}
+			incomingParent, _, found := apb.locate(incoming)
+			if !found {
+				return false
+			}
+			currentValue, ok := parseDecValue(currentParent[field])
+			if !ok {
+				return false
+			}
+			incomingValue, ok := parseDecValue(incomingParent[field])
+			if !ok {
+				return false
+			}
+			if !apb.Allows(currentValue, incomingValue) {
+				return false
+			}
+		}
+		for _, apb := range bounds {
+			currentParent, field, _ := apb.locate(current)
+			incomingParent, _, _ := apb.locate(incoming)
+			incomingParent[field] = currentParent[field]
+		}
+		if !reflect.DeepEqual(current, incoming) {
+			return false
+		}
+	}
+	return true
+}
+
+// paramKey identifies a param by its subspace and key
+type paramKey struct {
+	subspace string
+	key      string
+}
+
+// applyParamChange applies a param change in a cached context and returns the value that would be stored
+func applyParamChange(ctx sdk.Context, subspace params.Subspace, key, value string) (bz []byte, err error) {
+	// Update panics if the key isn't registered, not using a panic to help avoid begin blocker panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not apply change to param %s: %v", key, r)
+		}
+	}()
+	cacheCtx, _ := ctx.CacheContext()
+	if err := subspace.Update(cacheCtx, []byte(key), []byte(value)); err != nil {
+		return nil, err
+	}
+	return subspace.GetRaw(cacheCtx, []byte(key)), nil
+}
+
+// decodeParamValue decodes a stored param value, wrapped in an object so that a param value can be replaced in place
+func decodeParamValue(bz []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(string(bz)))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{paramValueField: value}, nil
+}
+
+// paramValueField is the field holding a decoded param value
+const paramValueField = "value"
+
+// parseDecValue parses a numeric json value, amino encodes sdk.Dec, sdk.Int and 64 bit integers as strings
+func parseDecValue(value interface{}) (sdk.Dec, bool) {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	default:
+		return sdk.Dec{}, false
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, false
+	}
+	return dec, true
+}
+
+// AllowedParamBounds slice of AllowedParamBound
+type AllowedParamBounds []AllowedParamBound
+
+// For returns the bounds that apply to a param
+func (apbs AllowedParamBounds) For(subspace, key string) AllowedParamBounds {
+	var bounds AllowedParamBounds
+	for _, apb := range apbs {
+		if apb.Subspace == subspace && apb.Key == key {
+			bounds = append(bounds, apb)
+		}
+	}
+	return bounds
+}
+
+// AllowedParamBound permission struct for changes to a numeric value within a module param
+type AllowedParamBound struct {
+	Subspace      string  `json:"subspace" yaml:"subspace"`
+	Key           string  `json:"key" yaml:"key"`
+	EntryField    string  `json:"entry_field" yaml:"entry_field"`         // For params holding a list, the field identifying the entry to change, eg "type" for cdp collateral params
+	EntryID       string  `json:"entry_id" yaml:"entry_id"`               // The value of the entry field for the entry to change, eg "bnb-a"
+	Field         string  `json:"field" yaml:"field"`                     // Dot separated json path to the value within the param or entry, empty if the param is the value
+	Min           sdk.Dec `json:"min" yaml:"min"`                         // Smallest value that can be set
+	Max           sdk.Dec `json:"max" yaml:"max"`                         // Largest value that can be set
+	MaxStep       sdk.Dec `json:"max_step" yaml:"max_step"`               // Largest change that can be made in one proposal
+	PerSecondRate bool    `json:"per_second_rate" yaml:"per_second_rate"` // Whether the value is a per second interest factor, the bounds and step are then compared to its APR
+}
+
+// NewAllowedParamBound returns a new AllowedParamBound
+func NewAllowedParamBound(subspace, key, entryField, entryID, field string, min, max, maxStep sdk.Dec, perSecondRate bool) AllowedParamBound {
+	return AllowedParamBound{
+		Subspace:      subspace,
+		Key:           key,
+		EntryField:    entryField,
+		EntryID:       entryID,
+		Field:         field,
+		Min:           min,
+		Max:           max,
+		MaxStep:       maxStep,
+		PerSecondRate: perSecondRate,
+	}
+}
+
+// Validate checks the param bound is well formed
+func (apb AllowedParamBound) Validate() error {
+	if apb.Subspace == "" || apb.Key == "" {
+		return fmt.Errorf("param bound must have a subspace and key")
+	}
+	if (apb.EntryField == "") != (apb.EntryID == "") {
+		return fmt.Errorf("param bound for %s/%s must set both or neither of entry field and entry id", apb.Subspace, apb.Key)
+	}
+	if apb.Min.IsNil() || apb.Max.IsNil() || apb.MaxStep.IsNil() {
+		return fmt.Errorf("param bound for %s/%s must have a min, max and max step", apb.Subspace, apb.Key)
+	}
+	if apb.Min.GT(apb.Max) {
+		return fmt.Errorf("param bound for %s/%s has min %s greater than max %s", apb.Subspace, apb.Key, apb.Min, apb.Max)
+	}
+	if !apb.MaxStep.IsPositive() {
+		return fmt.Errorf("param bound for %s/%s must have a positive max step: %s", apb.Subspace, apb.Key, apb.MaxStep)
+	}
+	return nil
+}
+
+// Allows determines if a change to a bounded value is permitted
+func (apb AllowedParamBound) Allows(current, incoming sdk.Dec) bool {
+	if apb.Min.IsNil() || apb.Max.IsNil() || apb.MaxStep.IsNil() {
+		return false
+	}
+	// unchanged values are allowed even if the current value is outside the bounds
+	if current.Equal(incoming) {
+		return true
+	}
+	if apb.PerSecondRate {
+		var ok bool
+		if current, ok = perSecondRateToAPR(current); !ok {
+			return false
+		}
+		if incoming, ok = perSecondRateToAPR(incoming); !ok {
+			return false
+		}
+	}
+	return incoming.GTE(apb.Min) &&
+		incoming.LTE(apb.Max) &&
+		incoming.Sub(current).Abs().LTE(apb.MaxStep)
+}
+
+// locate finds the bounded value in a decoded param, returning the object holding it and its field name
+func (apb AllowedParamBound) locate(param map[string]interface{}) (map[string]interface{}, string, bool) {
+	path := []string{paramValueField}
+	if apb.Field != "" {
+		path = append(path, strings.Split(apb.Field, ".")...)
+	}
+
+	var node interface{} = param
+	var parent map[string]interface{}
+	var field string
+	for _, f := range path {
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, "", false
+		}
+		parent, field = obj, f
+		node, ok = obj[f]
+		if !ok {
+			return nil, "", false
+		}
+		// step into the bounded entry of lists
+		if list, ok := node.([]interface{}); ok {
+			node, ok = apb.findEntry(list)
+			if !ok {
+				return nil, "", false
+			}
+		}
+	}
+	return parent, field, true
+}
+
+// findEntry returns the list entry whose entry field matches the entry id
+func (apb AllowedParamBound) findEntry(list []interface{}) (interface{}, bool) {
+	if apb.EntryField == "" {
+		return nil, false
+	}
+	for _, entry := range list {
+		obj, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := obj[apb.EntryField].(string); ok && id == apb.EntryID {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// perSecondRateToAPR converts a per second interest factor, eg a cdp stability fee, to an annual percentage rate.
+// It returns false for factors above maxPerSecondRate, or below zero, whose APR can't be computed.
+func perSecondRateToAPR(rate sdk.Dec) (sdk.Dec, bool) {
+	if rate.IsNegative() || rate.GT(maxPerSecondRate) {
+		return sdk.Dec{}, false
+	}
+	return rate.Power(secondsPerYear).Sub(sdk.OneDec()), true
+}
@@ -10,6 +10,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	bep3types "github.com/kava-labs/kava/x/bep3/types"
+	"github.com/kava-labs/kava/x/hard"
 )
 
 type PermissionsTestSuite struct {
@@ -352,6 +355,169 @@ func (suite *PermissionsTestSuite) TestSoftwareUpgradePermission_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestPausePermission_Allows() {
+	blockTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	allowedPauses := AllowedPauses{
+		NewAllowedPause("hard", "hard_borrow", 24*time.Hour),
+		NewAllowedPause("swap", "swap_deposit", 7*24*time.Hour),
+	}
+
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name: "normal",
+			pubProposal: NewPauseProposal("A Title", "A description for this proposal.", Pauses{
+				NewPause("hard", "hard_borrow", "bnb", blockTime.Add(24*time.Hour)),
+				NewPause("swap", "swap_deposit", "", blockTime.Add(time.Hour)),
+			}),
+			expectAllowed: true,
+		},
+		{
+			name: "normal (lifting a pause)",
+			pubProposal: NewPauseProposal("A Title", "A description for this proposal.", Pauses{
+				NewPause("hard", "hard_borrow", "bnb", blockTime),
+			}),
+			expectAllowed: true,
+		},
+		{
+			name: "not allowed (duration too long)",
+			pubProposal: NewPauseProposal("A Title", "A description for this proposal.", Pauses{
+				NewPause("hard", "hard_borrow", "bnb", blockTime.Add(25*time.Hour)),
+			}),
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (one msg type not allowed)",
+			pubProposal: NewPauseProposal("A Title", "A description for this proposal.", Pauses{
+				NewPause("hard", "hard_borrow", "", blockTime.Add(time.Hour)),
+				NewPause("hard", "hard_withdraw", "", blockTime.Add(time.Hour)),
+			}),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (wrong pubproposal type)",
+			pubProposal:   govtypes.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := PausePermission{AllowedPauses: allowedPauses}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}.WithBlockTime(blockTime), nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestHardReserveWithdrawalPermission_Allows() {
+	recipient := sdk.AccAddress("recipient")
+	maxWithdrawal := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000), sdk.NewInt64Coin("ukava", 5000))
+
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "normal",
+			pubProposal:   hard.NewReserveWithdrawalProposal("A Title", "A description for this proposal.", recipient, false, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))),
+			expectAllowed: true,
+		},
+		{
+			name:          "normal (cover bad debt)",
+			pubProposal:   hard.NewReserveWithdrawalProposal("A Title", "A description for this proposal.", nil, true, maxWithdrawal),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (amount too large)",
+			pubProposal:   hard.NewReserveWithdrawalProposal("A Title", "A description for this proposal.", recipient, false, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1001))),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (denom not allowed)",
+			pubProposal:   hard.NewReserveWithdrawalProposal("A Title", "A description for this proposal.", recipient, false, sdk.NewCoins(sdk.NewInt64Coin("btcb", 1))),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (wrong pubproposal type)",
+			pubProposal:   govtypes.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := HardReserveWithdrawalPermission{MaxWithdrawal: maxWithdrawal}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestBep3DeputyBondSlashPermission_Allows() {
+	deputy := sdk.AccAddress("deputy")
+
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "normal",
+			pubProposal:   bep3types.NewDeputyBondSlashProposal("A Title", "A description for this proposal.", deputy, sdk.MustNewDecFromStr("0.1")),
+			expectAllowed: true,
+		},
+		{
+			name:          "normal (max fraction)",
+			pubProposal:   bep3types.NewDeputyBondSlashProposal("A Title", "A description for this proposal.", deputy, sdk.MustNewDecFromStr("0.5")),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (fraction too large)",
+			pubProposal:   bep3types.NewDeputyBondSlashProposal("A Title", "A description for this proposal.", deputy, sdk.MustNewDecFromStr("0.51")),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (wrong pubproposal type)",
+			pubProposal:   govtypes.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := Bep3DeputyBondSlashPermission{MaxSlashFraction: sdk.MustNewDecFromStr("0.5")}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
 func TestPermissionsTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionsTestSuite))
 }
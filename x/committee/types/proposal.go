@@ -13,6 +13,8 @@ import (
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeCommitteeVeto   = "CommitteeVeto"
+	ProposalTypePause           = "Pause"
 )
 
 // ProposalOutcome indicates the status of a proposal when it's closed and deleted from the store
@@ -25,12 +27,24 @@ const (
 	Failed
 	// Invalid indicates that proposal passed but an error occurred when attempting to enact it
 	Invalid
+	// Withdrawn indicates that the proposal was withdrawn by its proposer or a majority of committee members before it closed
+	Withdrawn
+	// Superseded indicates that the proposal was replaced by an amended proposal before it closed
+	Superseded
+	// Queued indicates that the proposal passed and is waiting for the committee's enactment delay to elapse
+	Queued
+	// Vetoed indicates that the proposal was vetoed by a veto committee while queued
+	Vetoed
 )
 
 var toString = map[ProposalOutcome]string{
-	Passed:  "Passed",
-	Failed:  "Failed",
-	Invalid: "Invalid",
+	Passed:     "Passed",
+	Failed:     "Failed",
+	Invalid:    "Invalid",
+	Withdrawn:  "Withdrawn",
+	Superseded: "Superseded",
+	Queued:     "Queued",
+	Vetoed:     "Vetoed",
 }
 
 func (p ProposalOutcome) String() string {
@@ -67,12 +81,40 @@ func MatchMarshaledOutcome(value []byte, cdc *codec.Codec) (ProposalOutcome, err
 	if bytes.Compare(invalid, value) == 0 {
 		return Invalid, nil
 	}
+	withdrawn, err := Withdrawn.Marshal(cdc)
+	if err != nil {
+		return 0, err
+	}
+	if bytes.Compare(withdrawn, value) == 0 {
+		return Withdrawn, nil
+	}
+	superseded, err := Superseded.Marshal(cdc)
+	if err != nil {
+		return 0, err
+	}
+	if bytes.Compare(superseded, value) == 0 {
+		return Superseded, nil
+	}
+	queued, err := Queued.Marshal(cdc)
+	if err != nil {
+		return 0, err
+	}
+	if bytes.Compare(queued, value) == 0 {
+		return Queued, nil
+	}
+	vetoed, err := Vetoed.Marshal(cdc)
+	if err != nil {
+		return 0, err
+	}
+	if bytes.Compare(vetoed, value) == 0 {
+		return Vetoed, nil
+	}
 	return 0, nil
 }
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
-var _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
+var _, _, _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, CommitteeVetoProposal{}, PauseProposal{}
+var _, _, _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, CommitteeVetoProposal{}, PauseProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
@@ -81,6 +123,12 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeCommitteeDelete)
 	govtypes.RegisterProposalTypeCodec(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeCommitteeVeto)
+	govtypes.RegisterProposalTypeCodec(CommitteeVetoProposal{}, "kava/CommitteeVetoProposal")
+
+	govtypes.RegisterProposalType(ProposalTypePause)
+	govtypes.RegisterProposalTypeCodec(PauseProposal{}, "kava/PauseProposal")
 }
 
 // CommitteeChangeProposal is a gov proposal for creating a new committee or modifying an existing one.
@@ -164,3 +212,89 @@ func (cdp CommitteeDeleteProposal) String() string {
 	bz, _ := yaml.Marshal(cdp)
 	return string(bz)
 }
+
+// CommitteeVetoProposal is a proposal for vetoing a queued proposal before it is enacted.
+type CommitteeVetoProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ProposalID  uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+func NewCommitteeVetoProposal(title string, description string, proposalID uint64) CommitteeVetoProposal {
+	return CommitteeVetoProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (cvp CommitteeVetoProposal) GetTitle() string { return cvp.Title }
+
+// GetDescription returns the description of the proposal.
+func (cvp CommitteeVetoProposal) GetDescription() string { return cvp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (cvp CommitteeVetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (cvp CommitteeVetoProposal) ProposalType() string { return ProposalTypeCommitteeVeto }
+
+// ValidateBasic runs basic stateless validity checks
+func (cvp CommitteeVetoProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(cvp)
+}
+
+// String implements the Stringer interface.
+func (cvp CommitteeVetoProposal) String() string {
+	bz, _ := yaml.Marshal(cvp)
+	return string(bz)
+}
+
+// PauseProposal is a proposal for pausing msg types until an end time.
+// Pauses replace existing pauses of the same msg type and scope, a pause with an end time that has passed lifts the existing pause.
+type PauseProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Pauses      Pauses `json:"pauses" yaml:"pauses"`
+}
+
+func NewPauseProposal(title string, description string, pauses Pauses) PauseProposal {
+	return PauseProposal{
+		Title:       title,
+		Description: description,
+		Pauses:      pauses,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (pp PauseProposal) GetTitle() string { return pp.Title }
+
+// GetDescription returns the description of the proposal.
+func (pp PauseProposal) GetDescription() string { return pp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (pp PauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (pp PauseProposal) ProposalType() string { return ProposalTypePause }
+
+// ValidateBasic runs basic stateless validity checks
+func (pp PauseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(pp); err != nil {
+		return err
+	}
+	if len(pp.Pauses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, "pause proposal must contain at least one pause")
+	}
+	if err := pp.Pauses.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (pp PauseProposal) String() string {
+	bz, _ := yaml.Marshal(pp)
+	return string(bz)
+}
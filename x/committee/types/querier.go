@@ -8,15 +8,19 @@ import (
 
 // Query endpoints supported by the Querier
 const (
-	QueryCommittees     = "committees"
-	QueryCommittee      = "committee"
-	QueryProposals      = "proposals"
-	QueryProposal       = "proposal"
-	QueryNextProposalID = "next-proposal-id"
-	QueryVotes          = "votes"
-	QueryVote           = "vote"
-	QueryTally          = "tally"
-	QueryRawParams      = "raw_params"
+	QueryCommittees      = "committees"
+	QueryCommittee       = "committee"
+	QueryProposals       = "proposals"
+	QueryProposal        = "proposal"
+	QueryNextProposalID  = "next-proposal-id"
+	QueryVotes           = "votes"
+	QueryVote            = "vote"
+	QueryTally           = "tally"
+	QueryRawParams       = "raw_params"
+	QueryQueuedProposals = "queued-proposals"
+	QueryQueuedProposal  = "queued-proposal"
+	QueryVoteDelegations = "vote-delegations"
+	QueryPauses          = "pauses"
 )
 
 type QueryCommitteeParams struct {
@@ -70,6 +74,8 @@ type ProposalPollingStatus struct {
 	PossibleVotes sdk.Dec `json:"possible_votes" yaml:"possible_votes"`
 	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
 	Quorum        sdk.Dec `json:"quorum" yaml:"quorum"`
+	// DelegatedVotes is the part of current votes cast by delegatees on behalf of member committee members
+	DelegatedVotes sdk.Dec `json:"delegated_votes,omitempty" yaml:"delegated_votes"`
 }
 
 func NewProposalPollingStatus(proposalID uint64, yesVotes, currentVotes, possibleVotes,
@@ -91,8 +97,10 @@ func (p ProposalPollingStatus) String() string {
 	Current votes:     %d
   	Possible votes:    %d
   	Vote threshold:    %d
-	Quorum:        	   %d`,
+	Quorum:        	   %d
+	Delegated votes:   %d`,
 		p.ProposalID, p.YesVotes, p.CurrentVotes,
 		p.PossibleVotes, p.VoteThreshold, p.Quorum,
+		p.DelegatedVotes,
 	)
 }
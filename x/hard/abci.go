@@ -4,7 +4,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlocker updates interest rates
+// BeginBlocker updates interest rates, covers bad debt and distributes reserves
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	k.ApplyInterestRateUpdates(ctx)
+
+	err := k.CoverBadDebt(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.DistributeReserves(ctx)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -3,79 +3,100 @@ package hard
 // DO NOT EDIT - generated by aliasgen tool (github.com/rhuairahrighairidh/aliasgen)
 
 import (
+	"github.com/kava-labs/kava/x/hard/client"
 	"github.com/kava-labs/kava/x/hard/keeper"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
 const (
-	AttributeKeyBorrow       = types.AttributeKeyBorrow
-	AttributeKeyBorrowCoins  = types.AttributeKeyBorrowCoins
-	AttributeKeyBorrower     = types.AttributeKeyBorrower
-	AttributeKeyDeposit      = types.AttributeKeyDeposit
-	AttributeKeyDepositCoins = types.AttributeKeyDepositCoins
-	AttributeKeyDepositDenom = types.AttributeKeyDepositDenom
-	AttributeKeyDepositor    = types.AttributeKeyDepositor
-	AttributeKeyRepayCoins   = types.AttributeKeyRepayCoins
-	AttributeKeySender       = types.AttributeKeySender
-	AttributeValueCategory   = types.AttributeValueCategory
-	DefaultParamspace        = types.DefaultParamspace
-	EventTypeHardLiquidation = types.EventTypeHardLiquidation
-	EventTypeHardBorrow      = types.EventTypeHardBorrow
-	EventTypeHardDeposit     = types.EventTypeHardDeposit
-	EventTypeHardRepay       = types.EventTypeHardRepay
-	EventTypeHardWithdrawal  = types.EventTypeHardWithdrawal
-	ModuleAccountName        = types.ModuleAccountName
-	ModuleName               = types.ModuleName
-	QuerierRoute             = types.QuerierRoute
-	QueryGetBorrows          = types.QueryGetBorrows
-	QueryGetDeposits         = types.QueryGetDeposits
-	QueryGetModuleAccounts   = types.QueryGetModuleAccounts
-	QueryGetParams           = types.QueryGetParams
-	QueryGetTotalBorrowed    = types.QueryGetTotalBorrowed
-	QueryGetTotalDeposited   = types.QueryGetTotalDeposited
-	RouterKey                = types.RouterKey
-	StoreKey                 = types.StoreKey
+	AttributeKeyAmount                = types.AttributeKeyAmount
+	AttributeKeyBorrow                = types.AttributeKeyBorrow
+	AttributeKeyBorrowCoins           = types.AttributeKeyBorrowCoins
+	AttributeKeyBorrower              = types.AttributeKeyBorrower
+	AttributeKeyCoverBadDebt          = types.AttributeKeyCoverBadDebt
+	AttributeKeyDeposit               = types.AttributeKeyDeposit
+	AttributeKeyDepositCoins          = types.AttributeKeyDepositCoins
+	AttributeKeyDepositDenom          = types.AttributeKeyDepositDenom
+	AttributeKeyDepositor             = types.AttributeKeyDepositor
+	AttributeKeyDestination           = types.AttributeKeyDestination
+	AttributeKeyError                 = types.AttributeKeyError
+	AttributeKeyRecipient             = types.AttributeKeyRecipient
+	AttributeKeyRepayCoins            = types.AttributeKeyRepayCoins
+	AttributeKeySender                = types.AttributeKeySender
+	AttributeValueCategory            = types.AttributeValueCategory
+	DefaultParamspace                 = types.DefaultParamspace
+	EventTypeBadDebt                  = types.EventTypeBadDebt
+	EventTypeBadDebtCovered           = types.EventTypeBadDebtCovered
+	EventTypeBadDebtSocialized        = types.EventTypeBadDebtSocialized
+	EventTypeHardLiquidation          = types.EventTypeHardLiquidation
+	EventTypeHardBorrow               = types.EventTypeHardBorrow
+	EventTypeHardDeposit              = types.EventTypeHardDeposit
+	EventTypeHardRepay                = types.EventTypeHardRepay
+	EventTypeHardWithdrawal           = types.EventTypeHardWithdrawal
+	EventTypeReserveDistribution      = types.EventTypeReserveDistribution
+	EventTypeReserveDistributionError = types.EventTypeReserveDistributionError
+	EventTypeReserveWithdrawal        = types.EventTypeReserveWithdrawal
+	ModuleAccountName                 = types.ModuleAccountName
+	ModuleName                        = types.ModuleName
+	ProposalTypeReserveWithdrawal     = types.ProposalTypeReserveWithdrawal
+	QuerierRoute                      = types.QuerierRoute
+	QueryGetBadDebt                   = types.QueryGetBadDebt
+	QueryGetBorrows                   = types.QueryGetBorrows
+	QueryGetDeposits                  = types.QueryGetDeposits
+	QueryGetModuleAccounts            = types.QueryGetModuleAccounts
+	QueryGetParams                    = types.QueryGetParams
+	QueryGetSolvency                  = types.QueryGetSolvency
+	QueryGetTotalBorrowed             = types.QueryGetTotalBorrowed
+	QueryGetTotalDeposited            = types.QueryGetTotalDeposited
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
 )
 
 var (
 	// function aliases
-	APYToSPY                      = keeper.APYToSPY
-	SPYToEstimatedAPY             = keeper.SPYToEstimatedAPY
-	CalculateBorrowInterestFactor = keeper.CalculateBorrowInterestFactor
-	CalculateBorrowRate           = keeper.CalculateBorrowRate
-	CalculateSupplyInterestFactor = keeper.CalculateSupplyInterestFactor
-	CalculateUtilizationRatio     = keeper.CalculateUtilizationRatio
-	NewKeeper                     = keeper.NewKeeper
-	NewQuerier                    = keeper.NewQuerier
-	DefaultGenesisState           = types.DefaultGenesisState
-	DefaultParams                 = types.DefaultParams
-	DepositTypeIteratorKey        = types.DepositTypeIteratorKey
-	GetTotalVestingPeriodLength   = types.GetTotalVestingPeriodLength
-	NewBorrow                     = types.NewBorrow
-	NewBorrowInterestFactor       = types.NewBorrowInterestFactor
-	NewBorrowLimit                = types.NewBorrowLimit
-	NewDeposit                    = types.NewDeposit
-	NewGenesisAccumulationTime    = types.NewGenesisAccumulationTime
-	NewGenesisState               = types.NewGenesisState
-	NewInterestRateModel          = types.NewInterestRateModel
-	NewMoneyMarket                = types.NewMoneyMarket
-	NewMsgBorrow                  = types.NewMsgBorrow
-	NewMsgDeposit                 = types.NewMsgDeposit
-	NewMsgLiquidate               = types.NewMsgLiquidate
-	NewMsgRepay                   = types.NewMsgRepay
-	NewMsgWithdraw                = types.NewMsgWithdraw
-	NewMultiHARDHooks             = types.NewMultiHARDHooks
-	NewParams                     = types.NewParams
-	NewPeriod                     = types.NewPeriod
-	NewQueryAccountParams         = types.NewQueryAccountParams
-	NewQueryBorrowsParams         = types.NewQueryBorrowsParams
-	NewQueryDepositsParams        = types.NewQueryDepositsParams
-	NewQueryTotalBorrowedParams   = types.NewQueryTotalBorrowedParams
-	NewQueryTotalDepositedParams  = types.NewQueryTotalDepositedParams
-	NewSupplyInterestFactor       = types.NewSupplyInterestFactor
-	NewValuationMap               = types.NewValuationMap
-	ParamKeyTable                 = types.ParamKeyTable
-	RegisterCodec                 = types.RegisterCodec
+	APYToSPY                        = keeper.APYToSPY
+	HandleReserveWithdrawalProposal = keeper.HandleReserveWithdrawalProposal
+	SPYToEstimatedAPY               = keeper.SPYToEstimatedAPY
+	CalculateBorrowInterestFactor   = keeper.CalculateBorrowInterestFactor
+	CalculateBorrowRate             = keeper.CalculateBorrowRate
+	CalculateSupplyInterestFactor   = keeper.CalculateSupplyInterestFactor
+	CalculateUtilizationRatio       = keeper.CalculateUtilizationRatio
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	DefaultGenesisState             = types.DefaultGenesisState
+	DefaultParams                   = types.DefaultParams
+	DepositTypeIteratorKey          = types.DepositTypeIteratorKey
+	GetTotalVestingPeriodLength     = types.GetTotalVestingPeriodLength
+	NewBorrow                       = types.NewBorrow
+	NewBorrowInterestFactor         = types.NewBorrowInterestFactor
+	NewBorrowLimit                  = types.NewBorrowLimit
+	NewDeposit                      = types.NewDeposit
+	NewGenesisAccumulationTime      = types.NewGenesisAccumulationTime
+	NewGenesisState                 = types.NewGenesisState
+	NewInterestRateModel            = types.NewInterestRateModel
+	NewMarketSolvency               = types.NewMarketSolvency
+	NewMoneyMarket                  = types.NewMoneyMarket
+	NewMsgBorrow                    = types.NewMsgBorrow
+	NewMsgDeposit                   = types.NewMsgDeposit
+	NewMsgLiquidate                 = types.NewMsgLiquidate
+	NewMsgRepay                     = types.NewMsgRepay
+	NewMsgWithdraw                  = types.NewMsgWithdraw
+	NewMultiHARDHooks               = types.NewMultiHARDHooks
+	NewParams                       = types.NewParams
+	NewPeriod                       = types.NewPeriod
+	NewQueryAccountParams           = types.NewQueryAccountParams
+	NewQueryBadDebtParams           = types.NewQueryBadDebtParams
+	NewQueryBorrowsParams           = types.NewQueryBorrowsParams
+	NewQueryDepositsParams          = types.NewQueryDepositsParams
+	NewQuerySolvencyParams          = types.NewQuerySolvencyParams
+	NewQueryTotalBorrowedParams     = types.NewQueryTotalBorrowedParams
+	NewQueryTotalDepositedParams    = types.NewQueryTotalDepositedParams
+	NewReserveDistributionParam     = types.NewReserveDistributionParam
+	NewReserveWithdrawalProposal    = types.NewReserveWithdrawalProposal
+	NewSupplyInterestFactor         = types.NewSupplyInterestFactor
+	NewValuationMap                 = types.NewValuationMap
+	ParamKeyTable                   = types.ParamKeyTable
+	RegisterCodec                   = types.RegisterCodec
 
 	// variable aliases
 	BorrowInterestFactorPrefix          = types.BorrowInterestFactorPrefix
@@ -85,6 +106,10 @@ var (
 	DefaultBorrows                      = types.DefaultBorrows
 	DefaultDeposits                     = types.DefaultDeposits
 	DefaultMoneyMarkets                 = types.DefaultMoneyMarkets
+	DefaultReserveDistributions         = types.DefaultReserveDistributions
+	DefaultReservePolicy                = types.DefaultReservePolicy
+	DefaultSocializeBadDebt             = types.DefaultSocializeBadDebt
+	DefaultTotalBadDebt                 = types.DefaultTotalBadDebt
 	DefaultTotalBorrowed                = types.DefaultTotalBorrowed
 	DefaultTotalReserves                = types.DefaultTotalReserves
 	DefaultTotalSupplied                = types.DefaultTotalSupplied
@@ -104,10 +129,13 @@ var (
 	ErrInsufficientCoins                = types.ErrInsufficientCoins
 	ErrInsufficientLoanToValue          = types.ErrInsufficientLoanToValue
 	ErrInsufficientModAccountBalance    = types.ErrInsufficientModAccountBalance
+	ErrInsufficientReserves             = types.ErrInsufficientReserves
 	ErrInvalidAccountType               = types.ErrInvalidAccountType
+	ErrInvalidBuyback                   = types.ErrInvalidBuyback
 	ErrInvalidDepositDenom              = types.ErrInvalidDepositDenom
 	ErrInvalidReceiver                  = types.ErrInvalidReceiver
 	ErrInvalidRepaymentDenom            = types.ErrInvalidRepaymentDenom
+	ErrInvalidReserveWithdrawal         = types.ErrInvalidReserveWithdrawal
 	ErrInvalidWithdrawAmount            = types.ErrInvalidWithdrawAmount
 	ErrInvalidWithdrawDenom             = types.ErrInvalidWithdrawDenom
 	ErrMarketNotFound                   = types.ErrMarketNotFound
@@ -120,15 +148,21 @@ var (
 	ErrReservesExceedCash               = types.ErrReservesExceedCash
 	GovDenom                            = types.GovDenom
 	KeyMoneyMarkets                     = types.KeyMoneyMarkets
+	KeyReserveDistributionPolicy        = types.KeyReserveDistributionPolicy
+	KeyReserveDistributions             = types.KeyReserveDistributions
+	KeySocializeBadDebt                 = types.KeySocializeBadDebt
 	ModuleCdc                           = types.ModuleCdc
 	MoneyMarketsPrefix                  = types.MoneyMarketsPrefix
 	PreviousAccrualTimePrefix           = types.PreviousAccrualTimePrefix
 	SuppliedCoinsPrefix                 = types.SuppliedCoinsPrefix
 	SupplyInterestFactorPrefix          = types.SupplyInterestFactorPrefix
+	TotalBadDebtPrefix                  = types.TotalBadDebtPrefix
 	TotalReservesPrefix                 = types.TotalReservesPrefix
+	ProposalHandler                     = client.ProposalHandler
 )
 
 type (
+	AuctionHooks              = keeper.AuctionHooks
 	Keeper                    = keeper.Keeper
 	LiqData                   = keeper.LiqData
 	AccountKeeper             = types.AccountKeeper
@@ -146,6 +180,8 @@ type (
 	HARDHooks                 = types.HARDHooks
 	InterestRateModel         = types.InterestRateModel
 	InterestRateModels        = types.InterestRateModels
+	MarketSolvencies          = types.MarketSolvencies
+	MarketSolvency            = types.MarketSolvency
 	MoneyMarket               = types.MoneyMarket
 	MoneyMarkets              = types.MoneyMarkets
 	MsgBorrow                 = types.MsgBorrow
@@ -157,10 +193,16 @@ type (
 	Params                    = types.Params
 	PricefeedKeeper           = types.PricefeedKeeper
 	QueryAccountParams        = types.QueryAccountParams
+	QueryBadDebtParams        = types.QueryBadDebtParams
 	QueryBorrowsParams        = types.QueryBorrowsParams
 	QueryDepositsParams       = types.QueryDepositsParams
+	QuerySolvencyParams       = types.QuerySolvencyParams
 	QueryTotalBorrowedParams  = types.QueryTotalBorrowedParams
 	QueryTotalDepositedParams = types.QueryTotalDepositedParams
+	ReserveDistributionParam  = types.ReserveDistributionParam
+	ReserveDistributionParams = types.ReserveDistributionParams
+	ReserveWithdrawalProposal = types.ReserveWithdrawalProposal
+	SendRestriction           = types.SendRestriction
 	StakingKeeper             = types.StakingKeeper
 	SupplyInterestFactor      = types.SupplyInterestFactor
 	SupplyInterestFactors     = types.SupplyInterestFactors
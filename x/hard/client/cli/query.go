@@ -46,6 +46,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		queryInterestRateCmd(queryRoute, cdc),
 		queryReserves(queryRoute, cdc),
 		queryInterestFactorsCmd(queryRoute, cdc),
+		queryBadDebtCmd(queryRoute, cdc),
+		querySolvencyCmd(queryRoute, cdc),
 	)...)
 
 	return hardQueryCmd
@@ -568,3 +570,89 @@ func queryInterestFactorsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command
 	cmd.Flags().String(flagDenom, "", "(optional) filter interest factors by denom")
 	return cmd
 }
+
+func queryBadDebtCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bad-debt",
+		Short: "get Hard module bad debt",
+		Long: strings.TrimSpace(`get the bad debt of each market that hasn't been covered by reserves or socialized yet:
+
+		Example:
+		$ kvcli q hard bad-debt
+		$ kvcli q hard bad-debt --denom bnb`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+
+			// Construct query with params
+			params := types.NewQueryBadDebtParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetBadDebt)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var badDebt sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &badDebt); err != nil {
+				return fmt.Errorf("failed to unmarshal bad debt coins: %w", err)
+			}
+			return cliCtx.PrintOutput(badDebt)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter bad debt coins by denom")
+	return cmd
+}
+
+func querySolvencyCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "solvency",
+		Short: "get the solvency of Hard money markets",
+		Long: strings.TrimSpace(`get the supplied, borrowed, reserve, bad debt and cash balances of each money market:
+
+		Example:
+		$ kvcli q hard solvency
+		$ kvcli q hard solvency --denom bnb`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+
+			// Construct query with params
+			params := types.NewQuerySolvencyParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSolvency)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var solvencies types.MarketSolvencies
+			if err := cdc.UnmarshalJSON(res, &solvencies); err != nil {
+				return fmt.Errorf("failed to unmarshal market solvencies: %w", err)
+			}
+			return cliCtx.PrintOutput(solvencies)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter market solvencies by denom")
+	return cmd
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
@@ -196,3 +197,66 @@ func getCmdLiquidate(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdSubmitReserveWithdrawalProposal implements the command to submit a hard reserve withdrawal proposal
+func GetCmdSubmitReserveWithdrawalProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hard-reserve-withdrawal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a hard reserve withdrawal proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a hard reserve withdrawal proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. To cover bad debt instead of
+paying a recipient, leave the recipient empty and set cover_bad_debt to true.
+
+Example:
+$ %s tx gov submit-proposal hard-reserve-withdrawal <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Hard Reserve Withdrawal",
+  "description": "Fund a security audit from the protocol reserves",
+  "recipient": "kava1mz2003lathm95n5vnlthmtfvrzrjkrr53j4464",
+  "cover_bad_debt": false,
+  "amount": [
+    {
+      "denom": "ukava",
+      "amount": "1000000"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseReserveWithdrawalProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewReserveWithdrawalProposal(proposal.Title, proposal.Description, proposal.Recipient, proposal.CoverBadDebt, proposal.Amount)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
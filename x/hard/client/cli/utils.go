@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// ReserveWithdrawalProposalJSON defines a ReserveWithdrawalProposal with a deposit
+	ReserveWithdrawalProposalJSON struct {
+		Title        string         `json:"title" yaml:"title"`
+		Description  string         `json:"description" yaml:"description"`
+		Recipient    sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		CoverBadDebt bool           `json:"cover_bad_debt" yaml:"cover_bad_debt"`
+		Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+		Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseReserveWithdrawalProposalJSON reads and parses a ReserveWithdrawalProposalJSON from a file.
+func ParseReserveWithdrawalProposalJSON(cdc *codec.Codec, proposalFile string) (ReserveWithdrawalProposalJSON, error) {
+	proposal := ReserveWithdrawalProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
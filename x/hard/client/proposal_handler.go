@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/kava-labs/kava/x/hard/client/cli"
+	"github.com/kava-labs/kava/x/hard/client/rest"
+)
+
+// reserve withdrawal proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitReserveWithdrawalProposal, rest.ProposalRESTHandler)
+)
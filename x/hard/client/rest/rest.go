@@ -57,3 +57,16 @@ type PostLiquidateReq struct {
 	From     sdk.AccAddress `json:"from" yaml:"from"`
 	Borrower sdk.AccAddress `json:"borrower" yaml:"borrower"`
 }
+
+// ReserveWithdrawalProposalReq defines a hard reserve withdrawal proposal request body.
+type ReserveWithdrawalProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title        string         `json:"title" yaml:"title"`
+	Description  string         `json:"description" yaml:"description"`
+	Recipient    sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	CoverBadDebt bool           `json:"cover_bad_debt" yaml:"cover_bad_debt"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer     sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
@@ -37,6 +37,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs
 	k.SetSuppliedCoins(ctx, gs.TotalSupplied)
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
+	k.SetTotalBadDebt(ctx, gs.TotalBadDebt)
 
 	// check if the module account exists
 	DepositModuleAccount := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
@@ -85,6 +86,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	if !found {
 		totalReserves = DefaultTotalReserves
 	}
+	totalBadDebt, found := k.GetTotalBadDebt(ctx)
+	if !found {
+		totalBadDebt = DefaultTotalBadDebt
+	}
 
 	for _, mm := range params.MoneyMarkets {
 		supplyFactor, f := k.GetSupplyInterestFactor(ctx, mm.Denom)
@@ -110,6 +115,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	}
 	return NewGenesisState(
 		params, gats, deposits, borrows,
-		totalSupplied, totalBorrowed, totalReserves,
+		totalSupplied, totalBorrowed, totalReserves, totalBadDebt,
 	)
 }
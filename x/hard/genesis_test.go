@@ -44,6 +44,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			hard.NewMoneyMarket("ukava", hard.NewBorrowLimit(false, sdk.NewDec(1e15), loanToValue), "kava:usd", sdk.NewInt(1e6), hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 		},
 		sdk.NewDec(10),
+		hard.DefaultReserveDistributions, hard.DefaultReservePolicy, hard.DefaultSocializeBadDebt,
 	)
 
 	deposits := hard.Deposits{
@@ -96,6 +97,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		totalSupplied,
 		totalBorrowed,
 		nil,
+		nil,
 	)
 
 	suite.NotPanics(
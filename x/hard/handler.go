@@ -3,6 +3,7 @@ package hard
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/hard/keeper"
 	"github.com/kava-labs/kava/x/hard/types"
@@ -118,3 +119,16 @@ func handleMsgLiquidate(ctx sdk.Context, k keeper.Keeper, msg types.MsgLiquidate
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// NewReserveWithdrawalProposalHandler creates a gov handler for hard reserve withdrawal proposals
+func NewReserveWithdrawalProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.ReserveWithdrawalProposal:
+			return keeper.HandleReserveWithdrawalProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized hard proposal content type: %T", c)
+		}
+	}
+}
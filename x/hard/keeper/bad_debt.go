@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// AuctionHooks wraps the hard keeper to record bad debt from hard liquidation auctions
+type AuctionHooks struct {
+	k Keeper
+}
+
+var _ auctiontypes.AuctionHooks = AuctionHooks{}
+
+// AuctionHooks returns the wrapper struct for auction hooks
+func (k Keeper) AuctionHooks() AuctionHooks {
+	return AuctionHooks{k}
+}
+
+// AfterCollateralAuctionClosed records the part of a liquidated borrow that a hard liquidation auction didn't raise as bad debt.
+// The bad debt is covered by the hard begin blocker.
+func (h AuctionHooks) AfterCollateralAuctionClosed(ctx sdk.Context, auction auctiontypes.CollateralAuction) {
+	if auction.Initiator != types.ModuleAccountName {
+		return
+	}
+	if !auction.Bid.IsLT(auction.MaxBid) {
+		return
+	}
+	shortfall := auction.MaxBid.Sub(auction.Bid)
+	h.k.RecordBadDebt(ctx, sdk.NewCoins(shortfall))
+}
+
+// RecordBadDebt adds to the bad debt of each market
+func (k Keeper) RecordBadDebt(ctx sdk.Context, coins sdk.Coins) {
+	if coins.Empty() {
+		return
+	}
+	badDebt, _ := k.GetTotalBadDebt(ctx)
+	k.SetTotalBadDebt(ctx, badDebt.Add(coins...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBadDebt,
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// CoverBadDebt covers each market's bad debt from its reserves. If bad debt socialization is enabled, bad debt that
+// reserves can't cover is spread across the market's suppliers by decreasing the supply interest factor.
+// Bad debt that is as large as the market's total supplied coins is left uncovered.
+func (k Keeper) CoverBadDebt(ctx sdk.Context) error {
+	badDebt, found := k.GetTotalBadDebt(ctx)
+	if !found || badDebt.Empty() {
+		return nil
+	}
+	params := k.GetParams(ctx)
+
+	for _, debt := range badDebt {
+		reserves, _ := k.GetTotalReserves(ctx)
+		covered := sdk.MinInt(debt.Amount, reserves.AmountOf(debt.Denom))
+		if covered.IsPositive() {
+			coveredCoins := sdk.NewCoins(sdk.NewCoin(debt.Denom, covered))
+			k.SetTotalReserves(ctx, reserves.Sub(coveredCoins))
+			k.reduceBadDebt(ctx, coveredCoins)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBadDebtCovered,
+					sdk.NewAttribute(types.AttributeKeyAmount, coveredCoins.String()),
+				),
+			)
+		}
+
+		remaining := debt.Amount.Sub(covered)
+		if !params.SocializeBadDebt || !remaining.IsPositive() {
+			continue
+		}
+		supplied, _ := k.GetSuppliedCoins(ctx)
+		totalSupplied := supplied.AmountOf(debt.Denom)
+		if remaining.GTE(totalSupplied) {
+			continue
+		}
+		if err := k.socializeBadDebt(ctx, sdk.NewCoin(debt.Denom, remaining), totalSupplied); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// socializeBadDebt decreases a market's supply interest factor so that its suppliers' deposits shrink by the bad debt
+func (k Keeper) socializeBadDebt(ctx sdk.Context, debt sdk.Coin, totalSupplied sdk.Int) error {
+	factor, found := k.GetSupplyInterestFactor(ctx, debt.Denom)
+	if !found {
+		factor = sdk.OneDec()
+	}
+	remainingShare := totalSupplied.Sub(debt.Amount).ToDec().Quo(totalSupplied.ToDec())
+	k.SetSupplyInterestFactor(ctx, debt.Denom, factor.Mul(remainingShare))
+
+	debtCoins := sdk.NewCoins(debt)
+	if err := k.DecrementSuppliedCoins(ctx, debtCoins); err != nil {
+		return err
+	}
+	k.reduceBadDebt(ctx, debtCoins)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBadDebtSocialized,
+			sdk.NewAttribute(types.AttributeKeyAmount, debt.String()),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) reduceBadDebt(ctx sdk.Context, coins sdk.Coins) {
+	badDebt, _ := k.GetTotalBadDebt(ctx)
+	k.SetTotalBadDebt(ctx, badDebt.Sub(coins))
+}
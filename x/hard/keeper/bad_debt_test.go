@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordBadDebtFromAuction() {
+	sk := suite.app.GetSupplyKeeper()
+	ak := suite.app.GetAuctionKeeper()
+	lot := sdk.NewCoin("bnb", sdk.NewInt(100))
+	suite.Require().NoError(sk.MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(lot, sdk.NewCoin("usdx", sdk.NewInt(400)))))
+	suite.Require().NoError(sk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleAccountName, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(400)))))
+	suite.keeper.SetTotalReserves(suite.ctx, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(250))))
+
+	// a hard liquidation auction that only raises 400 of the 1000 usdx borrowed
+	id, err := ak.StartCollateralAuction(suite.ctx, types.ModuleAccountName, lot, sdk.NewCoin("usdx", sdk.NewInt(1000)),
+		[]sdk.AccAddress{suite.addrs[0]}, []sdk.Int{sdk.NewInt(1)}, sdk.NewCoin("debt", sdk.ZeroInt()))
+	suite.Require().NoError(err)
+	suite.Require().NoError(ak.PlaceBid(suite.ctx, id, suite.addrs[0], sdk.NewCoin("usdx", sdk.NewInt(400))))
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(48 * time.Hour))
+	suite.Require().NoError(ak.CloseAuction(ctx, id))
+
+	// the 600 usdx shortfall is recorded, and left for the begin blocker to cover
+	badDebt, _ := suite.keeper.GetTotalBadDebt(ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(600))), badDebt)
+	reserves, _ := suite.keeper.GetTotalReserves(ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(250))), reserves)
+
+	// reserves cover 250 of it in the next block
+	suite.Require().NoError(suite.keeper.CoverBadDebt(ctx))
+	badDebt, _ = suite.keeper.GetTotalBadDebt(ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(350))), badDebt)
+	reserves, _ = suite.keeper.GetTotalReserves(ctx)
+	suite.Require().True(reserves.Empty())
+}
+
+func (suite *KeeperTestSuite) TestCoverBadDebt() {
+	depositor := suite.addrs[0]
+	suite.keeper.SetSupplyInterestFactor(suite.ctx, "bnb", sdk.OneDec())
+	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000))),
+		types.SupplyInterestFactors{types.NewSupplyInterestFactor("bnb", sdk.OneDec())}))
+	suite.keeper.SetSuppliedCoins(suite.ctx, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000))))
+	suite.keeper.SetTotalReserves(suite.ctx, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(50))))
+	suite.keeper.RecordBadDebt(suite.ctx, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(150))))
+
+	// without socialization, reserves cover what they can and the rest stays as bad debt
+	suite.Require().NoError(suite.keeper.CoverBadDebt(suite.ctx))
+	badDebt, _ := suite.keeper.GetTotalBadDebt(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))), badDebt)
+	reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().True(reserves.Empty())
+
+	// with socialization, suppliers' deposits shrink by the remaining bad debt
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SocializeBadDebt = true
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.keeper.CoverBadDebt(suite.ctx))
+
+	badDebt, _ = suite.keeper.GetTotalBadDebt(suite.ctx)
+	suite.Require().True(badDebt.Empty())
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(900))), supplied)
+	factor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "bnb")
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.9"), factor)
+
+	deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(900))), deposit.Amount)
+	suite.keeper.SyncSupplyInterest(suite.ctx, depositor)
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(900))), deposit.Amount)
+}
@@ -41,6 +41,11 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 		return err
 	}
 
+	err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), borrower, coins)
+	if err != nil {
+		return err
+	}
+
 	// Sends coins from Hard module account to user
 	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, coins)
 	if err != nil {
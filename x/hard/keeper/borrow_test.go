@@ -314,8 +314,10 @@ func (suite *KeeperTestSuite) TestBorrow() {
 					types.NewMoneyMarket("xyz", types.NewBorrowLimit(false, sdk.NewDec(1), tc.args.loanToValueBNB), "xyz:usd", sdk.NewInt(1), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.NewDec(10),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 
 			// Pricefeed module genesis state
@@ -463,6 +465,7 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
 			},
 			sdk.NewDec(10),
+			types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 		),
 		types.DefaultAccumulationTimes,
 		types.DefaultDeposits,
@@ -470,6 +473,7 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 		types.DefaultTotalSupplied,
 		types.DefaultTotalBorrowed,
 		types.DefaultTotalReserves,
+		types.DefaultTotalBadDebt,
 	)
 
 	// Pricefeed module genesis state
@@ -37,6 +37,10 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
+	err = k.validateTransfer(ctx, depositor, k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), coins)
+	if err != nil {
+		return err
+	}
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
@@ -172,6 +176,7 @@ func (k Keeper) GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (typ
 // loadSyncedDeposit calculates a user's synced deposit, but does not update state
 func (k Keeper) loadSyncedDeposit(ctx sdk.Context, deposit types.Deposit) types.Deposit {
 	totalNewInterest := sdk.Coins{}
+	totalLosses := sdk.Coins{}
 	newSupplyIndexes := types.SupplyInterestFactors{}
 	for _, coin := range deposit.Amount {
 		interestFactorValue, foundInterestFactorValue := k.GetSupplyInterestFactor(ctx, coin.Denom)
@@ -190,7 +195,13 @@ func (k Keeper) loadSyncedDeposit(ctx sdk.Context, deposit types.Deposit) types.
 				storedAmount := sdk.NewDecFromInt(deposit.Amount.AmountOf(coin.Denom))
 				userLastInterestFactor := deposit.Index[foundAtIndex].Value
 				coinInterest := (storedAmount.Quo(userLastInterestFactor).Mul(interestFactorValue)).Sub(storedAmount)
-				totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, coinInterest.TruncateInt()))
+				if coinInterest.IsNegative() {
+					// socialized bad debt has decreased the supply interest factor
+					loss := sdk.MinInt(coinInterest.Neg().TruncateInt(), coin.Amount)
+					totalLosses = totalLosses.Add(sdk.NewCoin(coin.Denom, loss))
+				} else {
+					totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, coinInterest.TruncateInt()))
+				}
 			}
 		}
 
@@ -198,5 +209,5 @@ func (k Keeper) loadSyncedDeposit(ctx sdk.Context, deposit types.Deposit) types.
 		newSupplyIndexes = append(newSupplyIndexes, supplyIndex)
 	}
 
-	return types.NewDeposit(deposit.Depositor, deposit.Amount.Add(totalNewInterest...), newSupplyIndexes)
+	return types.NewDeposit(deposit.Depositor, deposit.Amount.Add(totalNewInterest...).Sub(totalLosses), newSupplyIndexes)
 }
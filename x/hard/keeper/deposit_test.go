@@ -112,8 +112,10 @@ func (suite *KeeperTestSuite) TestDeposit() {
 					types.NewMoneyMarket("btcb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "btcb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.NewDec(10),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 
 			// Pricefeed module genesis state
@@ -270,8 +272,10 @@ func (suite *KeeperTestSuite) TestDecrementSuppliedCoins() {
 					types.NewMoneyMarket("xrpb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "xrpb:usd", sdk.NewInt(100000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.MustNewDecFromStr("10"),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 			// Pricefeed module genesis state
 			pricefeedGS := pricefeed.GenesisState{
@@ -258,6 +258,7 @@ func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 // SyncSupplyInterest updates the user's earned interest on supplied coins based on the latest global state
 func (k Keeper) SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	totalNewInterest := sdk.Coins{}
+	totalLosses := sdk.Coins{}
 
 	// Update user's supply index list for each asset in the 'coins' array.
 	// We use a list of SupplyInterestFactors here because Amino doesn't support marshaling maps.
@@ -287,12 +288,17 @@ func (k Keeper) SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress) {
 			if interest.TruncateInt().GT(sdk.ZeroInt()) {
 				totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, interest.TruncateInt()))
 			}
+			// The supply interest factor decreases when bad debt is socialized, which is a loss to the user
+			if interest.IsNegative() {
+				loss := sdk.MinInt(interest.Neg().TruncateInt(), coin.Amount)
+				totalLosses = totalLosses.Add(sdk.NewCoin(coin.Denom, loss))
+			}
 			// We're synced up, so update user's deposit index value to match the current global deposit index value
 			deposit.Index[foundAtIndex].Value = interestFactorValue
 		}
 	}
-	// Add all pending interest to user's deposit
-	deposit.Amount = deposit.Amount.Add(totalNewInterest...)
+	// Add all pending interest to user's deposit and remove any losses
+	deposit.Amount = deposit.Amount.Add(totalNewInterest...).Sub(totalLosses)
 
 	// Update user's deposit in the store
 	k.SetDeposit(ctx, deposit)
@@ -828,8 +828,10 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 
 			// Pricefeed module genesis state
@@ -1242,8 +1244,10 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 
 			// Pricefeed module genesis state
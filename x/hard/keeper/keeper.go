@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/kava-labs/kava/buyback"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
@@ -21,13 +22,15 @@ type Keeper struct {
 	stakingKeeper   types.StakingKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	buyback         buyback.Buyback
 	hooks           types.HARDHooks
+	sendRestriction types.SendRestriction
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 	ak types.AccountKeeper, sk types.SupplyKeeper, stk types.StakingKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper) Keeper {
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, swk types.SwapKeeper) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
@@ -41,6 +44,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		stakingKeeper:   stk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		buyback:         buyback.NewBuyback(sk, swk, types.ModuleAccountName),
 		hooks:           nil,
 	}
 }
@@ -54,6 +58,23 @@ func (k *Keeper) SetHooks(hooks types.HARDHooks) *Keeper {
 	return k
 }
 
+// SetSendRestriction sets the restriction checked when coins move between accounts and the hard module
+func (k *Keeper) SetSendRestriction(sr types.SendRestriction) *Keeper {
+	if k.sendRestriction != nil {
+		panic("cannot set hard send restriction twice")
+	}
+	k.sendRestriction = sr
+	return k
+}
+
+// validateTransfer checks a transfer against the send restriction, if one is set
+func (k Keeper) validateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if k.sendRestriction == nil {
+		return nil
+	}
+	return k.sendRestriction.ValidateTransfer(ctx, from, to, coins)
+}
+
 // GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
 func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
@@ -221,7 +242,8 @@ func (k Keeper) DeleteMoneyMarket(ctx sdk.Context, denom string) {
 }
 
 // IterateMoneyMarkets iterates over all money markets objects in the store and performs a callback function
-// 		that returns both the money market and the key (denom) it's stored under
+//
+//	that returns both the money market and the key (denom) it's stored under
 func (k Keeper) IterateMoneyMarkets(ctx sdk.Context, cb func(denom string, moneyMarket types.MoneyMarket) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketsPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -287,6 +309,29 @@ func (k Keeper) SetTotalReserves(ctx sdk.Context, coins sdk.Coins) {
 	store.Set([]byte{}, bz)
 }
 
+// GetTotalBadDebt returns the bad debt of each market that hasn't been covered or socialized yet
+func (k Keeper) GetTotalBadDebt(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalBadDebtPrefix)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var totalBadDebt sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &totalBadDebt)
+	return totalBadDebt, true
+}
+
+// SetTotalBadDebt sets the bad debt of each market
+func (k Keeper) SetTotalBadDebt(ctx sdk.Context, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalBadDebtPrefix)
+	if coins.Empty() {
+		store.Set([]byte{}, []byte{})
+		return
+	}
+	bz := k.cdc.MustMarshalBinaryBare(coins)
+	store.Set([]byte{}, bz)
+}
+
 // GetBorrowInterestFactor returns the current borrow interest factor for an individual market
 func (k Keeper) GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
@@ -593,8 +593,10 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						tc.args.keeperRewardPercent), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 
 			// Pricefeed module genesis state
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// HandleReserveWithdrawalProposal is a handler for executing a passed reserve withdrawal proposal
+func HandleReserveWithdrawalProposal(ctx sdk.Context, k Keeper, p types.ReserveWithdrawalProposal) error {
+	reserves, _ := k.GetTotalReserves(ctx)
+	if !p.Amount.IsAllLTE(reserves) {
+		return sdkerrors.Wrapf(types.ErrInsufficientReserves, "withdrawal %s exceeds reserves %s", p.Amount, reserves)
+	}
+
+	// reserves used to cover bad debt stay in the module account, where they back suppliers' deposits
+	if p.CoverBadDebt {
+		badDebt, _ := k.GetTotalBadDebt(ctx)
+		if !p.Amount.IsAllLTE(badDebt) {
+			return sdkerrors.Wrapf(types.ErrInvalidReserveWithdrawal, "withdrawal %s exceeds bad debt %s", p.Amount, badDebt)
+		}
+		k.reduceBadDebt(ctx, p.Amount)
+	} else {
+		cash := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins()
+		if !p.Amount.IsAllLTE(cash) {
+			return sdkerrors.Wrapf(types.ErrInsufficientReserves, "withdrawal %s exceeds available cash %s", p.Amount, cash)
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, p.Recipient, p.Amount)
+		if err != nil {
+			return err
+		}
+	}
+	k.SetTotalReserves(ctx, reserves.Sub(p.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReserveWithdrawal,
+			sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyCoverBadDebt, strconv.FormatBool(p.CoverBadDebt)),
+			sdk.NewAttribute(types.AttributeKeyAmount, p.Amount.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleReserveWithdrawalProposal() {
+	reserves := sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000)), sdk.NewCoin("ukava", sdk.NewInt(500)))
+	badDebt := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(700)))
+
+	testCases := []struct {
+		name             string
+		proposal         types.ReserveWithdrawalProposal
+		expectedReserves sdk.Coins
+		expectedCash     sdk.Coins
+		expectedBadDebt  sdk.Coins
+		expectPass       bool
+		expectedErr      string
+	}{
+		{
+			name:             "withdraw to recipient",
+			proposal:         types.NewReserveWithdrawalProposal("A Title", "A description", suite.addrs[0], false, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(400)))),
+			expectedReserves: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(600)), sdk.NewCoin("ukava", sdk.NewInt(500))),
+			expectedCash:     sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(600)), sdk.NewCoin("ukava", sdk.NewInt(500))),
+			expectedBadDebt:  badDebt,
+			expectPass:       true,
+		},
+		{
+			name:             "cover bad debt",
+			proposal:         types.NewReserveWithdrawalProposal("A Title", "A description", nil, true, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(500)))),
+			expectedReserves: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000))),
+			expectedCash:     reserves,
+			expectedBadDebt:  sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(200))),
+			expectPass:       true,
+		},
+		{
+			name:        "exceeds bad debt",
+			proposal:    types.NewReserveWithdrawalProposal("A Title", "A description", nil, true, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100)))),
+			expectPass:  false,
+			expectedErr: "exceeds bad debt",
+		},
+		{
+			name:        "exceeds reserves",
+			proposal:    types.NewReserveWithdrawalProposal("A Title", "A description", suite.addrs[0], false, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1001)))),
+			expectPass:  false,
+			expectedErr: "insufficient reserves",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.app.GetSupplyKeeper().MintCoins(suite.ctx, types.ModuleAccountName, reserves))
+			suite.keeper.SetTotalReserves(suite.ctx, reserves)
+			suite.keeper.SetTotalBadDebt(suite.ctx, badDebt)
+
+			err := keeper.HandleReserveWithdrawalProposal(suite.ctx, suite.keeper, tc.proposal)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				totalReserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+				suite.Require().Equal(tc.expectedReserves, totalReserves)
+				suite.Require().Equal(tc.expectedCash, suite.getModuleAccount(types.ModuleAccountName).GetCoins())
+				totalBadDebt, _ := suite.keeper.GetTotalBadDebt(suite.ctx)
+				suite.Require().Equal(tc.expectedBadDebt, totalBadDebt)
+				if !tc.proposal.CoverBadDebt {
+					suite.Require().Equal(tc.proposal.Amount, suite.getAccount(tc.proposal.Recipient).GetCoins())
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
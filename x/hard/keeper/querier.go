@@ -38,6 +38,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetReserves(ctx, req, k)
 		case types.QueryGetInterestFactors:
 			return queryGetInterestFactors(ctx, req, k)
+		case types.QueryGetBadDebt:
+			return queryGetBadDebt(ctx, req, k)
+		case types.QueryGetSolvency:
+			return queryGetSolvency(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -528,3 +532,61 @@ func queryGetInterestFactors(ctx sdk.Context, req abci.RequestQuery, k Keeper) (
 
 	return bz, nil
 }
+
+func queryGetBadDebt(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBadDebtParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	badDebt, found := k.GetTotalBadDebt(ctx)
+	if !found {
+		badDebt = sdk.Coins{}
+	}
+
+	// If user specified a denom only return coins of that denom type
+	if len(params.Denom) > 0 {
+		badDebt = sdk.NewCoins(sdk.NewCoin(params.Denom, badDebt.AmountOf(params.Denom)))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, badDebt)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetSolvency(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySolvencyParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	supplied, _ := k.GetSuppliedCoins(ctx)
+	borrowed, _ := k.GetBorrowedCoins(ctx)
+	reserves, _ := k.GetTotalReserves(ctx)
+	badDebt, _ := k.GetTotalBadDebt(ctx)
+	cash := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins()
+
+	var solvencies types.MarketSolvencies
+	for _, mm := range k.GetAllMoneyMarkets(ctx) {
+		// If user specified a denom only return the solvency of that market
+		if len(params.Denom) > 0 && mm.Denom != params.Denom {
+			continue
+		}
+		solvencies = append(solvencies, types.NewMarketSolvency(
+			mm.Denom, supplied.AmountOf(mm.Denom), borrowed.AmountOf(mm.Denom),
+			reserves.AmountOf(mm.Denom), badDebt.AmountOf(mm.Denom), cash.AmountOf(mm.Denom),
+		))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, solvencies)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
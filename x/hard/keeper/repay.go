@@ -34,6 +34,11 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 		return err
 	}
 
+	err = k.validateTransfer(ctx, sender, k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), payment)
+	if err != nil {
+		return err
+	}
+
 	// Sends coins from user to Hard module account
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleAccountName, payment)
 	if err != nil {
@@ -237,8 +237,10 @@ func (suite *KeeperTestSuite) TestRepay() {
 						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalBadDebt,
 			)
 
 			// Pricefeed module genesis state
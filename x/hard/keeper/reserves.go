@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// DistributeReserves distributes up to one lot of each denom's reserves while they are at or above the denom's threshold,
+// using the reserve distribution policy. Reserves that are lent out can't be distributed.
+func (k Keeper) DistributeReserves(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	policy := params.ReservePolicy
+	if len(policy) == 0 {
+		policy = cdptypes.DefaultSurplusPolicy
+	}
+
+	for _, rdp := range params.ReserveDistributions {
+		reserves, found := k.GetTotalReserves(ctx)
+		if !found {
+			return nil
+		}
+		reserve := reserves.AmountOf(rdp.Denom)
+		if reserve.IsZero() || reserve.LT(rdp.Threshold) {
+			continue
+		}
+		cash := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins().AmountOf(rdp.Denom)
+		amount := sdk.MinInt(sdk.MinInt(rdp.Lot, reserve), cash)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		distributed, err := k.distributeReserve(ctx, sdk.NewCoin(rdp.Denom, amount), policy)
+		if err != nil {
+			return err
+		}
+		if distributed.IsPositive() {
+			k.SetTotalReserves(ctx, reserves.Sub(sdk.NewCoins(sdk.NewCoin(rdp.Denom, distributed))))
+		}
+	}
+	return nil
+}
+
+// distributeReserve splits a lot of reserves between the destinations of a policy and returns the amount distributed.
+// Shares that can't be distributed stay in the reserves.
+func (k Keeper) distributeReserve(ctx sdk.Context, lot sdk.Coin, policy cdptypes.SurplusDistributionPolicy) (sdk.Int, error) {
+	distributed := sdk.ZeroInt()
+	shares := policy.Split(lot.Amount)
+	for i, sd := range policy {
+		share := sdk.NewCoin(lot.Denom, shares[i])
+		if !share.IsPositive() {
+			continue
+		}
+
+		switch sd.Destination {
+		case cdptypes.SurplusDestinationAuction:
+			// reserves of the gov denom don't need to be sold for it, they are burned directly
+			if share.Denom == types.GovDenom {
+				if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleAccountName, sdk.NewCoins(share)); err != nil {
+					return distributed, err
+				}
+			} else if _, err := k.auctionKeeper.StartSurplusAuction(ctx, types.ModuleAccountName, share, types.GovDenom); err != nil {
+				return distributed, err
+			}
+		case cdptypes.SurplusDestinationBuyback:
+			if share.Denom == types.GovDenom {
+				if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleAccountName, sdk.NewCoins(share)); err != nil {
+					return distributed, err
+				}
+			} else if err := k.BuybackAndBurn(ctx, share, sd.MaxSlippage); err != nil {
+				emitReserveDistributionError(ctx, err)
+				continue
+			}
+		case cdptypes.SurplusDestinationModuleAccount:
+			if k.supplyKeeper.GetModuleAddress(sd.ModuleAccount) == nil {
+				emitReserveDistributionError(ctx, fmt.Errorf("module account %s does not exist", sd.ModuleAccount))
+				continue
+			}
+			if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, sd.ModuleAccount, sdk.NewCoins(share)); err != nil {
+				return distributed, err
+			}
+		default:
+			return distributed, fmt.Errorf("invalid reserve destination: %s", sd.Destination)
+		}
+
+		distributed = distributed.Add(share.Amount)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReserveDistribution,
+				sdk.NewAttribute(types.AttributeKeyDestination, sd.Destination),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+	return distributed, nil
+}
+
+// BuybackAndBurn swaps reserves held by the hard module account for the gov denom through x/swap and burns the gov denom bought.
+// The swap fails if its output is more than maxSlippage less than the reserves are worth in the gov denom at pricefeed prices.
+func (k Keeper) BuybackAndBurn(ctx sdk.Context, reserve sdk.Coin, maxSlippage sdk.Dec) error {
+	reservePrice, err := k.getBuybackPrice(ctx, reserve.Denom)
+	if err != nil {
+		return err
+	}
+	govPrice, err := k.getBuybackPrice(ctx, types.GovDenom)
+	if err != nil {
+		return err
+	}
+	if err := k.buyback.BuyAndBurn(ctx, reserve, reservePrice, types.GovDenom, govPrice, maxSlippage); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidBuyback, err.Error())
+	}
+	return nil
+}
+
+// getBuybackPrice returns the USD value of one base unit of a denom, from the spot price of its money market
+func (k Keeper) getBuybackPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidBuyback, "no money market for %s", denom)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidBuyback, "no price for %s: %s", denom, err)
+	}
+	return price.Price.Quo(mm.ConversionFactor.ToDec()), nil
+}
+
+func emitReserveDistributionError(ctx sdk.Context, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReserveDistributionError,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributeReserves() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ReserveDistributions = types.ReserveDistributionParams{
+		types.NewReserveDistributionParam("bnb", sdk.NewInt(1000), sdk.NewInt(500)),
+	}
+	params.ReservePolicy = cdptypes.SurplusDistributionPolicy{
+		cdptypes.NewSurplusDistribution(cdptypes.SurplusDestinationModuleAccount, cdptypes.TreasuryMacc, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+		cdptypes.NewSurplusDistribution(cdptypes.SurplusDestinationAuction, "", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	sk := suite.app.GetSupplyKeeper()
+	suite.Require().NoError(sk.MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1200)))))
+	suite.keeper.SetTotalReserves(suite.ctx, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1200))))
+
+	// reserves are above the threshold, so one lot is distributed
+	suite.Require().NoError(suite.keeper.DistributeReserves(suite.ctx))
+	reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(700))), reserves)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(250))), suite.getModuleAccount(cdptypes.TreasuryMacc).GetCoins())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(250))), suite.getModuleAccount(auction.ModuleName).GetCoins())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(700))), suite.getModuleAccount(types.ModuleAccountName).GetCoins())
+
+	// reserves are below the threshold, so nothing is distributed
+	suite.Require().NoError(suite.keeper.DistributeReserves(suite.ctx))
+	reserves, _ = suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(700))), reserves)
+}
@@ -47,6 +47,10 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "proposed withdraw outside loan-to-value range")
 	}
 
+	err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), depositor, amount)
+	if err != nil {
+		return err
+	}
 	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
 	if err != nil {
 		return err
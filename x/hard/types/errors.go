@@ -69,4 +69,10 @@ var (
 	ErrExceedsProtocolBorrowableBalance = sdkerrors.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = sdkerrors.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrInvalidBuyback error for when reserves can't be swapped for the gov denom
+	ErrInvalidBuyback = sdkerrors.Register(ModuleName, 33, "reserve buyback failed")
+	// ErrInvalidReserveWithdrawal error for an invalid reserve withdrawal proposal
+	ErrInvalidReserveWithdrawal = sdkerrors.Register(ModuleName, 34, "invalid reserve withdrawal")
+	// ErrInsufficientReserves error for when a withdrawal exceeds the protocol's reserves
+	ErrInsufficientReserves = sdkerrors.Register(ModuleName, 35, "insufficient reserves")
 )
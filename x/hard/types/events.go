@@ -2,24 +2,35 @@ package types
 
 // Event types for hard module
 const (
-	EventTypeHardDeposit          = "hard_deposit"
-	EventTypeHardWithdrawal       = "hard_withdrawal"
-	EventTypeHardBorrow           = "hard_borrow"
-	EventTypeHardLiquidation      = "hard_liquidation"
-	EventTypeHardRepay            = "hard_repay"
-	AttributeValueCategory        = ModuleName
-	AttributeKeyDeposit           = "deposit"
-	AttributeKeyDepositDenom      = "deposit_denom"
-	AttributeKeyDepositCoins      = "deposit_coins"
-	AttributeKeyDepositor         = "depositor"
-	AttributeKeyBorrow            = "borrow"
-	AttributeKeyBorrower          = "borrower"
-	AttributeKeyBorrowCoins       = "borrow_coins"
-	AttributeKeySender            = "sender"
-	AttributeKeyRepayCoins        = "repay_coins"
-	AttributeKeyLiquidatedOwner   = "liquidated_owner"
-	AttributeKeyLiquidatedCoins   = "liquidated_coins"
-	AttributeKeyKeeper            = "keeper"
-	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
-	AttributeKeyOwner             = "owner"
+	EventTypeHardDeposit              = "hard_deposit"
+	EventTypeHardWithdrawal           = "hard_withdrawal"
+	EventTypeHardBorrow               = "hard_borrow"
+	EventTypeHardLiquidation          = "hard_liquidation"
+	EventTypeHardRepay                = "hard_repay"
+	EventTypeReserveDistribution      = "hard_reserve_distribution"
+	EventTypeReserveDistributionError = "hard_reserve_distribution_error"
+	EventTypeReserveWithdrawal        = "hard_reserve_withdrawal"
+	EventTypeBadDebt                  = "hard_bad_debt"
+	EventTypeBadDebtCovered           = "hard_bad_debt_covered"
+	EventTypeBadDebtSocialized        = "hard_bad_debt_socialized"
+	AttributeValueCategory            = ModuleName
+	AttributeKeyDeposit               = "deposit"
+	AttributeKeyDepositDenom          = "deposit_denom"
+	AttributeKeyDepositCoins          = "deposit_coins"
+	AttributeKeyDepositor             = "depositor"
+	AttributeKeyBorrow                = "borrow"
+	AttributeKeyBorrower              = "borrower"
+	AttributeKeyBorrowCoins           = "borrow_coins"
+	AttributeKeySender                = "sender"
+	AttributeKeyRepayCoins            = "repay_coins"
+	AttributeKeyLiquidatedOwner       = "liquidated_owner"
+	AttributeKeyLiquidatedCoins       = "liquidated_coins"
+	AttributeKeyKeeper                = "keeper"
+	AttributeKeyKeeperRewardCoins     = "keeper_reward_coins"
+	AttributeKeyOwner                 = "owner"
+	AttributeKeyDestination           = "destination"
+	AttributeKeyAmount                = "amount"
+	AttributeKeyError                 = "error_message"
+	AttributeKeyRecipient             = "recipient"
+	AttributeKeyCoverBadDebt          = "cover_bad_debt"
 )
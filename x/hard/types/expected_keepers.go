@@ -18,6 +18,7 @@ type SupplyKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
 // AccountKeeper defines the expected keeper interface for interacting with account
@@ -42,9 +43,15 @@ type PricefeedKeeper interface {
 
 // AuctionKeeper expected interface for the auction keeper (noalias)
 type AuctionKeeper interface {
+	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error)
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
 }
 
+// SwapKeeper expected interface for the swap keeper (noalias)
+type SwapKeeper interface {
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+}
+
 // HARDHooks event hooks for other keepers to run code in response to HARD modifications
 type HARDHooks interface {
 	AfterDepositCreated(ctx sdk.Context, deposit Deposit)
@@ -54,3 +61,8 @@ type HARDHooks interface {
 	BeforeBorrowModified(ctx sdk.Context, borrow Borrow)
 	AfterBorrowModified(ctx sdk.Context, borrow Borrow)
 }
+
+// SendRestriction validates transfers of coins between accounts and the module, such as transfers of restricted issuance assets
+type SendRestriction interface {
+	ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+}
@@ -17,12 +17,13 @@ type GenesisState struct {
 	TotalSupplied             sdk.Coins                `json:"total_supplied" yaml:"total_supplied"`
 	TotalBorrowed             sdk.Coins                `json:"total_borrowed" yaml:"total_borrowed"`
 	TotalReserves             sdk.Coins                `json:"total_reserves" yaml:"total_reserves"`
+	TotalBadDebt              sdk.Coins                `json:"total_bad_debt" yaml:"total_bad_debt"`
 }
 
 // NewGenesisState returns a new genesis state
 func NewGenesisState(
 	params Params, prevAccumulationTimes GenesisAccumulationTimes, deposits Deposits,
-	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins) GenesisState {
+	borrows Borrows, totalSupplied, totalBorrowed, totalReserves, totalBadDebt sdk.Coins) GenesisState {
 	return GenesisState{
 		Params:                    params,
 		PreviousAccumulationTimes: prevAccumulationTimes,
@@ -31,6 +32,7 @@ func NewGenesisState(
 		TotalSupplied:             totalSupplied,
 		TotalBorrowed:             totalBorrowed,
 		TotalReserves:             totalReserves,
+		TotalBadDebt:              totalBadDebt,
 	}
 }
 
@@ -44,6 +46,7 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		TotalBadDebt:              DefaultTotalBadDebt,
 	}
 }
 
@@ -73,6 +76,9 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+	if !gs.TotalBadDebt.IsValid() {
+		return fmt.Errorf("invalid total bad debt coins: %s", gs.TotalBadDebt)
+	}
 	return nil
 }
 
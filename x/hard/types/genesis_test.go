@@ -33,6 +33,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 		ts     sdk.Coins
 		tb     sdk.Coins
 		tr     sdk.Coins
+		tbd    sdk.Coins
 	}
 	testCases := []struct {
 		name        string
@@ -50,6 +51,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 				ts:     types.DefaultTotalSupplied,
 				tb:     types.DefaultTotalBorrowed,
 				tr:     types.DefaultTotalReserves,
+				tbd:    types.DefaultTotalBadDebt,
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -62,6 +64,7 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 						types.NewMoneyMarket("usdx", types.NewBorrowLimit(true, sdk.MustNewDecFromStr("100000000000"), sdk.MustNewDecFromStr("1")), "usdx:usd", sdk.NewInt(USDX_CF), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 					},
 					sdk.MustNewDecFromStr("10"),
+					types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt,
 				),
 				gats: types.GenesisAccumulationTimes{
 					types.NewGenesisAccumulationTime("usdx", time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC), sdk.OneDec(), sdk.OneDec()),
@@ -71,14 +74,30 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 				ts:   sdk.Coins{},
 				tb:   sdk.Coins{},
 				tr:   sdk.Coins{},
+				tbd:  sdk.Coins{},
 			},
 			expectPass:  true,
 			expectedErr: "",
 		},
+		{
+			name: "invalid total bad debt",
+			args: args{
+				params: types.DefaultParams(),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				tbd:    sdk.Coins{sdk.Coin{Denom: "usdx", Amount: sdk.NewInt(-1)}},
+			},
+			expectPass:  false,
+			expectedErr: "invalid total bad debt coins",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr)
+			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr, tc.args.tbd)
 			err := gs.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
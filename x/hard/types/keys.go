@@ -31,6 +31,7 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	TotalBadDebtPrefix            = []byte{0x11} // sdk.Coins
 	sep                           = []byte(":")
 )
 
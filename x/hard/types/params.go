@@ -13,21 +13,79 @@ import (
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
 	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
+	KeyReserveDistributions      = []byte("ReserveDistributionParams")
+	KeyReserveDistributionPolicy = []byte("ReserveDistributionPolicy")
+	KeySocializeBadDebt          = []byte("SocializeBadDebt")
 	DefaultMoneyMarkets          = MoneyMarkets{}
-	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
+	DefaultMinimumBorrowUSDValue = sdk.NewDec(10)                          // $10 USD minimum borrow value
+	DefaultReserveDistributions  = ReserveDistributionParams(nil)          // no reserves are distributed
+	DefaultReservePolicy         = cdptypes.SurplusDistributionPolicy(nil) // distributed reserves are auctioned
+	DefaultSocializeBadDebt      = false
 	GovDenom                     = cdptypes.DefaultGovDenom
 	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
 	DefaultTotalSupplied         = sdk.Coins{}
 	DefaultTotalBorrowed         = sdk.Coins{}
 	DefaultTotalReserves         = sdk.Coins{}
+	DefaultTotalBadDebt          = sdk.Coins{}
 	DefaultDeposits              = Deposits{}
 	DefaultBorrows               = Borrows{}
 )
 
 // Params governance parameters for hard module
 type Params struct {
-	MoneyMarkets          MoneyMarkets `json:"money_markets" yaml:"money_markets"`
-	MinimumBorrowUSDValue sdk.Dec      `json:"minimum_borrow_usd_value" yaml:"minimum_borrow_usd_value"`
+	MoneyMarkets          MoneyMarkets                       `json:"money_markets" yaml:"money_markets"`
+	MinimumBorrowUSDValue sdk.Dec                            `json:"minimum_borrow_usd_value" yaml:"minimum_borrow_usd_value"`
+	ReserveDistributions  ReserveDistributionParams          `json:"reserve_distribution_params" yaml:"reserve_distribution_params"`
+	ReservePolicy         cdptypes.SurplusDistributionPolicy `json:"reserve_distribution_policy" yaml:"reserve_distribution_policy"`
+	SocializeBadDebt      bool                               `json:"socialize_bad_debt" yaml:"socialize_bad_debt"` // bad debt that reserves can't cover is spread across the market's suppliers
+}
+
+// ReserveDistributionParam sets when and how much of a denom's reserves are distributed
+type ReserveDistributionParam struct {
+	Denom     string  `json:"denom" yaml:"denom"`
+	Threshold sdk.Int `json:"threshold" yaml:"threshold"` // reserves are distributed while they are at or above the threshold
+	Lot       sdk.Int `json:"lot" yaml:"lot"`             // the most reserves distributed per block
+}
+
+// NewReserveDistributionParam returns a new ReserveDistributionParam
+func NewReserveDistributionParam(denom string, threshold, lot sdk.Int) ReserveDistributionParam {
+	return ReserveDistributionParam{
+		Denom:     denom,
+		Threshold: threshold,
+		Lot:       lot,
+	}
+}
+
+// Validate ReserveDistributionParam
+func (rdp ReserveDistributionParam) Validate() error {
+	if err := sdk.ValidateDenom(rdp.Denom); err != nil {
+		return err
+	}
+	if rdp.Threshold.IsNil() || rdp.Threshold.IsNegative() {
+		return fmt.Errorf("reserve distribution threshold cannot be negative: %s", rdp.Threshold)
+	}
+	if rdp.Lot.IsNil() || !rdp.Lot.IsPositive() {
+		return fmt.Errorf("reserve distribution lot must be positive: %s", rdp.Lot)
+	}
+	return nil
+}
+
+// ReserveDistributionParams slice of ReserveDistributionParam
+type ReserveDistributionParams []ReserveDistributionParam
+
+// Validate ReserveDistributionParams
+func (rdps ReserveDistributionParams) Validate() error {
+	denomDupMap := make(map[string]bool)
+	for _, rdp := range rdps {
+		if err := rdp.Validate(); err != nil {
+			return err
+		}
+		if denomDupMap[rdp.Denom] {
+			return fmt.Errorf("duplicate reserve distribution denom: %s", rdp.Denom)
+		}
+		denomDupMap[rdp.Denom] = true
+	}
+	return nil
 }
 
 // BorrowLimit enforces restrictions on a money market
@@ -227,24 +285,31 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 type InterestRateModels []InterestRateModel
 
 // NewParams returns a new params object
-func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params {
+func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec, reserveDistributions ReserveDistributionParams,
+	reservePolicy cdptypes.SurplusDistributionPolicy, socializeBadDebt bool) Params {
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		ReserveDistributions:  reserveDistributions,
+		ReservePolicy:         reservePolicy,
+		SocializeBadDebt:      socializeBadDebt,
 	}
 }
 
 // DefaultParams returns default params for hard module
 func DefaultParams() Params {
-	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue)
+	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue, DefaultReserveDistributions, DefaultReservePolicy, DefaultSocializeBadDebt)
 }
 
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	Minimum Borrow USD Value: %v
-	Money Markets: %v`,
-		p.MinimumBorrowUSDValue, p.MoneyMarkets)
+	Money Markets: %v
+	Reserve Distributions: %v
+	Reserve Distribution Policy: %v
+	Socialize Bad Debt: %t`,
+		p.MinimumBorrowUSDValue, p.MoneyMarkets, p.ReserveDistributions, p.ReservePolicy, p.SocializeBadDebt)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -257,6 +322,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		params.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		params.NewParamSetPair(KeyReserveDistributions, &p.ReserveDistributions, validateReserveDistributionParams),
+		params.NewParamSetPair(KeyReserveDistributionPolicy, &p.ReservePolicy, validateReserveDistributionPolicy),
+		params.NewParamSetPair(KeySocializeBadDebt, &p.SocializeBadDebt, validateSocializeBadDebt),
 	}
 }
 
@@ -266,6 +334,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateReserveDistributionParams(p.ReserveDistributions); err != nil {
+		return err
+	}
+
+	if err := validateReserveDistributionPolicy(p.ReservePolicy); err != nil {
+		return err
+	}
+
+	if err := validateSocializeBadDebt(p.SocializeBadDebt); err != nil {
+		return err
+	}
+
 	return validateMoneyMarketParams(p.MoneyMarkets)
 }
 
@@ -290,3 +370,29 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateReserveDistributionParams(i interface{}) error {
+	rdps, ok := i.(ReserveDistributionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return rdps.Validate()
+}
+
+func validateReserveDistributionPolicy(i interface{}) error {
+	policy, ok := i.(cdptypes.SurplusDistributionPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return policy.Validate()
+}
+
+func validateSocializeBadDebt(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
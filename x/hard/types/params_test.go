@@ -59,7 +59,62 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal)
+			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal, types.DefaultReserveDistributions, types.DefaultReservePolicy, types.DefaultSocializeBadDebt)
+			err := params.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *ParamTestSuite) TestReserveDistributionParamsValidation() {
+	testCases := []struct {
+		name        string
+		rdps        types.ReserveDistributionParams
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			rdps: types.ReserveDistributionParams{
+				types.NewReserveDistributionParam("bnb", sdk.NewInt(1000), sdk.NewInt(100)),
+				types.NewReserveDistributionParam("ukava", sdk.ZeroInt(), sdk.NewInt(100)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero lot",
+			rdps: types.ReserveDistributionParams{
+				types.NewReserveDistributionParam("bnb", sdk.NewInt(1000), sdk.ZeroInt()),
+			},
+			expectPass:  false,
+			expectedErr: "lot must be positive",
+		},
+		{
+			name: "negative threshold",
+			rdps: types.ReserveDistributionParams{
+				types.NewReserveDistributionParam("bnb", sdk.NewInt(-1), sdk.NewInt(100)),
+			},
+			expectPass:  false,
+			expectedErr: "threshold cannot be negative",
+		},
+		{
+			name: "duplicate denom",
+			rdps: types.ReserveDistributionParams{
+				types.NewReserveDistributionParam("bnb", sdk.NewInt(1000), sdk.NewInt(100)),
+				types.NewReserveDistributionParam("bnb", sdk.NewInt(2000), sdk.NewInt(100)),
+			},
+			expectPass:  false,
+			expectedErr: "duplicate reserve distribution denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(types.DefaultMoneyMarkets, types.DefaultMinimumBorrowUSDValue, tc.rdps, types.DefaultReservePolicy, types.DefaultSocializeBadDebt)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeReserveWithdrawal defines the type for a ReserveWithdrawalProposal
+	ProposalTypeReserveWithdrawal = "HardReserveWithdrawal"
+)
+
+// Assert ReserveWithdrawalProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = ReserveWithdrawalProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReserveWithdrawal)
+	govtypes.RegisterProposalTypeCodec(ReserveWithdrawalProposal{}, "kava/HardReserveWithdrawalProposal")
+}
+
+// ReserveWithdrawalProposal withdraws protocol reserves, either sending them to a recipient
+// or releasing them to cover bad debt in their money markets
+type ReserveWithdrawalProposal struct {
+	Title        string         `json:"title" yaml:"title"`
+	Description  string         `json:"description" yaml:"description"`
+	Recipient    sdk.AccAddress `json:"recipient" yaml:"recipient"`           // receives the withdrawn reserves, must be empty when covering bad debt
+	CoverBadDebt bool           `json:"cover_bad_debt" yaml:"cover_bad_debt"` // withdrawn reserves stay in the hard module account to back suppliers' deposits
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewReserveWithdrawalProposal creates a new reserve withdrawal proposal.
+func NewReserveWithdrawalProposal(title, description string, recipient sdk.AccAddress, coverBadDebt bool, amount sdk.Coins) ReserveWithdrawalProposal {
+	return ReserveWithdrawalProposal{
+		Title:        title,
+		Description:  description,
+		Recipient:    recipient,
+		CoverBadDebt: coverBadDebt,
+		Amount:       amount,
+	}
+}
+
+// GetTitle returns the title of a reserve withdrawal proposal.
+func (rwp ReserveWithdrawalProposal) GetTitle() string { return rwp.Title }
+
+// GetDescription returns the description of a reserve withdrawal proposal.
+func (rwp ReserveWithdrawalProposal) GetDescription() string { return rwp.Description }
+
+// ProposalRoute returns the routing key of a reserve withdrawal proposal.
+func (rwp ReserveWithdrawalProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reserve withdrawal proposal.
+func (rwp ReserveWithdrawalProposal) ProposalType() string { return ProposalTypeReserveWithdrawal }
+
+// ValidateBasic stateless validation of a reserve withdrawal proposal.
+func (rwp ReserveWithdrawalProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rwp); err != nil {
+		return err
+	}
+	if rwp.Amount.Empty() || !rwp.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "reserve withdrawal amount: %s", rwp.Amount)
+	}
+	if rwp.CoverBadDebt && !rwp.Recipient.Empty() {
+		return sdkerrors.Wrap(ErrInvalidReserveWithdrawal, "recipient must be empty when covering bad debt")
+	}
+	if !rwp.CoverBadDebt && rwp.Recipient.Empty() {
+		return sdkerrors.Wrap(ErrInvalidReserveWithdrawal, "recipient cannot be empty")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (rwp ReserveWithdrawalProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Hard Reserve Withdrawal Proposal:
+  Title:          %s
+  Description:    %s
+  Recipient:      %s
+  Cover Bad Debt: %t
+  Amount:         %s
+`, rwp.Title, rwp.Description, rwp.Recipient, rwp.CoverBadDebt, rwp.Amount))
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestReserveWithdrawalProposal_ValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress("test1")
+	amount := sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000)))
+
+	testCases := []struct {
+		name        string
+		proposal    types.ReserveWithdrawalProposal
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "valid withdrawal",
+			proposal:   types.NewReserveWithdrawalProposal("A Title", "A description", recipient, false, amount),
+			expectPass: true,
+		},
+		{
+			name:       "valid bad debt cover",
+			proposal:   types.NewReserveWithdrawalProposal("A Title", "A description", nil, true, amount),
+			expectPass: true,
+		},
+		{
+			name:        "missing recipient",
+			proposal:    types.NewReserveWithdrawalProposal("A Title", "A description", nil, false, amount),
+			expectPass:  false,
+			expectedErr: "recipient cannot be empty",
+		},
+		{
+			name:        "recipient when covering bad debt",
+			proposal:    types.NewReserveWithdrawalProposal("A Title", "A description", recipient, true, amount),
+			expectPass:  false,
+			expectedErr: "recipient must be empty",
+		},
+		{
+			name:        "empty amount",
+			proposal:    types.NewReserveWithdrawalProposal("A Title", "A description", recipient, false, sdk.Coins{}),
+			expectPass:  false,
+			expectedErr: "invalid coins",
+		},
+		{
+			name:        "missing title",
+			proposal:    types.NewReserveWithdrawalProposal("", "A description", recipient, false, amount),
+			expectPass:  false,
+			expectedErr: "proposal title cannot be blank",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ const (
 	QueryGetInterestRate     = "interest-rate"
 	QueryGetReserves         = "reserves"
 	QueryGetInterestFactors  = "interest-factors"
+	QueryGetBadDebt          = "bad-debt"
+	QueryGetSolvency         = "solvency"
 )
 
 // QueryDepositsParams is the params for a filtered deposit query
@@ -204,3 +206,52 @@ func NewInterestFactor(denom string, supplyInterestFactor, borrowInterestFactor
 
 // InterestFactors is a slice of InterestFactor
 type InterestFactors = []InterestFactor
+
+// QueryBadDebtParams is the params for a filtered bad debt query
+type QueryBadDebtParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryBadDebtParams creates a new QueryBadDebtParams
+func NewQueryBadDebtParams(denom string) QueryBadDebtParams {
+	return QueryBadDebtParams{
+		Denom: denom,
+	}
+}
+
+// QuerySolvencyParams is the params for a filtered market solvency query
+type QuerySolvencyParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQuerySolvencyParams creates a new QuerySolvencyParams
+func NewQuerySolvencyParams(denom string) QuerySolvencyParams {
+	return QuerySolvencyParams{
+		Denom: denom,
+	}
+}
+
+// MarketSolvency is a unique type returned by solvency queries
+type MarketSolvency struct {
+	Denom         string  `json:"denom" yaml:"denom"`
+	TotalSupplied sdk.Int `json:"total_supplied" yaml:"total_supplied"`
+	TotalBorrowed sdk.Int `json:"total_borrowed" yaml:"total_borrowed"`
+	TotalReserves sdk.Int `json:"total_reserves" yaml:"total_reserves"`
+	BadDebt       sdk.Int `json:"bad_debt" yaml:"bad_debt"` // supplied coins that aren't backed by cash, borrows or reserves
+	Cash          sdk.Int `json:"cash" yaml:"cash"`         // coins held by the hard module account
+}
+
+// NewMarketSolvency returns a new instance of MarketSolvency
+func NewMarketSolvency(denom string, totalSupplied, totalBorrowed, totalReserves, badDebt, cash sdk.Int) MarketSolvency {
+	return MarketSolvency{
+		Denom:         denom,
+		TotalSupplied: totalSupplied,
+		TotalBorrowed: totalBorrowed,
+		TotalReserves: totalReserves,
+		BadDebt:       badDebt,
+		Cash:          cash,
+	}
+}
+
+// MarketSolvencies is a slice of MarketSolvency
+type MarketSolvencies []MarketSolvency
@@ -51,6 +51,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 				hard.NewMoneyMarket("bnb", hard.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdk.NewInt(1000000), hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			hard.DefaultReserveDistributions, hard.DefaultReservePolicy, hard.DefaultSocializeBadDebt,
 		),
 		hard.DefaultAccumulationTimes,
 		hard.DefaultDeposits,
@@ -58,6 +59,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 		hard.DefaultTotalSupplied,
 		hard.DefaultTotalBorrowed,
 		hard.DefaultTotalReserves,
+		hard.DefaultTotalBadDebt,
 	)
 	incentiveGS := incentive.NewGenesisState(
 		incentive.NewParams(
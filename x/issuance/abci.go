@@ -5,12 +5,14 @@ import (
 	"github.com/kava-labs/kava/x/issuance/keeper"
 )
 
-// BeginBlocker iterates over each asset and seizes coins from blocked addresses by returning them to the asset owner
+// BeginBlocker iterates over each asset and seizes coins from blocked addresses by returning them to the asset owner.
+// Block lists are synchronized with params and the state of removed assets is cleared first, so coins are seized using current lists.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.SynchronizeBlockList(ctx)
+	k.ClearRemovedAssets(ctx)
 	err := k.SeizeCoinsForBlockableAssets(ctx)
 	if err != nil {
 		panic(err)
 	}
-	k.SynchronizeBlockList(ctx)
 	k.UpdateTimeBasedSupplyLimits(ctx)
 }
@@ -46,10 +46,12 @@ func (suite *ABCITestSuite) SetupTest() {
 }
 
 func (suite *ABCITestSuite) TestRateLimitingTimePassage() {
+	type block struct {
+		timeElapsed time.Duration
+		issued      int64
+	}
 	type args struct {
-		assets         issuance.Assets
-		supplies       issuance.AssetSupplies
-		blockTimes     []time.Duration
+		blocks         []block
 		expectedSupply issuance.AssetSupply
 	}
 	testCases := []struct {
@@ -57,47 +59,44 @@ func (suite *ABCITestSuite) TestRateLimitingTimePassage() {
 		args args
 	}{
 		{
-			"time passage same period",
+			"issuance within window",
 			args{
-				assets: issuance.Assets{
-					issuance.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, issuance.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
-				},
-				supplies: issuance.AssetSupplies{
-					issuance.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour),
-				},
-				blockTimes:     []time.Duration{time.Hour},
-				expectedSupply: issuance.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour*2),
+				blocks:         []block{{time.Hour, 100}, {time.Hour * 12, 0}},
+				expectedSupply: issuance.NewAssetSupply(sdk.NewInt64Coin("usdtoken", 100), time.Duration(0)),
 			},
 		},
 		{
-			"time passage new period",
+			"issuance leaves window",
 			args{
-				assets: issuance.Assets{
-					issuance.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, issuance.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
-				},
-				supplies: issuance.AssetSupplies{
-					issuance.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour),
-				},
-				blockTimes:     []time.Duration{time.Hour * 12, time.Hour * 12},
+				blocks:         []block{{time.Hour, 100}, {time.Hour * 24, 0}},
 				expectedSupply: issuance.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Duration(0)),
 			},
 		},
+		{
+			"window rolls with block time",
+			args{
+				blocks:         []block{{time.Hour, 100}, {time.Hour * 12, 200}, {time.Hour * 12, 0}},
+				expectedSupply: issuance.NewAssetSupply(sdk.NewInt64Coin("usdtoken", 200), time.Duration(0)),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
-			params := issuance.NewParams(tc.args.assets)
-			suite.keeper.SetParams(suite.ctx, params)
-			for _, supply := range tc.args.supplies {
-				suite.keeper.SetAssetSupply(suite.ctx, supply, supply.GetDenom())
+			assets := issuance.Assets{
+				issuance.NewAsset(suite.addrs[0], "usdtoken", false, true, false, issuance.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
 			}
-			suite.keeper.SetPreviousBlockTime(suite.ctx, suite.blockTime)
-			for _, bt := range tc.args.blockTimes {
-				nextBlockTime := suite.ctx.BlockTime().Add(bt)
-				suite.ctx = suite.ctx.WithBlockTime(nextBlockTime)
+			suite.keeper.SetParams(suite.ctx, issuance.NewParams(assets))
+			suite.keeper.CreateNewAssetSupply(suite.ctx, "usdtoken")
+			for _, b := range tc.args.blocks {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(b.timeElapsed))
 				suite.Require().NotPanics(func() {
 					issuance.BeginBlocker(suite.ctx, suite.keeper)
 				})
+				if b.issued > 0 {
+					err := suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", b.issued), suite.addrs[0], suite.addrs[2])
+					suite.Require().NoError(err)
+				}
 			}
 			actualSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.expectedSupply.GetDenom())
 			suite.Require().True(found)
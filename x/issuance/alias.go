@@ -12,9 +12,21 @@ import (
 // ALIASGEN: github.com/kava-labs/kava/x/issuance/types
 
 const (
+	AttributeKeyAllow        = types.AttributeKeyAllow
+	AttributeKeyAllowance    = types.AttributeKeyAllowance
+	AttributeKeyDisallow     = types.AttributeKeyDisallow
+	AttributeKeyOwner        = types.AttributeKeyOwner
+	AttributeKeyPendingOwner = types.AttributeKeyPendingOwner
+	AttributeKeyRole         = types.AttributeKeyRole
+	EventTypeAcceptOwner     = types.EventTypeAcceptOwner
+	EventTypeAllow           = types.EventTypeAllow
+	EventTypeDisallow        = types.EventTypeDisallow
+	EventTypeGrantRole       = types.EventTypeGrantRole
 	EventTypeIssue           = types.EventTypeIssue
 	EventTypeRedeem          = types.EventTypeRedeem
 	EventTypeBlock           = types.EventTypeBlock
+	EventTypeRevokeRole      = types.EventTypeRevokeRole
+	EventTypeTransferOwner   = types.EventTypeTransferOwner
 	EventTypeUnblock         = types.EventTypeUnblock
 	EventTypePause           = types.EventTypePause
 	EventTypeSeize           = types.EventTypeSeize
@@ -27,6 +39,15 @@ const (
 	AttributeKeyAddress      = types.AttributeKeyAddress
 	AttributeKeyPauseStatus  = types.AttributeKeyPauseStatus
 	ModuleName               = types.ModuleName
+	QueryGetAllowedAddresses = types.QueryGetAllowedAddresses
+	QueryGetBlockedAddresses = types.QueryGetBlockedAddresses
+	QueryGetCapacities       = types.QueryGetCapacities
+	QueryGetOwners           = types.QueryGetOwners
+	QueryGetPendingOwners    = types.QueryGetPendingOwners
+	QueryGetRoles            = types.QueryGetRoles
+	RoleBlocker              = types.RoleBlocker
+	RoleMinter               = types.RoleMinter
+	RolePauser               = types.RolePauser
 	StoreKey                 = types.StoreKey
 	RouterKey                = types.RouterKey
 	DefaultParamspace        = types.DefaultParamspace
@@ -37,24 +58,52 @@ const (
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
-	RegisterCodec        = types.RegisterCodec
-	NewGenesisState      = types.NewGenesisState
-	DefaultGenesisState  = types.DefaultGenesisState
-	NewMsgIssueTokens    = types.NewMsgIssueTokens
-	NewMsgRedeemTokens   = types.NewMsgRedeemTokens
-	NewMsgBlockAddress   = types.NewMsgBlockAddress
-	NewMsgUnblockAddress = types.NewMsgUnblockAddress
-	NewMsgSetPauseStatus = types.NewMsgSetPauseStatus
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
-	ParamKeyTable        = types.ParamKeyTable
-	NewAsset             = types.NewAsset
-	NewRateLimit         = types.NewRateLimit
-	NewAssetSupply       = types.NewAssetSupply
+	NewKeeper               = keeper.NewKeeper
+	NewQuerier              = keeper.NewQuerier
+	AssetAddressesKey       = types.AssetAddressesKey
+	AssetAddressKey         = types.AssetAddressKey
+	NewAddressList          = types.NewAddressList
+	NewAssetOwner           = types.NewAssetOwner
+	NewMsgAcceptOwnership   = types.NewMsgAcceptOwnership
+	NewMsgAllowAddress      = types.NewMsgAllowAddress
+	NewMsgDisallowAddress   = types.NewMsgDisallowAddress
+	NewMsgGrantRole         = types.NewMsgGrantRole
+	NewMsgRevokeRole        = types.NewMsgRevokeRole
+	NewMsgTransferOwnership = types.NewMsgTransferOwnership
+	NewPendingOwner         = types.NewPendingOwner
+	NewQueryAddressesParams = types.NewQueryAddressesParams
+	NewQueryAssetParams     = types.NewQueryAssetParams
+	NewQueryRolesParams     = types.NewQueryRolesParams
+	NewRoleHolder           = types.NewRoleHolder
+	RegisterCodec           = types.RegisterCodec
+	NewGenesisState         = types.NewGenesisState
+	DefaultGenesisState     = types.DefaultGenesisState
+	NewMsgIssueTokens       = types.NewMsgIssueTokens
+	NewMsgRedeemTokens      = types.NewMsgRedeemTokens
+	NewMsgBlockAddress      = types.NewMsgBlockAddress
+	NewMsgUnblockAddress    = types.NewMsgUnblockAddress
+	NewMsgSetPauseStatus    = types.NewMsgSetPauseStatus
+	NewParams               = types.NewParams
+	DefaultParams           = types.DefaultParams
+	ParamKeyTable           = types.ParamKeyTable
+	NewAsset                = types.NewAsset
+	NewRateLimit            = types.NewRateLimit
+	NewAssetSupply          = types.NewAssetSupply
+	RoleHolderKey           = types.RoleHolderKey
+	RoleHoldersKey          = types.RoleHoldersKey
+	ValidateRole            = types.ValidateRole
 
 	// variable aliases
+	AllowedAddressPrefix       = types.AllowedAddressPrefix
+	BlockedAddressPrefix       = types.BlockedAddressPrefix
+	ErrAccountAlreadyAllowed   = types.ErrAccountAlreadyAllowed
+	ErrAccountNotAllowed       = types.ErrAccountNotAllowed
+	ErrAccountNotFound         = types.ErrAccountNotFound
+	ErrExceedsMinterAllowance  = types.ErrExceedsMinterAllowance
+	ErrInvalidRole             = types.ErrInvalidRole
+	ErrNoPendingOwner          = types.ErrNoPendingOwner
+	ErrRateLimitNotActive      = types.ErrRateLimitNotActive
+	ErrRoleNotFound            = types.ErrRoleNotFound
 	ModuleCdc                  = types.ModuleCdc
 	ErrAssetNotFound           = types.ErrAssetNotFound
 	ErrNotAuthorized           = types.ErrNotAuthorized
@@ -66,25 +115,45 @@ var (
 	ErrExceedsSupplyLimit      = types.ErrExceedsSupplyLimit
 	ErrAssetUnblockable        = types.ErrAssetUnblockable
 	AssetSupplyPrefix          = types.AssetSupplyPrefix
+	OwnerPrefix                = types.OwnerPrefix
+	PendingOwnerPrefix         = types.PendingOwnerPrefix
 	PreviousBlockTimeKey       = types.PreviousBlockTimeKey
 	KeyAssets                  = types.KeyAssets
 	DefaultAssets              = types.DefaultAssets
 	ModuleAccountName          = types.ModuleAccountName
+	RateLimitPrefix            = types.RateLimitPrefix
+	RoleHolderPrefix           = types.RoleHolderPrefix
 )
 
 type (
-	Keeper            = keeper.Keeper
-	GenesisState      = types.GenesisState
-	MsgIssueTokens    = types.MsgIssueTokens
-	MsgRedeemTokens   = types.MsgRedeemTokens
-	MsgBlockAddress   = types.MsgBlockAddress
-	MsgUnblockAddress = types.MsgUnblockAddress
-	MsgSetPauseStatus = types.MsgSetPauseStatus
-	Params            = types.Params
-	Asset             = types.Asset
-	Assets            = types.Assets
-	RateLimit         = types.RateLimit
-	QueryAssetParams  = types.QueryAssetParams
-	AssetSupply       = types.AssetSupply
-	AssetSupplies     = types.AssetSupplies
+	Keeper               = keeper.Keeper
+	AddressList          = types.AddressList
+	AddressLists         = types.AddressLists
+	AssetOwner           = types.AssetOwner
+	AssetOwners          = types.AssetOwners
+	GenesisState         = types.GenesisState
+	MsgAcceptOwnership   = types.MsgAcceptOwnership
+	MsgAllowAddress      = types.MsgAllowAddress
+	MsgDisallowAddress   = types.MsgDisallowAddress
+	MsgGrantRole         = types.MsgGrantRole
+	MsgIssueTokens       = types.MsgIssueTokens
+	MsgRedeemTokens      = types.MsgRedeemTokens
+	MsgBlockAddress      = types.MsgBlockAddress
+	MsgRevokeRole        = types.MsgRevokeRole
+	MsgTransferOwnership = types.MsgTransferOwnership
+	MsgUnblockAddress    = types.MsgUnblockAddress
+	MsgSetPauseStatus    = types.MsgSetPauseStatus
+	Params               = types.Params
+	Asset                = types.Asset
+	Assets               = types.Assets
+	PendingOwner         = types.PendingOwner
+	PendingOwners        = types.PendingOwners
+	QueryAddressesParams = types.QueryAddressesParams
+	QueryRolesParams     = types.QueryRolesParams
+	RateLimit            = types.RateLimit
+	QueryAssetParams     = types.QueryAssetParams
+	AssetSupply          = types.AssetSupply
+	AssetSupplies        = types.AssetSupplies
+	RoleHolder           = types.RoleHolder
+	RoleHolders          = types.RoleHolders
 )
@@ -2,16 +2,27 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
+// Query flags
+const (
+	flagDenom = "denom"
+	flagRole  = "role"
+)
+
 // GetQueryCmd returns the cli query commands for the issuance module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	issuanceQueryCmd := &cobra.Command{
@@ -21,6 +32,12 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	issuanceQueryCmd.AddCommand(flags.GetCommands(
 		queryParamsCmd(queryRoute, cdc),
+		queryRolesCmd(queryRoute, cdc),
+		queryOwnersCmd(queryRoute, cdc),
+		queryPendingOwnersCmd(queryRoute, cdc),
+		queryAddressesCmd(queryRoute, cdc, "blocked-addresses", "block list", types.QueryGetBlockedAddresses),
+		queryAddressesCmd(queryRoute, cdc, "allowed-addresses", "allowlist", types.QueryGetAllowedAddresses),
+		queryCapacitiesCmd(queryRoute, cdc),
 	)...)
 
 	return issuanceQueryCmd
@@ -53,3 +70,177 @@ func queryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func queryRolesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "get role holders of issuance assets",
+		Long: strings.TrimSpace(`Get the addresses that hold roles for issuance assets, filtered by denom and role.
+A role can only be filtered for a single denom.`),
+		Example: fmt.Sprintf(`$ %s query %s roles
+$ %s query %s roles --denom usdtoken
+$ %s query %s roles --denom usdtoken --role minter`,
+			version.ClientName, types.ModuleName, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryRolesParams(viper.GetString(flagDenom), viper.GetString(flagRole))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetRoles)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var roleHolders types.RoleHolders
+			if err := cdc.UnmarshalJSON(res, &roleHolders); err != nil {
+				return fmt.Errorf("failed to unmarshal role holders: %w", err)
+			}
+			return cliCtx.PrintOutput(roleHolders)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter role holders by asset denom")
+	cmd.Flags().String(flagRole, "", "(optional) filter role holders by role, requires a denom")
+	return cmd
+}
+
+func queryOwnersCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "owners",
+		Short: "get the owners of issuance assets",
+		Long:  "Get the current owner of each issuance asset, including owners that accepted a transfer of ownership.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetOwners)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var owners types.AssetOwners
+			if err := cdc.UnmarshalJSON(res, &owners); err != nil {
+				return fmt.Errorf("failed to unmarshal owners: %w", err)
+			}
+			return cliCtx.PrintOutput(owners)
+		},
+	}
+}
+
+func queryPendingOwnersCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-owners",
+		Short: "get nominated owners of issuance assets",
+		Long:  "Get the addresses that have been nominated as new asset owners and have yet to accept ownership.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetPendingOwners)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var pendingOwners types.PendingOwners
+			if err := cdc.UnmarshalJSON(res, &pendingOwners); err != nil {
+				return fmt.Errorf("failed to unmarshal pending owners: %w", err)
+			}
+			return cliCtx.PrintOutput(pendingOwners)
+		},
+	}
+}
+
+func queryAddressesCmd(queryRoute string, cdc *codec.Codec, use, list, queryPath string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [denom]", use),
+		Short: fmt.Sprintf("get the %s of an issuance asset", list),
+		Long:  fmt.Sprintf("Get a page of the addresses on an issuance asset's %s.", list),
+		Example: fmt.Sprintf(`$ %s query %s %s usdtoken
+$ %s query %s %s usdtoken --page=2 --limit=100`,
+			version.ClientName, types.ModuleName, use, version.ClientName, types.ModuleName, use),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryAddressesParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), args[0])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, queryPath)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var addresses []sdk.AccAddress
+			if err := cdc.UnmarshalJSON(res, &addresses); err != nil {
+				return fmt.Errorf("failed to unmarshal addresses: %w", err)
+			}
+			return cliCtx.PrintOutput(addresses)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of addresses to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of addresses to query for")
+	return cmd
+}
+
+func queryCapacitiesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "capacity [denom]",
+		Short: "get the remaining rate limit capacity of issuance assets",
+		Long: strings.TrimSpace(`Get the net amount of rate-limited issuance assets issued within the window of their rate limit,
+and the amount that can still be issued. Omitting the denom returns the capacity of all rate-limited assets.`),
+		Example: fmt.Sprintf(`$ %s query %s capacity
+$ %s query %s capacity usdtoken`,
+			version.ClientName, types.ModuleName, version.ClientName, types.ModuleName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := ""
+			if len(args) > 0 {
+				denom = args[0]
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAssetParams(denom))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCapacities)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var capacities ratelimit.Capacities
+			if err := cdc.UnmarshalJSON(res, &capacities); err != nil {
+				return fmt.Errorf("failed to unmarshal capacities: %w", err)
+			}
+			return cliCtx.PrintOutput(capacities)
+		},
+	}
+}
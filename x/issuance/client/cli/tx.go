@@ -30,6 +30,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdBlockAddress(cdc),
 		getCmdUnblockAddress(cdc),
 		getCmdPauseAsset(cdc),
+		getCmdGrantRole(cdc),
+		getCmdRevokeRole(cdc),
+		getCmdTransferOwnership(cdc),
+		getCmdAcceptOwnership(cdc),
+		getCmdAllowAddress(cdc),
+		getCmdDisallowAddress(cdc),
 	)...)
 
 	return issuanceTxCmd
@@ -190,3 +196,172 @@ func getCmdPauseAsset(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdGrantRole(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [allowance]",
+		Short: "grant a role for an asset to an address",
+		Long: fmt.Sprintf(`The asset owner grants the %s, %s or %s role to an address.
+Minters can issue tokens up to their allowance, granting the minter role to an existing minter replaces its allowance.`,
+			types.RoleMinter, types.RolePauser, types.RoleBlocker),
+		Example: fmt.Sprintf(`$ %s tx %s grant-role usdtoken minter kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 1000000000
+$ %s tx %s grant-role usdtoken pauser kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+		`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			address, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			allowance := sdk.ZeroInt()
+			if len(args) == 4 {
+				var ok bool
+				allowance, ok = sdk.NewIntFromString(args[3])
+				if !ok {
+					return fmt.Errorf("invalid allowance: %s", args[3])
+				}
+			}
+
+			msg := types.NewMsgGrantRole(cliCtx.GetFromAddress(), args[0], args[1], address, allowance)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdRevokeRole(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address]",
+		Short: "revoke a role for an asset from an address",
+		Long:  "The asset owner revokes a role that was granted to an address",
+		Example: fmt.Sprintf(`$ %s tx %s revoke-role usdtoken minter kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			address, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(cliCtx.GetFromAddress(), args[0], args[1], address)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdTransferOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-ownership [denom] [new-owner]",
+		Short: "nominate a new owner for an asset",
+		Long:  "The asset owner nominates a new owner, who becomes the owner once they accept ownership",
+		Example: fmt.Sprintf(`$ %s tx %s transfer-ownership usdtoken kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferOwnership(cliCtx.GetFromAddress(), args[0], newOwner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdAcceptOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-ownership [denom]",
+		Short: "accept ownership of an asset",
+		Long:  "The pending owner of an asset accepts ownership, replacing the current owner",
+		Example: fmt.Sprintf(`$ %s tx %s accept-ownership usdtoken
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgAcceptOwnership(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdAllowAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allow [address] [denom]",
+		Short: "add an address to the allowlist for the input denom",
+		Long:  "The asset owner adds an address to the asset's allowlist. When allowlist mode is enabled for the asset, only the owner and addresses on the allowlist can receive it",
+		Example: fmt.Sprintf(`$ %s tx %s allow kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw usdtoken
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAllowAddress(cliCtx.GetFromAddress(), args[1], address)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdDisallowAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disallow [address] [denom]",
+		Short: "remove an address from the allowlist for the input denom",
+		Long:  "The asset owner removes an address from the asset's allowlist. Tokens held by the address are not seized",
+		Example: fmt.Sprintf(`$ %s tx %s disallow kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw usdtoken
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisallowAddress(cliCtx.GetFromAddress(), args[1], address)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
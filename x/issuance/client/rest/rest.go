@@ -8,6 +8,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
+// REST variable names
+// nolint
+const (
+	RestDenom = "denom"
+	RestRole  = "role"
+)
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
@@ -47,3 +54,47 @@ type PostPauseReq struct {
 	Denom   string       `json:"denom" yaml:"denom"`
 	Status  bool         `json:"status" yaml:"status"`
 }
+
+// PostGrantRoleReq defines the properties of a grant role request's body
+type PostGrantRoleReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	Role      string         `json:"role" yaml:"role"`
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Allowance sdk.Int        `json:"allowance" yaml:"allowance"`
+}
+
+// PostRevokeRoleReq defines the properties of a revoke role request's body
+type PostRevokeRoleReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	Role    string         `json:"role" yaml:"role"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// PostTransferOwnershipReq defines the properties of a transfer ownership request's body
+type PostTransferOwnershipReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// PostAcceptOwnershipReq defines the properties of an accept ownership request's body
+type PostAcceptOwnershipReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom   string       `json:"denom" yaml:"denom"`
+}
+
+// PostAllowAddressReq defines the properties of an allow address request's body
+type PostAllowAddressReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Denom   string         `json:"denom" yaml:"denom"`
+}
+
+// PostDisallowAddressReq defines the properties of a disallow address request's body
+type PostDisallowAddressReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Denom   string         `json:"denom" yaml:"denom"`
+}
@@ -28,6 +28,34 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 		k.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
 
+	for _, bucket := range gs.RateLimitBuckets {
+		k.SetRateLimitBucket(ctx, bucket)
+	}
+
+	for _, roleHolder := range gs.RoleHolders {
+		k.SetRoleHolder(ctx, roleHolder)
+	}
+
+	for _, owner := range gs.Owners {
+		k.SetOwner(ctx, owner.Denom, owner.Address)
+	}
+
+	for _, pendingOwner := range gs.PendingOwners {
+		k.SetPendingOwner(ctx, pendingOwner.Denom, pendingOwner.Address)
+	}
+
+	for _, list := range gs.BlockedAddresses {
+		for _, addr := range list.Addresses {
+			k.SetBlockedAddress(ctx, list.Denom, addr)
+		}
+	}
+
+	for _, list := range gs.AllowedAddresses {
+		for _, addr := range list.Addresses {
+			k.SetAllowedAddress(ctx, list.Denom, addr)
+		}
+	}
+
 	for _, asset := range gs.Params.Assets {
 		if asset.RateLimit.Active {
 			_, found := k.GetAssetSupply(ctx, asset.Denom)
@@ -43,5 +71,20 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	supplies := k.GetAllAssetSupplies(ctx)
-	return types.NewGenesisState(params, supplies)
+	buckets := k.GetRateLimitBuckets(ctx)
+	roleHolders := k.GetRoleHolders(ctx, "", "")
+	owners := k.GetAllOwners(ctx)
+	pendingOwners := k.GetAllPendingOwners(ctx)
+
+	blockedAddresses := types.AddressLists{}
+	allowedAddresses := types.AddressLists{}
+	for _, asset := range params.Assets {
+		if blocked := k.GetBlockedAddresses(ctx, asset.Denom); len(blocked) > 0 {
+			blockedAddresses = append(blockedAddresses, types.NewAddressList(asset.Denom, blocked))
+		}
+		if allowed := k.GetAllowedAddresses(ctx, asset.Denom); len(allowed) > 0 {
+			allowedAddresses = append(allowedAddresses, types.NewAddressList(asset.Denom, allowed))
+		}
+	}
+	return types.NewGenesisState(params, supplies, buckets, roleHolders, owners, pendingOwners, blockedAddresses, allowedAddresses)
 }
@@ -24,6 +24,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgUnblockAddress(ctx, k, msg)
 		case types.MsgSetPauseStatus:
 			return handleMsgSetPauseStatus(ctx, k, msg)
+		case types.MsgGrantRole:
+			return handleMsgGrantRole(ctx, k, msg)
+		case types.MsgRevokeRole:
+			return handleMsgRevokeRole(ctx, k, msg)
+		case types.MsgTransferOwnership:
+			return handleMsgTransferOwnership(ctx, k, msg)
+		case types.MsgAcceptOwnership:
+			return handleMsgAcceptOwnership(ctx, k, msg)
+		case types.MsgAllowAddress:
+			return handleMsgAllowAddress(ctx, k, msg)
+		case types.MsgDisallowAddress:
+			return handleMsgDisallowAddress(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -114,3 +126,105 @@ func handleMsgSetPauseStatus(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetP
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgGrantRole(ctx sdk.Context, k keeper.Keeper, msg types.MsgGrantRole) (*sdk.Result, error) {
+	err := k.GrantRole(ctx, msg.Sender, msg.Denom, msg.Role, msg.Address, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgRevokeRole(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeRole) (*sdk.Result, error) {
+	err := k.RevokeRole(ctx, msg.Sender, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgTransferOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferOwnership) (*sdk.Result, error) {
+	err := k.TransferOwnership(ctx, msg.Sender, msg.Denom, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgAcceptOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgAcceptOwnership) (*sdk.Result, error) {
+	err := k.AcceptOwnership(ctx, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgAllowAddress(ctx sdk.Context, k keeper.Keeper, msg types.MsgAllowAddress) (*sdk.Result, error) {
+	err := k.AllowAddress(ctx, msg.Denom, msg.Sender, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgDisallowAddress(ctx sdk.Context, k keeper.Keeper, msg types.MsgDisallowAddress) (*sdk.Result, error) {
+	err := k.DisallowAddress(ctx, msg.Denom, msg.Sender, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
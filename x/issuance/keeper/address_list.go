@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+// IsBlocked returns true if an address is on an asset's block list
+func (k Keeper) IsBlocked(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.hasAssetAddress(ctx, types.BlockedAddressPrefix, denom, addr)
+}
+
+// SetBlockedAddress adds an address to an asset's block list
+func (k Keeper) SetBlockedAddress(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.setAssetAddress(ctx, types.BlockedAddressPrefix, denom, addr)
+}
+
+// DeleteBlockedAddress removes an address from an asset's block list
+func (k Keeper) DeleteBlockedAddress(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.deleteAssetAddress(ctx, types.BlockedAddressPrefix, denom, addr)
+}
+
+// IterateBlockedAddresses provides an iterator over the addresses on an asset's block list
+func (k Keeper) IterateBlockedAddresses(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	k.iterateAssetAddresses(ctx, types.BlockedAddressPrefix, denom, cb)
+}
+
+// GetBlockedAddresses returns the addresses on an asset's block list
+func (k Keeper) GetBlockedAddresses(ctx sdk.Context, denom string) (addrs []sdk.AccAddress) {
+	k.IterateBlockedAddresses(ctx, denom, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	return
+}
+
+// IsAllowed returns true if an address is on an asset's allowlist
+func (k Keeper) IsAllowed(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.hasAssetAddress(ctx, types.AllowedAddressPrefix, denom, addr)
+}
+
+// SetAllowedAddress adds an address to an asset's allowlist
+func (k Keeper) SetAllowedAddress(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.setAssetAddress(ctx, types.AllowedAddressPrefix, denom, addr)
+}
+
+// DeleteAllowedAddress removes an address from an asset's allowlist
+func (k Keeper) DeleteAllowedAddress(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.deleteAssetAddress(ctx, types.AllowedAddressPrefix, denom, addr)
+}
+
+// IterateAllowedAddresses provides an iterator over the addresses on an asset's allowlist
+func (k Keeper) IterateAllowedAddresses(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	k.iterateAssetAddresses(ctx, types.AllowedAddressPrefix, denom, cb)
+}
+
+// GetAllowedAddresses returns the addresses on an asset's allowlist
+func (k Keeper) GetAllowedAddresses(ctx sdk.Context, denom string) (addrs []sdk.AccAddress) {
+	k.IterateAllowedAddresses(ctx, denom, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	return
+}
+
+// AllowAddress adds an address to an asset's allowlist. The allowlist can be managed before allowlist mode is enabled for the asset.
+func (k Keeper) AllowAddress(ctx sdk.Context, denom string, owner, addr sdk.AccAddress) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if !owner.Equals(asset.Owner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	}
+	if k.IsAllowed(ctx, denom, addr) {
+		return sdkerrors.Wrapf(types.ErrAccountAlreadyAllowed, "address: %s", addr)
+	}
+	k.SetAllowedAddress(ctx, denom, addr)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAllow,
+			sdk.NewAttribute(types.AttributeKeyAllow, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+	return nil
+}
+
+// DisallowAddress removes an address from an asset's allowlist. Tokens the address holds aren't seized, but it can't receive more.
+func (k Keeper) DisallowAddress(ctx sdk.Context, denom string, owner, addr sdk.AccAddress) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if !owner.Equals(asset.Owner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	}
+	if !k.IsAllowed(ctx, denom, addr) {
+		return sdkerrors.Wrapf(types.ErrAccountNotAllowed, "address: %s", addr)
+	}
+	k.DeleteAllowedAddress(ctx, denom, addr)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisallow,
+			sdk.NewAttribute(types.AttributeKeyDisallow, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+	return nil
+}
+
+// canReceive returns false if an asset is in allowlist mode and the address is neither the owner nor on the allowlist
+func (k Keeper) canReceive(ctx sdk.Context, asset types.Asset, addr sdk.AccAddress) bool {
+	if !asset.AllowlistEnabled || addr.Equals(asset.Owner) {
+		return true
+	}
+	return k.IsAllowed(ctx, asset.Denom, addr)
+}
+
+func (k Keeper) hasAssetAddress(ctx sdk.Context, listPrefix []byte, denom string, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.key), listPrefix)
+	return store.Has(types.AssetAddressKey(denom, addr))
+}
+
+func (k Keeper) setAssetAddress(ctx sdk.Context, listPrefix []byte, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), listPrefix)
+	store.Set(types.AssetAddressKey(denom, addr), addr)
+}
+
+func (k Keeper) deleteAssetAddress(ctx sdk.Context, listPrefix []byte, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), listPrefix)
+	store.Delete(types.AssetAddressKey(denom, addr))
+}
+
+func (k Keeper) iterateAssetAddresses(ctx sdk.Context, listPrefix []byte, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), listPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.AssetAddressesKey(denom))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// deleteAssetAddresses deletes all the entries of an asset's block list or allowlist
+func (k Keeper) deleteAssetAddresses(ctx sdk.Context, listPrefix []byte, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), listPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.AssetAddressesKey(denom))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/issuance/keeper"
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+func (suite *KeeperTestSuite) TestAllowlist() {
+	owner, allowed, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	asset := types.NewAsset(owner, "usdtoken", false, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+
+	err := suite.keeper.AllowAddress(suite.ctx, "usdtoken", allowed, allowed)
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+	suite.Require().NoError(suite.keeper.AllowAddress(suite.ctx, "usdtoken", owner, allowed))
+	err = suite.keeper.AllowAddress(suite.ctx, "usdtoken", owner, allowed)
+	suite.Require().True(errors.Is(err, types.ErrAccountAlreadyAllowed))
+
+	// only the owner and allowed addresses can receive the asset
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 100), owner, allowed))
+	err = suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 100), owner, other)
+	suite.Require().True(errors.Is(err, types.ErrAccountNotAllowed))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 10))
+	suite.Require().NoError(suite.keeper.ValidateTransfer(suite.ctx, allowed, owner, coins))
+	err = suite.keeper.ValidateTransfer(suite.ctx, allowed, other, coins)
+	suite.Require().True(errors.Is(err, types.ErrAccountNotAllowed))
+
+	// disallowed addresses can neither send the tokens they hold nor receive more
+	suite.Require().NoError(suite.keeper.DisallowAddress(suite.ctx, "usdtoken", owner, allowed))
+	err = suite.keeper.ValidateTransfer(suite.ctx, allowed, owner, coins)
+	suite.Require().True(errors.Is(err, types.ErrAccountNotAllowed))
+	err = suite.keeper.ValidateTransfer(suite.ctx, owner, allowed, coins)
+	suite.Require().True(errors.Is(err, types.ErrAccountNotAllowed))
+	err = suite.keeper.DisallowAddress(suite.ctx, "usdtoken", owner, allowed)
+	suite.Require().True(errors.Is(err, types.ErrAccountNotAllowed))
+
+	// an owner that accepted ownership can receive the asset without being on the allowlist
+	suite.Require().NoError(suite.keeper.TransferOwnership(suite.ctx, owner, "usdtoken", other))
+	suite.Require().NoError(suite.keeper.AcceptOwnership(suite.ctx, other, "usdtoken"))
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 100), other, other))
+}
+
+func (suite *KeeperTestSuite) TestSynchronizeBlockList() {
+	owner := suite.addrs[0]
+	noLimit := types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))
+	assets := types.Assets{
+		types.NewAsset(owner, "usdtoken", false, true, false, noLimit),
+		types.NewAsset(owner, "opentoken", false, true, false, noLimit),
+	}
+	suite.keeper.SetParams(suite.ctx, types.NewParams(assets))
+	suite.keeper.SetBlockedAddress(suite.ctx, "usdtoken", suite.addrs[1])
+	suite.keeper.SetBlockedAddress(suite.ctx, "opentoken", suite.addrs[3])
+
+	// a param change proposal makes an asset unblockable
+	assets[1].Blockable = false
+	subspace, found := suite.app.GetParamsKeeper().GetSubspace(types.DefaultParamspace)
+	suite.Require().True(found)
+	params := types.NewParams(assets)
+	subspace.SetParamSet(suite.ctx, &params)
+
+	suite.keeper.SynchronizeBlockList(suite.ctx)
+
+	suite.Require().True(suite.keeper.IsBlocked(suite.ctx, "usdtoken", suite.addrs[1]))
+	suite.Require().Empty(suite.keeper.GetBlockedAddresses(suite.ctx, "opentoken"))
+}
+
+func (suite *KeeperTestSuite) TestQuerierGetAddresses() {
+	owner := suite.addrs[0]
+	asset := types.NewAsset(owner, "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+	for _, addr := range suite.addrs[1:] {
+		suite.keeper.SetBlockedAddress(suite.ctx, "usdtoken", addr)
+	}
+	querier := keeper.NewQuerier(suite.keeper)
+
+	var pages [][]sdk.AccAddress
+	for page := 1; page <= 3; page++ {
+		bz, err := querier(suite.ctx, []string{types.QueryGetBlockedAddresses}, abci.RequestQuery{
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAddressesParams(page, 3, "usdtoken")),
+		})
+		suite.Require().NoError(err)
+		var addrs []sdk.AccAddress
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &addrs))
+		pages = append(pages, addrs)
+	}
+	suite.Require().Len(pages[0], 3)
+	suite.Require().Len(pages[1], 1)
+	suite.Require().Empty(pages[2])
+	suite.Require().ElementsMatch(suite.addrs[1:], append(pages[0], pages[1]...))
+
+	_, err := querier(suite.ctx, []string{types.QueryGetAllowedAddresses}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAddressesParams(1, 3, "othertoken")),
+	})
+	suite.Require().True(errors.Is(err, types.ErrAssetNotFound))
+}
@@ -9,23 +9,24 @@ import (
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
-// IssueTokens mints new tokens and sends them to the receiver address
-func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, owner, receiver sdk.AccAddress) error {
+// IssueTokens mints new tokens and sends them to the receiver address.
+// Tokens can be issued by the asset owner, or by a minter up to its allowance.
+func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, sender, receiver sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, tokens.Denom)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", tokens.Denom)
 	}
-	if !owner.Equals(asset.Owner) {
-		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	if err := k.checkRole(ctx, asset, types.RoleMinter, sender); err != nil {
+		return err
 	}
 	if asset.Paused {
 		return sdkerrors.Wrapf(types.ErrAssetPaused, "denom: %s", tokens.Denom)
 	}
-	if asset.Blockable {
-		blocked, _ := k.checkBlockedAddress(asset, receiver)
-		if blocked {
-			return sdkerrors.Wrapf(types.ErrAccountBlocked, "address: %s", receiver)
-		}
+	if asset.Blockable && k.IsBlocked(ctx, asset.Denom, receiver) {
+		return sdkerrors.Wrapf(types.ErrAccountBlocked, "address: %s", receiver)
+	}
+	if !k.canReceive(ctx, asset, receiver) {
+		return sdkerrors.Wrapf(types.ErrAccountNotAllowed, "address: %s", receiver)
 	}
 	acc := k.accountKeeper.GetAccount(ctx, receiver)
 	_, ok := acc.(supplyexported.ModuleAccountI)
@@ -33,6 +34,16 @@ func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, owner, receiver sd
 		return sdkerrors.Wrapf(types.ErrIssueToModuleAccount, "address: %s", receiver)
 	}
 
+	// minters issue from their allowance, the owner's issuance isn't limited by an allowance
+	if !sender.Equals(asset.Owner) {
+		minter, _ := k.GetRoleHolder(ctx, asset.Denom, types.RoleMinter, sender)
+		if minter.Allowance.LT(tokens.Amount) {
+			return sdkerrors.Wrapf(types.ErrExceedsMinterAllowance, "allowance: %s, requested: %s", minter.Allowance, tokens.Amount)
+		}
+		minter.Allowance = minter.Allowance.Sub(tokens.Amount)
+		k.SetRoleHolder(ctx, minter)
+	}
+
 	// for rate-limited assets, check that the issuance isn't over the limit
 	if asset.RateLimit.Active {
 		err := k.IncrementCurrentAssetSupply(ctx, tokens)
@@ -60,20 +71,20 @@ func (k Keeper) IssueTokens(ctx sdk.Context, tokens sdk.Coin, owner, receiver sd
 	return nil
 }
 
-// RedeemTokens sends tokens from the owner address to the module account and burns them
-func (k Keeper) RedeemTokens(ctx sdk.Context, tokens sdk.Coin, owner sdk.AccAddress) error {
+// RedeemTokens sends tokens from the owner or a minter address to the module account and burns them
+func (k Keeper) RedeemTokens(ctx sdk.Context, tokens sdk.Coin, sender sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, tokens.Denom)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", tokens.Denom)
 	}
-	if !owner.Equals(asset.Owner) {
-		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	if err := k.checkRole(ctx, asset, types.RoleMinter, sender); err != nil {
+		return err
 	}
 	if asset.Paused {
 		return sdkerrors.Wrapf(types.ErrAssetPaused, "denom: %s", tokens.Denom)
 	}
 	coins := sdk.NewCoins(tokens)
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, coins)
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleAccountName, coins)
 	if err != nil {
 		return err
 	}
@@ -81,6 +92,13 @@ func (k Keeper) RedeemTokens(ctx sdk.Context, tokens sdk.Coin, owner sdk.AccAddr
 	if err != nil {
 		return err
 	}
+	// for rate-limited assets, redeemed tokens free up capacity taken by recent issuance
+	if asset.RateLimit.Active {
+		err = k.DecrementCurrentAssetSupply(ctx, tokens)
+		if err != nil {
+			return err
+		}
+	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRedeem,
@@ -90,8 +108,8 @@ func (k Keeper) RedeemTokens(ctx sdk.Context, tokens sdk.Coin, owner sdk.AccAddr
 	return nil
 }
 
-// BlockAddress adds an address to the blocked list
-func (k Keeper) BlockAddress(ctx sdk.Context, denom string, owner, blockedAddress sdk.AccAddress) error {
+// BlockAddress adds an address to the blocked list. Addresses can be blocked by the asset owner or a blocker.
+func (k Keeper) BlockAddress(ctx sdk.Context, denom string, sender, blockedAddress sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, denom)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
@@ -99,19 +117,20 @@ func (k Keeper) BlockAddress(ctx sdk.Context, denom string, owner, blockedAddres
 	if !asset.Blockable {
 		return sdkerrors.Wrap(types.ErrAssetUnblockable, denom)
 	}
-	if !owner.Equals(asset.Owner) {
-		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	if err := k.checkRole(ctx, asset, types.RoleBlocker, sender); err != nil {
+		return err
+	}
+	if blockedAddress.Equals(asset.Owner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "asset owner cannot be blocked: %s", blockedAddress)
 	}
-	blocked, _ := k.checkBlockedAddress(asset, blockedAddress)
-	if blocked {
+	if k.IsBlocked(ctx, denom, blockedAddress) {
 		return sdkerrors.Wrapf(types.ErrAccountAlreadyBlocked, "address: %s", blockedAddress)
 	}
 	account := k.accountKeeper.GetAccount(ctx, blockedAddress)
 	if account == nil {
 		return sdkerrors.Wrapf(types.ErrAccountNotFound, "address: %s", blockedAddress)
 	}
-	asset.BlockedAddresses = append(asset.BlockedAddresses, blockedAddress)
-	k.SetAsset(ctx, asset)
+	k.SetBlockedAddress(ctx, denom, blockedAddress)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeBlock,
@@ -122,8 +141,8 @@ func (k Keeper) BlockAddress(ctx sdk.Context, denom string, owner, blockedAddres
 	return nil
 }
 
-// UnblockAddress removes an address from the blocked list
-func (k Keeper) UnblockAddress(ctx sdk.Context, denom string, owner, addr sdk.AccAddress) error {
+// UnblockAddress removes an address from the blocked list. Addresses can be unblocked by the asset owner or a blocker.
+func (k Keeper) UnblockAddress(ctx sdk.Context, denom string, sender, addr sdk.AccAddress) error {
 	asset, found := k.GetAsset(ctx, denom)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
@@ -131,17 +150,13 @@ func (k Keeper) UnblockAddress(ctx sdk.Context, denom string, owner, addr sdk.Ac
 	if !asset.Blockable {
 		return sdkerrors.Wrap(types.ErrAssetUnblockable, denom)
 	}
-	if !owner.Equals(asset.Owner) {
-		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	if err := k.checkRole(ctx, asset, types.RoleBlocker, sender); err != nil {
+		return err
 	}
-	blocked, i := k.checkBlockedAddress(asset, addr)
-	if !blocked {
+	if !k.IsBlocked(ctx, denom, addr) {
 		return sdkerrors.Wrapf(types.ErrAccountAlreadyUnblocked, "address: %s", addr)
 	}
-
-	blockedAddrs := k.removeBlockedAddress(asset.BlockedAddresses, i)
-	asset.BlockedAddresses = blockedAddrs
-	k.SetAsset(ctx, asset)
+	k.DeleteBlockedAddress(ctx, denom, addr)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeUnblock,
@@ -152,14 +167,14 @@ func (k Keeper) UnblockAddress(ctx sdk.Context, denom string, owner, addr sdk.Ac
 	return nil
 }
 
-// SetPauseStatus pauses/un-pauses an asset
-func (k Keeper) SetPauseStatus(ctx sdk.Context, owner sdk.AccAddress, denom string, status bool) error {
+// SetPauseStatus pauses/un-pauses an asset. Assets can be paused by the asset owner or a pauser.
+func (k Keeper) SetPauseStatus(ctx sdk.Context, sender sdk.AccAddress, denom string, status bool) error {
 	asset, found := k.GetAsset(ctx, denom)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
 	}
-	if !owner.Equals(asset.Owner) {
-		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	if err := k.checkRole(ctx, asset, types.RolePauser, sender); err != nil {
+		return err
 	}
 	if asset.Paused == status {
 		return nil
@@ -196,7 +211,7 @@ func (k Keeper) SeizeCoinsFromBlockedAddresses(ctx sdk.Context, denom string) er
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
 	}
-	for _, address := range asset.BlockedAddresses {
+	for _, address := range k.GetBlockedAddresses(ctx, denom) {
 		account := k.accountKeeper.GetAccount(ctx, address)
 		if account == nil {
 			// avoids a potential panic
@@ -227,16 +242,46 @@ func (k Keeper) SeizeCoinsFromBlockedAddresses(ctx sdk.Context, denom string) er
 	return nil
 }
 
-func (k Keeper) checkBlockedAddress(asset types.Asset, checkAddress sdk.AccAddress) (bool, int) {
-	for i, address := range asset.BlockedAddresses {
-		if address.Equals(checkAddress) {
-			return true, i
-		}
+// ValidateTransfer checks that coins can be transferred between two addresses. Transfers of paused assets are rejected,
+// as are transfers of blockable assets to or from blocked addresses, and transfers of allowlist assets to or from
+// addresses that aren't on the allowlist. An empty from or to address isn't checked.
+func (k Keeper) ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	// transfers are checked by other modules' keepers, which can run before issuance params are set
+	var assets types.Assets
+	k.paramSubspace.GetIfExists(ctx, types.KeyAssets, &assets)
+	if len(assets) == 0 {
+		return nil
 	}
-	return false, 0
-}
 
-func (k Keeper) removeBlockedAddress(blockedAddrs []sdk.AccAddress, i int) []sdk.AccAddress {
-	blockedAddrs[len(blockedAddrs)-1], blockedAddrs[i] = blockedAddrs[i], blockedAddrs[len(blockedAddrs)-1]
-	return blockedAddrs[:len(blockedAddrs)-1]
+	for _, asset := range assets {
+		coin := sdk.NewCoin(asset.Denom, coins.AmountOf(asset.Denom))
+		if coin.IsZero() {
+			continue
+		}
+		if asset.Paused {
+			return sdkerrors.Wrapf(types.ErrAssetPaused, "denom: %s", coin.Denom)
+		}
+		if owner, found := k.GetOwner(ctx, asset.Denom); found {
+			asset.Owner = owner
+		}
+		// holders that aren't on the allowlist can't move the tokens they hold either, so turning on allowlist mode
+		// freezes tokens held outside the list
+		for _, addr := range []sdk.AccAddress{from, to} {
+			if !addr.Empty() && !k.canReceive(ctx, asset, addr) {
+				return sdkerrors.Wrapf(types.ErrAccountNotAllowed, "address: %s, denom: %s", addr, coin.Denom)
+			}
+		}
+		if !asset.Blockable {
+			continue
+		}
+		for _, addr := range []sdk.AccAddress{from, to} {
+			if addr.Empty() {
+				continue
+			}
+			if k.IsBlocked(ctx, asset.Denom, addr) {
+				return sdkerrors.Wrapf(types.ErrAccountBlocked, "address: %s, denom: %s", addr, coin.Denom)
+			}
+		}
+	}
+	return nil
 }
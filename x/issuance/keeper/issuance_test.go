@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -64,7 +65,7 @@ func (suite *KeeperTestSuite) getModuleAccount(name string) supplyexported.Modul
 func (suite *KeeperTestSuite) TestGetSetParams() {
 	params := suite.keeper.GetParams(suite.ctx)
 	suite.Require().Equal(types.Params{Assets: types.Assets(nil)}, params)
-	asset := types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	asset := types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
 	params = types.NewParams(types.Assets{asset})
 	suite.keeper.SetParams(suite.ctx, params)
 	newParams := suite.keeper.GetParams(suite.ctx)
@@ -91,7 +92,7 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			"valid issuance",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:   suite.addrs[0],
 				tokens:   sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -106,7 +107,7 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			"non-owner issuance",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:   suite.addrs[2],
 				tokens:   sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -121,7 +122,7 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			"invalid denom",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:   suite.addrs[0],
 				tokens:   sdk.NewCoin("othertoken", sdk.NewInt(100000)),
@@ -136,7 +137,7 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			"issue to blocked address",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:   suite.addrs[0],
 				tokens:   sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -151,7 +152,7 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			"issue to module account",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:   suite.addrs[0],
 				tokens:   sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -166,7 +167,7 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			"paused issuance",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, true, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", true, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:   suite.addrs[0],
 				tokens:   sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -183,6 +184,10 @@ func (suite *KeeperTestSuite) TestIssueTokens() {
 			suite.SetupTest()
 			params := types.NewParams(tc.args.assets)
 			suite.keeper.SetParams(suite.ctx, params)
+			// addrs[1] is on the block list of each asset
+			for _, asset := range tc.args.assets {
+				suite.keeper.SetBlockedAddress(suite.ctx, asset.Denom, suite.addrs[1])
+			}
 			err := suite.keeper.IssueTokens(suite.ctx, tc.args.tokens, tc.args.sender, tc.args.receiver)
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err, tc.name)
@@ -218,7 +223,7 @@ func (suite *KeeperTestSuite) TestIssueTokensRateLimited() {
 			"valid issuance",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
 				},
 				supplies: types.AssetSupplies{
 					types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour),
@@ -237,7 +242,7 @@ func (suite *KeeperTestSuite) TestIssueTokensRateLimited() {
 			"over-limit issuance",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
 				},
 				supplies: types.AssetSupplies{
 					types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour),
@@ -295,7 +300,7 @@ func (suite *KeeperTestSuite) TestRedeemTokens() {
 			"valid redemption",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:        suite.addrs[0],
 				initialTokens: sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -310,7 +315,7 @@ func (suite *KeeperTestSuite) TestRedeemTokens() {
 			"invalid denom redemption",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:        suite.addrs[0],
 				initialTokens: sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -325,7 +330,7 @@ func (suite *KeeperTestSuite) TestRedeemTokens() {
 			"non-owner redemption",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:        suite.addrs[2],
 				initialTokens: sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -340,7 +345,7 @@ func (suite *KeeperTestSuite) TestRedeemTokens() {
 			"paused redemption",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, true, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", true, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:        suite.addrs[0],
 				initialTokens: sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -355,7 +360,7 @@ func (suite *KeeperTestSuite) TestRedeemTokens() {
 			"redeem amount greater than balance",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:        suite.addrs[0],
 				initialTokens: sdk.NewCoin("usdtoken", sdk.NewInt(100000)),
@@ -413,7 +418,7 @@ func (suite *KeeperTestSuite) TestBlockAddress() {
 			"valid block",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				blockedAddr: suite.addrs[1],
@@ -428,7 +433,7 @@ func (suite *KeeperTestSuite) TestBlockAddress() {
 			"unblockable token",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				blockedAddr: suite.addrs[1],
@@ -443,7 +448,7 @@ func (suite *KeeperTestSuite) TestBlockAddress() {
 			"non-owner block",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[2],
 				blockedAddr: suite.addrs[1],
@@ -458,7 +463,7 @@ func (suite *KeeperTestSuite) TestBlockAddress() {
 			"invalid denom block",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				blockedAddr: suite.addrs[1],
@@ -473,7 +478,7 @@ func (suite *KeeperTestSuite) TestBlockAddress() {
 			"block non-existing account",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				blockedAddr: sdk.AccAddress(crypto.AddressHash([]byte("RandomAddr"))),
@@ -494,15 +499,7 @@ func (suite *KeeperTestSuite) TestBlockAddress() {
 			err := suite.keeper.BlockAddress(suite.ctx, tc.args.denom, tc.args.sender, tc.args.blockedAddr)
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err, tc.name)
-				asset, found := suite.keeper.GetAsset(suite.ctx, tc.args.denom)
-				blocked := false
-				suite.Require().True(found)
-				for _, blockedAddr := range asset.BlockedAddresses {
-					if blockedAddr.Equals(tc.args.blockedAddr) {
-						blocked = true
-					}
-				}
-				suite.Require().True(blocked)
+				suite.Require().True(suite.keeper.IsBlocked(suite.ctx, tc.args.denom, tc.args.blockedAddr))
 			} else {
 				suite.Require().Error(err, tc.name)
 				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
@@ -531,7 +528,7 @@ func (suite *KeeperTestSuite) TestUnblockAddress() {
 			"valid unblock",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				blockedAddr: suite.addrs[1],
@@ -546,7 +543,7 @@ func (suite *KeeperTestSuite) TestUnblockAddress() {
 			"non-owner unblock",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[2],
 				blockedAddr: suite.addrs[1],
@@ -561,7 +558,7 @@ func (suite *KeeperTestSuite) TestUnblockAddress() {
 			"invalid denom block",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				blockedAddr: suite.addrs[1],
@@ -578,19 +575,15 @@ func (suite *KeeperTestSuite) TestUnblockAddress() {
 			suite.SetupTest()
 			params := types.NewParams(tc.args.assets)
 			suite.keeper.SetParams(suite.ctx, params)
+			// addrs[1] is on the block list of each asset
+			for _, asset := range tc.args.assets {
+				suite.keeper.SetBlockedAddress(suite.ctx, asset.Denom, suite.addrs[1])
+			}
 
 			err := suite.keeper.UnblockAddress(suite.ctx, tc.args.denom, tc.args.sender, tc.args.blockedAddr)
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err, tc.name)
-				asset, found := suite.keeper.GetAsset(suite.ctx, tc.args.denom)
-				blocked := false
-				suite.Require().True(found)
-				for _, blockedAddr := range asset.BlockedAddresses {
-					if blockedAddr.Equals(tc.args.blockedAddr) {
-						blocked = true
-					}
-				}
-				suite.Require().False(blocked)
+				suite.Require().False(suite.keeper.IsBlocked(suite.ctx, tc.args.denom, tc.args.blockedAddr))
 			} else {
 				suite.Require().Error(err, tc.name)
 				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
@@ -620,7 +613,7 @@ func (suite *KeeperTestSuite) TestChangePauseStatus() {
 			"valid pause",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				startStatus: false,
@@ -636,7 +629,7 @@ func (suite *KeeperTestSuite) TestChangePauseStatus() {
 			"valid unpause",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, true, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", true, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				startStatus: true,
@@ -652,7 +645,7 @@ func (suite *KeeperTestSuite) TestChangePauseStatus() {
 			"non-owner pause",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[2],
 				startStatus: false,
@@ -668,7 +661,7 @@ func (suite *KeeperTestSuite) TestChangePauseStatus() {
 			"invalid denom pause",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				sender:      suite.addrs[0],
 				startStatus: true,
@@ -721,7 +714,7 @@ func (suite *KeeperTestSuite) TestSeizeCoinsFromBlockedAddress() {
 			"valid seize",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				initialCoins: sdk.NewCoin("usdtoken", sdk.NewInt(100000000)),
 				denom:        "usdtoken",
@@ -736,7 +729,7 @@ func (suite *KeeperTestSuite) TestSeizeCoinsFromBlockedAddress() {
 			"invalid denom seize",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				initialCoins: sdk.NewCoin("usdtoken", sdk.NewInt(100000000)),
 				denom:        "othertoken",
@@ -751,13 +744,13 @@ func (suite *KeeperTestSuite) TestSeizeCoinsFromBlockedAddress() {
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
-			assetsWithBlockedAddrs := types.Assets{}
+			params := types.NewParams(tc.args.assets)
+			suite.keeper.SetParams(suite.ctx, params)
 			for _, asset := range tc.args.assets {
-				asset.BlockedAddresses = tc.args.blockedAddrs
-				assetsWithBlockedAddrs = append(assetsWithBlockedAddrs, asset)
+				for _, addr := range tc.args.blockedAddrs {
+					suite.keeper.SetBlockedAddress(suite.ctx, asset.Denom, addr)
+				}
 			}
-			params := types.NewParams(assetsWithBlockedAddrs)
-			suite.keeper.SetParams(suite.ctx, params)
 			sk := suite.app.GetSupplyKeeper()
 			for _, addr := range tc.args.blockedAddrs {
 				err := sk.MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(tc.args.initialCoins))
@@ -781,6 +774,48 @@ func (suite *KeeperTestSuite) TestSeizeCoinsFromBlockedAddress() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestValidateTransfer() {
+	noLimit := types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))
+	assets := types.Assets{
+		types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, noLimit),
+		types.NewAsset(suite.addrs[0], "pausedtoken", true, false, false, noLimit),
+		types.NewAsset(suite.addrs[0], "opentoken", false, false, false, noLimit),
+		types.NewAsset(suite.addrs[0], "allowtoken", false, false, true, noLimit),
+	}
+	suite.keeper.SetParams(suite.ctx, types.NewParams(assets))
+	suite.keeper.SetBlockedAddress(suite.ctx, "usdtoken", suite.addrs[1])
+	suite.keeper.SetAllowedAddress(suite.ctx, "allowtoken", suite.addrs[3])
+
+	testCases := []struct {
+		name        string
+		from        sdk.AccAddress
+		to          sdk.AccAddress
+		coins       sdk.Coins
+		expectedErr error
+	}{
+		{"unrestricted", suite.addrs[2], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 10), sdk.NewInt64Coin("ukava", 10)), nil},
+		{"from blocked address", suite.addrs[1], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 10)), types.ErrAccountBlocked},
+		{"to blocked address", suite.addrs[2], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 10)), types.ErrAccountBlocked},
+		{"blocked address, other denom", suite.addrs[1], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("opentoken", 10)), nil},
+		{"empty address skipped", nil, suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("usdtoken", 10)), nil},
+		{"paused asset", suite.addrs[2], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("pausedtoken", 10)), types.ErrAssetPaused},
+		{"allowed addresses", suite.addrs[3], suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin("allowtoken", 10)), nil},
+		{"to address not on allowlist", suite.addrs[3], suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin("allowtoken", 10)), types.ErrAccountNotAllowed},
+		{"from holder not on allowlist", suite.addrs[2], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("allowtoken", 10)), types.ErrAccountNotAllowed},
+		{"from holder not on allowlist to owner", suite.addrs[2], suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin("allowtoken", 10)), types.ErrAccountNotAllowed},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.keeper.ValidateTransfer(suite.ctx, tc.from, tc.to, tc.coins)
+			if tc.expectedErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().True(errors.Is(err, tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
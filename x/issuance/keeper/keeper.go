@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
@@ -18,6 +19,7 @@ type Keeper struct {
 	paramSubspace subspace.Subspace
 	accountKeeper types.AccountKeeper
 	supplyKeeper  types.SupplyKeeper
+	window        ratelimit.Window
 }
 
 // NewKeeper returns a new keeper
@@ -32,6 +34,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		paramSubspace: paramstore,
 		accountKeeper: ak,
 		supplyKeeper:  sk,
+		window:        ratelimit.NewWindow(cdc, key, types.RateLimitPrefix),
 	}
 }
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -19,22 +20,27 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetAsset returns an asset from the params and a boolean for if it was found
+// GetAsset returns an asset from the params and a boolean for if it was found.
+// The asset's owner is replaced by the owner in the store, if ownership has been transferred.
 func (k Keeper) GetAsset(ctx sdk.Context, denom string) (types.Asset, bool) {
 	params := k.GetParams(ctx)
 	for _, asset := range params.Assets {
 		if asset.Denom == denom {
+			if owner, found := k.GetOwner(ctx, denom); found {
+				asset.Owner = owner
+			}
 			return asset, true
 		}
 	}
 	return types.Asset{}, false
 }
 
-// SetAsset sets an asset in the params
+// SetAsset sets an asset in the params. The owner in params is left unchanged, ownership is set with SetOwner.
 func (k Keeper) SetAsset(ctx sdk.Context, asset types.Asset) {
 	params := k.GetParams(ctx)
 	for i := range params.Assets {
 		if params.Assets[i].Denom == asset.Denom {
+			asset.Owner = params.Assets[i].Owner
 			params.Assets[i] = asset
 		}
 	}
@@ -50,13 +56,69 @@ func (k Keeper) GetRateLimit(ctx sdk.Context, denom string) (types.RateLimit, er
 	return asset.RateLimit, nil
 }
 
-// SynchronizeBlockList resets the block list to empty for any asset that is not blockable - could happen if this value is changed via governance
+// SynchronizeBlockList resets the block list to empty for any asset that is not blockable,
+// which could happen if params are changed via governance
 func (k Keeper) SynchronizeBlockList(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	for _, asset := range params.Assets {
-		if !asset.Blockable && len(asset.BlockedAddresses) > 0 {
-			asset.BlockedAddresses = []sdk.AccAddress{}
-			k.SetAsset(ctx, asset)
+		if asset.Blockable {
+			continue
 		}
+		for _, addr := range k.GetBlockedAddresses(ctx, asset.Denom) {
+			k.DeleteBlockedAddress(ctx, asset.Denom, addr)
+		}
+	}
+}
+
+// ClearRemovedAssets deletes the owner, pending owner, role holders, block list and allowlist of assets that have been
+// removed from params, so they aren't carried over if an asset with the same denom is added again.
+// The denoms of assets in params are recorded in the store, so only the state of recorded denoms missing from params is visited.
+func (k Keeper) ClearRemovedAssets(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	assets := make(map[string]bool)
+	for _, asset := range params.Assets {
+		assets[asset.Denom] = true
+	}
+	recorded := make(map[string]bool)
+	for _, denom := range k.getAssetDenoms(ctx) {
+		recorded[denom] = true
+		if !assets[denom] {
+			k.clearAsset(ctx, denom)
+		}
+	}
+	for _, asset := range params.Assets {
+		if !recorded[asset.Denom] {
+			k.setAssetDenom(ctx, asset.Denom)
+		}
+	}
+}
+
+// clearAsset deletes the state of a removed asset and its recorded denom
+func (k Keeper) clearAsset(ctx sdk.Context, denom string) {
+	k.DeleteOwner(ctx, denom)
+	k.DeletePendingOwner(ctx, denom)
+	for _, roleHolder := range k.GetRoleHolders(ctx, denom, "") {
+		k.DeleteRoleHolder(ctx, roleHolder.Denom, roleHolder.Role, roleHolder.Address)
+	}
+	k.deleteAssetAddresses(ctx, types.BlockedAddressPrefix, denom)
+	k.deleteAssetAddresses(ctx, types.AllowedAddressPrefix, denom)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetDenomPrefix)
+	store.Delete([]byte(denom))
+}
+
+// setAssetDenom records the denom of an asset in params
+func (k Keeper) setAssetDenom(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetDenomPrefix)
+	store.Set([]byte(denom), []byte{})
+}
+
+// getAssetDenoms returns the recorded denoms of assets
+func (k Keeper) getAssetDenoms(ctx sdk.Context) (denoms []string) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.AssetDenomPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(types.AssetDenomPrefix):]))
 	}
+	return
 }
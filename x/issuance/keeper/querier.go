@@ -7,6 +7,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
@@ -16,6 +17,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, k)
+		case types.QueryGetRoles:
+			return queryGetRoles(ctx, req, k)
+		case types.QueryGetOwners:
+			return queryGetOwners(ctx, req, k)
+		case types.QueryGetPendingOwners:
+			return queryGetPendingOwners(ctx, req, k)
+		case types.QueryGetBlockedAddresses:
+			return queryGetAddresses(ctx, req, k, k.IterateBlockedAddresses)
+		case types.QueryGetAllowedAddresses:
+			return queryGetAddresses(ctx, req, k, k.IterateAllowedAddresses)
+		case types.QueryGetCapacities:
+			return queryGetCapacities(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -34,3 +47,131 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	}
 	return bz, nil
 }
+
+func queryGetRoles(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRolesParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Role != "" {
+		if err := types.ValidateRole(params.Role); err != nil {
+			return nil, err
+		}
+		if params.Denom == "" {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "denom is required when querying by role")
+		}
+	}
+
+	roleHolders := k.GetRoleHolders(ctx, params.Denom, params.Role)
+	if roleHolders == nil {
+		roleHolders = types.RoleHolders{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, roleHolders)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// queryGetOwners returns the current owner of each asset, whether it's the owner in params or one that accepted ownership
+func queryGetOwners(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	owners := types.AssetOwners{}
+	for _, asset := range k.GetParams(ctx).Assets {
+		asset, _ = k.GetAsset(ctx, asset.Denom)
+		owners = append(owners, types.NewAssetOwner(asset.Denom, asset.Owner))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, owners)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetPendingOwners(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	pendingOwners := k.GetAllPendingOwners(ctx)
+	if pendingOwners == nil {
+		pendingOwners = types.PendingOwners{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, pendingOwners)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// queryGetAddresses returns a page of an asset's block list or allowlist, without loading the rest of the list
+func queryGetAddresses(ctx sdk.Context, req abci.RequestQuery, k Keeper,
+	iterate func(sdk.Context, string, func(sdk.AccAddress) bool)) ([]byte, error) {
+	var params types.QueryAddressesParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if _, found := k.GetAsset(ctx, params.Denom); !found {
+		return nil, sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", params.Denom)
+	}
+
+	page, limit := params.Page, params.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	skip := (page - 1) * limit
+
+	addresses := []sdk.AccAddress{}
+	iterate(ctx, params.Denom, func(addr sdk.AccAddress) bool {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		addresses = append(addresses, addr)
+		return len(addresses) >= limit
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, addresses)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// queryGetCapacities returns the remaining rate limit capacity of an asset, or of all rate-limited assets if the denom is empty
+func queryGetCapacities(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAssetParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	capacities := ratelimit.Capacities{}
+	if params.Denom != "" {
+		capacity, err := k.GetCapacity(ctx, params.Denom)
+		if err != nil {
+			return nil, err
+		}
+		capacities = append(capacities, capacity)
+	} else {
+		for _, asset := range k.GetParams(ctx).Assets {
+			if !asset.RateLimit.Active {
+				continue
+			}
+			capacity, err := k.GetCapacity(ctx, asset.Denom)
+			if err != nil {
+				return nil, err
+			}
+			capacities = append(capacities, capacity)
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, capacities)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+// GetRoleHolder returns the role holder for an address, asset and role
+func (k Keeper) GetRoleHolder(ctx sdk.Context, denom, role string, addr sdk.AccAddress) (types.RoleHolder, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RoleHolderPrefix)
+	bz := store.Get(types.RoleHolderKey(denom, role, addr))
+	if bz == nil {
+		return types.RoleHolder{}, false
+	}
+	var roleHolder types.RoleHolder
+	k.cdc.MustUnmarshalBinaryBare(bz, &roleHolder)
+	return roleHolder, true
+}
+
+// SetRoleHolder stores a role holder
+func (k Keeper) SetRoleHolder(ctx sdk.Context, roleHolder types.RoleHolder) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RoleHolderPrefix)
+	store.Set(types.RoleHolderKey(roleHolder.Denom, roleHolder.Role, roleHolder.Address), k.cdc.MustMarshalBinaryBare(roleHolder))
+}
+
+// DeleteRoleHolder removes a role holder from the store
+func (k Keeper) DeleteRoleHolder(ctx sdk.Context, denom, role string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RoleHolderPrefix)
+	store.Delete(types.RoleHolderKey(denom, role, addr))
+}
+
+// IterateRoleHolders provides an iterator over the role holders of an asset's role.
+// An empty role iterates over all of the asset's roles, and an empty denom iterates over all role holders.
+func (k Keeper) IterateRoleHolders(ctx sdk.Context, denom, role string, cb func(roleHolder types.RoleHolder) (stop bool)) {
+	keyPrefix := types.RoleHolderPrefix
+	if denom != "" {
+		keyPrefix = append(append([]byte{}, types.RoleHolderPrefix...), types.RoleHoldersKey(denom, role)...)
+	}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var roleHolder types.RoleHolder
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &roleHolder)
+
+		if cb(roleHolder) {
+			break
+		}
+	}
+}
+
+// GetRoleHolders returns the role holders of an asset's role, of all of an asset's roles if role is empty,
+// or of all assets if denom is empty
+func (k Keeper) GetRoleHolders(ctx sdk.Context, denom, role string) (roleHolders types.RoleHolders) {
+	k.IterateRoleHolders(ctx, denom, role, func(roleHolder types.RoleHolder) bool {
+		roleHolders = append(roleHolders, roleHolder)
+		return false
+	})
+	return
+}
+
+// GetOwner returns the address that accepted ownership of an asset. Assets that have never changed hands have no owner
+// in the store and are owned by the owner in params.
+func (k Keeper) GetOwner(ctx sdk.Context, denom string) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OwnerPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetOwner stores the owner of an asset, which takes the place of the owner in params
+func (k Keeper) SetOwner(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OwnerPrefix)
+	store.Set([]byte(denom), addr)
+}
+
+// DeleteOwner removes an asset's owner from the store
+func (k Keeper) DeleteOwner(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OwnerPrefix)
+	store.Delete([]byte(denom))
+}
+
+// GetAllOwners returns the owners in the store of all assets
+func (k Keeper) GetAllOwners(ctx sdk.Context) (owners types.AssetOwners) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OwnerPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.OwnerPrefix):])
+		owners = append(owners, types.NewAssetOwner(denom, iterator.Value()))
+	}
+	return
+}
+
+// GetPendingOwner returns the address nominated as the new owner of an asset
+func (k Keeper) GetPendingOwner(ctx sdk.Context, denom string) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingOwnerPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetPendingOwner stores the address nominated as the new owner of an asset
+func (k Keeper) SetPendingOwner(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingOwnerPrefix)
+	store.Set([]byte(denom), addr)
+}
+
+// DeletePendingOwner removes an asset's pending owner from the store
+func (k Keeper) DeletePendingOwner(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PendingOwnerPrefix)
+	store.Delete([]byte(denom))
+}
+
+// GetAllPendingOwners returns the pending owners of all assets
+func (k Keeper) GetAllPendingOwners(ctx sdk.Context) (pendingOwners types.PendingOwners) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PendingOwnerPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.PendingOwnerPrefix):])
+		pendingOwners = append(pendingOwners, types.NewPendingOwner(denom, iterator.Value()))
+	}
+	return
+}
+
+// GrantRole grants a role for an asset to an address. Granting the minter role to an existing minter replaces its allowance.
+func (k Keeper) GrantRole(ctx sdk.Context, owner sdk.AccAddress, denom, role string, addr sdk.AccAddress, allowance sdk.Int) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if !owner.Equals(asset.Owner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	}
+	roleHolder := types.NewRoleHolder(denom, role, addr, allowance)
+	if err := roleHolder.Validate(); err != nil {
+		return err
+	}
+	k.SetRoleHolder(ctx, roleHolder)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantRole,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRole, role),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, allowance.String()),
+		),
+	)
+	return nil
+}
+
+// RevokeRole revokes a role for an asset from an address
+func (k Keeper) RevokeRole(ctx sdk.Context, owner sdk.AccAddress, denom, role string, addr sdk.AccAddress) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if !owner.Equals(asset.Owner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	}
+	if _, found := k.GetRoleHolder(ctx, denom, role, addr); !found {
+		return sdkerrors.Wrapf(types.ErrRoleNotFound, "denom: %s, role: %s, address: %s", denom, role, addr)
+	}
+	k.DeleteRoleHolder(ctx, denom, role, addr)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeRole,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRole, role),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		),
+	)
+	return nil
+}
+
+// TransferOwnership nominates a new owner for an asset. The current owner keeps ownership until the new owner accepts it.
+// Nominating a new owner replaces any previous nomination.
+func (k Keeper) TransferOwnership(ctx sdk.Context, owner sdk.AccAddress, denom string, newOwner sdk.AccAddress) error {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	if !owner.Equals(asset.Owner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "owner: %s, address: %s", asset.Owner, owner)
+	}
+	k.SetPendingOwner(ctx, denom, newOwner)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferOwner,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPendingOwner, newOwner.String()),
+		),
+	)
+	return nil
+}
+
+// AcceptOwnership makes an asset's pending owner its owner. The owner is kept in the store rather than params,
+// so a later change to the asset's params can't hand the asset back to a previous owner.
+func (k Keeper) AcceptOwnership(ctx sdk.Context, newOwner sdk.AccAddress, denom string) error {
+	if _, found := k.GetAsset(ctx, denom); !found {
+		return sdkerrors.Wrapf(types.ErrAssetNotFound, "denom: %s", denom)
+	}
+	pendingOwner, found := k.GetPendingOwner(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoPendingOwner, "denom: %s", denom)
+	}
+	if !newOwner.Equals(pendingOwner) {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "pending owner: %s, address: %s", pendingOwner, newOwner)
+	}
+	if k.IsBlocked(ctx, denom, newOwner) {
+		return sdkerrors.Wrapf(types.ErrAccountBlocked, "address: %s", newOwner)
+	}
+	k.SetOwner(ctx, denom, newOwner)
+	k.DeletePendingOwner(ctx, denom)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptOwner,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, newOwner.String()),
+		),
+	)
+	return nil
+}
+
+// checkRole returns an error if an address is neither the asset owner nor holds the role for the asset
+func (k Keeper) checkRole(ctx sdk.Context, asset types.Asset, role string, addr sdk.AccAddress) error {
+	if addr.Equals(asset.Owner) {
+		return nil
+	}
+	if _, found := k.GetRoleHolder(ctx, asset.Denom, role, addr); !found {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "address %s is not the owner or a %s of %s", addr, role, asset.Denom)
+	}
+	return nil
+}
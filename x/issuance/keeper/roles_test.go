@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+func (suite *KeeperTestSuite) TestGrantRevokeRole() {
+	owner, minter, pauser := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	asset := types.NewAsset(owner, "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+
+	err := suite.keeper.GrantRole(suite.ctx, minter, "usdtoken", types.RoleMinter, minter, sdk.NewInt(100))
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+	err = suite.keeper.GrantRole(suite.ctx, owner, "usdtoken", "admin", minter, sdk.ZeroInt())
+	suite.Require().True(errors.Is(err, types.ErrInvalidRole))
+	err = suite.keeper.GrantRole(suite.ctx, owner, "othertoken", types.RoleMinter, minter, sdk.NewInt(100))
+	suite.Require().True(errors.Is(err, types.ErrAssetNotFound))
+
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, owner, "usdtoken", types.RoleMinter, minter, sdk.NewInt(100)))
+	suite.Require().NoError(suite.keeper.GrantRole(suite.ctx, owner, "usdtoken", types.RolePauser, pauser, sdk.ZeroInt()))
+	suite.Require().Len(suite.keeper.GetRoleHolders(suite.ctx, "usdtoken", ""), 2)
+	suite.Require().Equal(
+		types.RoleHolders{types.NewRoleHolder("usdtoken", types.RoleMinter, minter, sdk.NewInt(100))},
+		suite.keeper.GetRoleHolders(suite.ctx, "usdtoken", types.RoleMinter),
+	)
+
+	err = suite.keeper.RevokeRole(suite.ctx, owner, "usdtoken", types.RoleBlocker, pauser)
+	suite.Require().True(errors.Is(err, types.ErrRoleNotFound))
+	suite.Require().NoError(suite.keeper.RevokeRole(suite.ctx, owner, "usdtoken", types.RolePauser, pauser))
+	_, found := suite.keeper.GetRoleHolder(suite.ctx, "usdtoken", types.RolePauser, pauser)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRoleAuthorization() {
+	owner, minter, pauser, blocker := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	asset := types.NewAsset(owner, "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+	suite.keeper.SetRoleHolder(suite.ctx, types.NewRoleHolder("usdtoken", types.RoleMinter, minter, sdk.NewInt(100)))
+	suite.keeper.SetRoleHolder(suite.ctx, types.NewRoleHolder("usdtoken", types.RolePauser, pauser, sdk.ZeroInt()))
+	suite.keeper.SetRoleHolder(suite.ctx, types.NewRoleHolder("usdtoken", types.RoleBlocker, blocker, sdk.ZeroInt()))
+
+	// minters issue up to their allowance, and can redeem tokens
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 60), minter, minter))
+	err := suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 60), minter, minter)
+	suite.Require().True(errors.Is(err, types.ErrExceedsMinterAllowance))
+	roleHolder, _ := suite.keeper.GetRoleHolder(suite.ctx, "usdtoken", types.RoleMinter, minter)
+	suite.Require().Equal(sdk.NewInt(40), roleHolder.Allowance)
+	suite.Require().NoError(suite.keeper.RedeemTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 10), minter))
+	err = suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 10), pauser, pauser)
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+
+	// the owner's issuance isn't limited by an allowance
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 1000), owner, owner))
+
+	// blockers block and unblock addresses
+	err = suite.keeper.BlockAddress(suite.ctx, "usdtoken", pauser, suite.addrs[4])
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+	suite.Require().NoError(suite.keeper.BlockAddress(suite.ctx, "usdtoken", blocker, suite.addrs[4]))
+	suite.Require().NoError(suite.keeper.UnblockAddress(suite.ctx, "usdtoken", blocker, suite.addrs[4]))
+
+	// pausers pause and un-pause the asset
+	err = suite.keeper.SetPauseStatus(suite.ctx, blocker, "usdtoken", true)
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+	suite.Require().NoError(suite.keeper.SetPauseStatus(suite.ctx, pauser, "usdtoken", true))
+	asset, _ = suite.keeper.GetAsset(suite.ctx, "usdtoken")
+	suite.Require().True(asset.Paused)
+}
+
+func (suite *KeeperTestSuite) TestTransferOwnership() {
+	owner, newOwner := suite.addrs[0], suite.addrs[1]
+	asset := types.NewAsset(owner, "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0)))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+
+	err := suite.keeper.AcceptOwnership(suite.ctx, newOwner, "usdtoken")
+	suite.Require().True(errors.Is(err, types.ErrNoPendingOwner))
+	err = suite.keeper.TransferOwnership(suite.ctx, newOwner, "usdtoken", newOwner)
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+
+	// ownership is unchanged until the new owner accepts it
+	suite.Require().NoError(suite.keeper.TransferOwnership(suite.ctx, owner, "usdtoken", newOwner))
+	asset, _ = suite.keeper.GetAsset(suite.ctx, "usdtoken")
+	suite.Require().Equal(owner, asset.Owner)
+	suite.Require().Equal(types.PendingOwners{types.NewPendingOwner("usdtoken", newOwner)}, suite.keeper.GetAllPendingOwners(suite.ctx))
+
+	err = suite.keeper.AcceptOwnership(suite.ctx, suite.addrs[2], "usdtoken")
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+	suite.Require().NoError(suite.keeper.AcceptOwnership(suite.ctx, newOwner, "usdtoken"))
+
+	asset, _ = suite.keeper.GetAsset(suite.ctx, "usdtoken")
+	suite.Require().Equal(newOwner, asset.Owner)
+	_, found := suite.keeper.GetPendingOwner(suite.ctx, "usdtoken")
+	suite.Require().False(found)
+
+	suite.Require().Equal(types.AssetOwners{types.NewAssetOwner("usdtoken", newOwner)}, suite.keeper.GetAllOwners(suite.ctx))
+
+	// a later change to the asset's params doesn't give the asset back to the previous owner
+	params := suite.keeper.GetParams(suite.ctx)
+	suite.Require().Equal(owner, params.Assets[0].Owner)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.keeper.SetPauseStatus(suite.ctx, newOwner, "usdtoken", true))
+	asset, _ = suite.keeper.GetAsset(suite.ctx, "usdtoken")
+	suite.Require().Equal(newOwner, asset.Owner)
+	err = suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 10), owner, owner)
+	suite.Require().True(errors.Is(err, types.ErrNotAuthorized))
+}
+
+func (suite *KeeperTestSuite) TestClearRemovedAssets() {
+	owner, newOwner, minter, pendingOwner := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	rateLimit := types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))
+	usdToken := types.NewAsset(owner, "usdtoken", false, true, false, rateLimit)
+	otherToken := types.NewAsset(owner, "othertoken", false, true, false, rateLimit)
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{usdToken, otherToken}))
+	for _, denom := range []string{"usdtoken", "othertoken"} {
+		suite.keeper.SetOwner(suite.ctx, denom, newOwner)
+		suite.keeper.SetPendingOwner(suite.ctx, denom, pendingOwner)
+		suite.keeper.SetRoleHolder(suite.ctx, types.NewRoleHolder(denom, types.RoleMinter, minter, sdk.NewInt(100)))
+		suite.keeper.SetBlockedAddress(suite.ctx, denom, suite.addrs[4])
+		suite.keeper.SetAllowedAddress(suite.ctx, denom, minter)
+	}
+
+	// assets still in params keep their state
+	suite.keeper.ClearRemovedAssets(suite.ctx)
+	suite.Require().Len(suite.keeper.GetAllOwners(suite.ctx), 2)
+	suite.Require().Len(suite.keeper.GetRoleHolders(suite.ctx, "", ""), 2)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{usdToken}))
+	suite.keeper.ClearRemovedAssets(suite.ctx)
+
+	suite.Require().Equal(types.AssetOwners{types.NewAssetOwner("usdtoken", newOwner)}, suite.keeper.GetAllOwners(suite.ctx))
+	suite.Require().Equal(types.PendingOwners{types.NewPendingOwner("usdtoken", pendingOwner)}, suite.keeper.GetAllPendingOwners(suite.ctx))
+	suite.Require().Equal(
+		types.RoleHolders{types.NewRoleHolder("usdtoken", types.RoleMinter, minter, sdk.NewInt(100))},
+		suite.keeper.GetRoleHolders(suite.ctx, "", ""),
+	)
+	suite.Require().Empty(suite.keeper.GetBlockedAddresses(suite.ctx, "othertoken"))
+	suite.Require().Empty(suite.keeper.GetAllowedAddresses(suite.ctx, "othertoken"))
+	suite.Require().Len(suite.keeper.GetBlockedAddresses(suite.ctx, "usdtoken"), 1)
+	suite.Require().Len(suite.keeper.GetAllowedAddresses(suite.ctx, "usdtoken"), 1)
+
+	// an asset added again with the same denom starts without the removed asset's state
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{usdToken, otherToken}))
+	asset, _ := suite.keeper.GetAsset(suite.ctx, "othertoken")
+	suite.Require().Equal(owner, asset.Owner)
+	suite.Require().Empty(suite.keeper.GetRoleHolders(suite.ctx, "othertoken", ""))
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
@@ -17,7 +18,8 @@ func (k Keeper) CreateNewAssetSupply(ctx sdk.Context, denom string) types.AssetS
 	return supply
 }
 
-// IncrementCurrentAssetSupply increments an asset's supply by the coin
+// IncrementCurrentAssetSupply increments an asset's supply by the coin, if the asset is rate limited.
+// The net amount issued over the rate limit's window, including the coin, must be within the limit.
 func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
@@ -35,43 +37,79 @@ func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) erro
 		if supplyLimit.IsLT(supply.CurrentSupply.Add(coin)) {
 			return sdkerrors.Wrapf(types.ErrExceedsSupplyLimit, "increase %s, asset supply %s, limit %s", coin, supply.CurrentSupply, supplyLimit)
 		}
+		k.window.Record(ctx, coin.Denom, coin.Amount)
 		supply.CurrentSupply = supply.CurrentSupply.Add(coin)
 		k.SetAssetSupply(ctx, supply, coin.Denom)
 	}
 	return nil
 }
 
-// UpdateTimeBasedSupplyLimits updates the time based supply for each asset, resetting it if the current time window has elapsed.
-func (k Keeper) UpdateTimeBasedSupplyLimits(ctx sdk.Context) {
-	params := k.GetParams(ctx)
-	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
+// DecrementCurrentAssetSupply decrements an asset's supply by the coin when tokens are redeemed, if the asset is rate limited.
+// Redeemed tokens cancel the most recent issuance in the rate limit's window, and don't decrement the supply below zero.
+func (k Keeper) DecrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
-		previousBlockTime = ctx.BlockTime()
-		k.SetPreviousBlockTime(ctx, previousBlockTime)
+		return sdkerrors.Wrap(types.ErrAssetNotFound, coin.Denom)
 	}
-	timeElapsed := ctx.BlockTime().Sub(previousBlockTime)
+
+	limit, err := k.GetRateLimit(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if limit.Active {
+		cancelled := k.window.Cancel(ctx, coin.Denom, coin.Amount)
+		supply.CurrentSupply = supply.CurrentSupply.Sub(sdk.NewCoin(coin.Denom, cancelled))
+		k.SetAssetSupply(ctx, supply, coin.Denom)
+	}
+	return nil
+}
+
+// GetRateLimitBuckets returns the issuance recorded in the rate limit window of each asset
+func (k Keeper) GetRateLimitBuckets(ctx sdk.Context) ratelimit.Buckets {
+	return k.window.GetAllBuckets(ctx)
+}
+
+// SetRateLimitBucket stores issuance in an asset's rate limit window. It is used to import the window from genesis.
+func (k Keeper) SetRateLimitBucket(ctx sdk.Context, bucket ratelimit.Bucket) {
+	k.window.SetBucket(ctx, bucket)
+}
+
+// GetCapacity returns the net amount of a rate-limited asset issued within its rate limit's window, and the amount that can still be issued
+func (k Keeper) GetCapacity(ctx sdk.Context, denom string) (ratelimit.Capacity, error) {
+	limit, err := k.GetRateLimit(ctx, denom)
+	if err != nil {
+		return ratelimit.Capacity{}, err
+	}
+	if !limit.Active {
+		return ratelimit.Capacity{}, sdkerrors.Wrap(types.ErrRateLimitNotActive, denom)
+	}
+	issued := sdk.ZeroInt()
+	if supply, found := k.GetAssetSupply(ctx, denom); found {
+		issued = supply.CurrentSupply.Amount
+	}
+	return ratelimit.NewCapacity(denom, limit.Limit, limit.TimePeriod, issued), nil
+}
+
+// UpdateTimeBasedSupplyLimits removes issuance that is no longer within the rate limit's window from each asset's supply.
+// The supply of assets that aren't rate limited is reset.
+func (k Keeper) UpdateTimeBasedSupplyLimits(ctx sdk.Context) {
+	params := k.GetParams(ctx)
 	for _, asset := range params.Assets {
 		supply, found := k.GetAssetSupply(ctx, asset.Denom)
 		// if a new asset has been added by governance, create a new asset supply for it in the store
 		if !found {
 			supply = k.CreateNewAssetSupply(ctx, asset.Denom)
 		}
-		if !asset.RateLimit.Active {
+		if asset.RateLimit.Active {
+			expired := k.window.Prune(ctx, asset.Denom, asset.RateLimit.TimePeriod)
+			supply.CurrentSupply = supply.CurrentSupply.Sub(sdk.NewCoin(asset.Denom, expired))
+		} else {
 			// rate limiting is not active, reset supply
+			k.window.Clear(ctx, asset.Denom)
 			supply.CurrentSupply = sdk.NewCoin(asset.Denom, sdk.ZeroInt())
-			supply.TimeElapsed = time.Duration(0)
-			k.SetAssetSupply(ctx, supply, asset.Denom)
-			continue
-		}
-		if asset.RateLimit.TimePeriod > supply.TimeElapsed+timeElapsed {
-			// rate limiting is active, the rate-limiting period has not expired
-			supply.TimeElapsed = supply.TimeElapsed + timeElapsed
-			k.SetAssetSupply(ctx, supply, asset.Denom)
-			continue
 		}
-		// rate limiting is active, the rate-limiting period has expired, and is now reset
 		supply.TimeElapsed = time.Duration(0)
-		supply.CurrentSupply = sdk.NewCoin(asset.Denom, sdk.ZeroInt())
 		k.SetAssetSupply(ctx, supply, asset.Denom)
 	}
 	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
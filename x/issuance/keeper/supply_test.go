@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/ratelimit"
+	"github.com/kava-labs/kava/x/issuance/keeper"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
@@ -28,7 +33,7 @@ func (suite *KeeperTestSuite) TestIncrementCurrentAssetSupply() {
 			"valid supply increase",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
 				},
 				supplies: types.AssetSupplies{
 					types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour),
@@ -44,7 +49,7 @@ func (suite *KeeperTestSuite) TestIncrementCurrentAssetSupply() {
 			"over limit increase",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(10000000000), time.Hour*24)),
 				},
 				supplies: types.AssetSupplies{
 					types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour),
@@ -81,3 +86,82 @@ func (suite *KeeperTestSuite) TestIncrementCurrentAssetSupply() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRollingWindowRateLimit() {
+	owner := suite.addrs[0]
+	asset := types.NewAsset(owner, "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(1000), time.Hour*24))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+	suite.keeper.CreateNewAssetSupply(suite.ctx, "usdtoken")
+	nextBlock := func(timeElapsed time.Duration) {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(timeElapsed))
+		suite.keeper.UpdateTimeBasedSupplyLimits(suite.ctx)
+	}
+	issue := func(amount int64) error {
+		return suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", amount), owner, owner)
+	}
+
+	// issuing the full limit either side of where a fixed period would have reset is still limited
+	nextBlock(time.Hour * 23)
+	suite.Require().NoError(issue(1000))
+	nextBlock(time.Hour * 2)
+	suite.Require().True(errors.Is(issue(1), types.ErrExceedsSupplyLimit))
+
+	// redeemed tokens cancel recent issuance
+	suite.Require().NoError(suite.keeper.RedeemTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 400), owner))
+	capacity, err := suite.keeper.GetCapacity(suite.ctx, "usdtoken")
+	suite.Require().NoError(err)
+	suite.Require().Equal(ratelimit.NewCapacity("usdtoken", sdk.NewInt(1000), time.Hour*24, sdk.NewInt(600)), capacity)
+	suite.Require().NoError(issue(400))
+	suite.Require().True(errors.Is(issue(1), types.ErrExceedsSupplyLimit))
+
+	// issuance leaves the window a full period after the block it was issued in
+	nextBlock(time.Hour*22 - time.Second)
+	suite.Require().True(errors.Is(issue(1), types.ErrExceedsSupplyLimit))
+	nextBlock(time.Second)
+	suite.Require().True(errors.Is(issue(601), types.ErrExceedsSupplyLimit))
+	suite.Require().NoError(issue(600))
+
+	// redeeming more than was issued in the window doesn't create capacity above the limit
+	suite.Require().NoError(suite.keeper.RedeemTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 1500), owner))
+	suite.Require().True(errors.Is(issue(1001), types.ErrExceedsSupplyLimit))
+
+	querier := keeper.NewQuerier(suite.keeper)
+	bz, err := querier(suite.ctx, []string{types.QueryGetCapacities}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAssetParams("")),
+	})
+	suite.Require().NoError(err)
+	var capacities ratelimit.Capacities
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &capacities))
+	suite.Require().Equal(ratelimit.Capacities{ratelimit.NewCapacity("usdtoken", sdk.NewInt(1000), time.Hour*24, sdk.ZeroInt())}, capacities)
+}
+
+func (suite *KeeperTestSuite) TestRateLimitBucketsGenesis() {
+	owner := suite.addrs[0]
+	asset := types.NewAsset(owner, "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(1000), time.Hour*24))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+	suite.keeper.CreateNewAssetSupply(suite.ctx, "usdtoken")
+	issuedAt := suite.ctx.BlockTime()
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 300), owner, owner))
+	suite.ctx = suite.ctx.WithBlockTime(issuedAt.Add(time.Hour * 12))
+	suite.Require().NoError(suite.keeper.IssueTokens(suite.ctx, sdk.NewInt64Coin("usdtoken", 500), owner, owner))
+
+	buckets := suite.keeper.GetRateLimitBuckets(suite.ctx)
+	suite.Require().Equal(ratelimit.Buckets{
+		ratelimit.NewBucket("usdtoken", issuedAt, sdk.NewInt(300)),
+		ratelimit.NewBucket("usdtoken", issuedAt.Add(time.Hour*12), sdk.NewInt(500)),
+	}, buckets)
+
+	// importing the buckets into a new chain keeps the times tokens were issued at
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockTime(issuedAt.Add(time.Hour * 12))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.Assets{asset}))
+	suite.keeper.SetAssetSupply(suite.ctx, types.NewAssetSupply(sdk.NewInt64Coin("usdtoken", 800), time.Duration(0)), "usdtoken")
+	for _, bucket := range buckets {
+		suite.keeper.SetRateLimitBucket(suite.ctx, bucket)
+	}
+	suite.ctx = suite.ctx.WithBlockTime(issuedAt.Add(time.Hour * 24))
+	suite.keeper.UpdateTimeBasedSupplyLimits(suite.ctx)
+	capacity, err := suite.keeper.GetCapacity(suite.ctx, "usdtoken")
+	suite.Require().NoError(err)
+	suite.Require().Equal(ratelimit.NewCapacity("usdtoken", sdk.NewInt(1000), time.Hour*24, sdk.NewInt(500)), capacity)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/issuance/types"
 
@@ -25,6 +26,23 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
 		return fmt.Sprintf("%s\n%s", timeA, timeB)
+	case bytes.Equal(kvA.Key[:1], types.RoleHolderPrefix):
+		var roleHolderA, roleHolderB types.RoleHolder
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &roleHolderA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &roleHolderB)
+		return fmt.Sprintf("%s\n%s", roleHolderA, roleHolderB)
+	case bytes.Equal(kvA.Key[:1], types.OwnerPrefix),
+		bytes.Equal(kvA.Key[:1], types.PendingOwnerPrefix),
+		bytes.Equal(kvA.Key[:1], types.BlockedAddressPrefix),
+		bytes.Equal(kvA.Key[:1], types.AllowedAddressPrefix):
+		return fmt.Sprintf("%s\n%s", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+	case bytes.Equal(kvA.Key[:1], types.RateLimitPrefix):
+		var amountA, amountB sdk.Int
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &amountA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &amountB)
+		return fmt.Sprintf("%s\n%s", amountA, amountB)
+	case bytes.Equal(kvA.Key[:1], types.AssetDenomPrefix):
+		return fmt.Sprintf("%s\n%s", kvA.Key[1:], kvB.Key[1:])
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 
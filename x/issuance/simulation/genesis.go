@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
@@ -22,7 +23,7 @@ var (
 func RandomizedGenState(simState *module.SimulationState) {
 	accs = simState.Accounts
 	params := randomizedParams(simState.Rand)
-	gs := types.NewGenesisState(params, types.AssetSupplies{})
+	gs := types.NewGenesisState(params, types.AssetSupplies{}, ratelimit.Buckets{}, types.RoleHolders{}, types.AssetOwners{}, types.PendingOwners{}, types.AddressLists{}, types.AddressLists{})
 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, gs))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
 }
@@ -46,7 +47,7 @@ func randomizedAssets(r *rand.Rand) types.Assets {
 			assetLimit := simulation.RandIntBetween(r, 100000000000, 1000000000000)
 			rateLimit = types.NewRateLimit(true, sdk.NewInt(int64(assetLimit)), timeLimit)
 		}
-		randomAsset := types.NewAsset(owner.Address, denom, []sdk.AccAddress{}, paused, true, rateLimit)
+		randomAsset := types.NewAsset(owner.Address, denom, paused, true, false, rateLimit)
 		randomAssets = append(randomAssets, randomAsset)
 	}
 	return randomAssets
@@ -110,10 +110,8 @@ func SimulateMsgIssueTokens(ak types.AccountKeeper, k keeper.Keeper) simulation.
 		if recipient == nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
-		for _, blockedAddr := range asset.BlockedAddresses {
-			if recipient.GetAddress().Equals(blockedAddr) {
-				return simulation.NoOpMsg(types.ModuleName), nil, nil
-			}
+		if k.IsBlocked(ctx, asset.Denom, recipient.GetAddress()) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 		randomAmount := simulation.RandIntBetween(r, 10000000, 1000000000000)
 		if asset.RateLimit.Active {
@@ -243,10 +241,8 @@ func SimulateMsgBlockAddress(ak types.AccountKeeper, k keeper.Keeper) simulation
 		if blockedAccount.GetAddress().Equals(asset.Owner) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
-		for _, blockedAddr := range asset.BlockedAddresses {
-			if blockedAccount.GetAddress().Equals(blockedAddr) {
-				return simulation.NoOpMsg(types.ModuleName), nil, nil
-			}
+		if k.IsBlocked(ctx, asset.Denom, blockedAccount.GetAddress()) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
 		msg := types.NewMsgBlockAddress(asset.Owner, asset.Denom, blockedAccount.GetAddress())
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressList is the block list or allowlist of an asset
+type AddressList struct {
+	Denom     string           `json:"denom" yaml:"denom"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+}
+
+// NewAddressList returns a new AddressList
+func NewAddressList(denom string, addresses []sdk.AccAddress) AddressList {
+	return AddressList{
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+// Validate performs a basic validation of address list fields
+func (al AddressList) Validate() error {
+	if err := sdk.ValidateDenom(al.Denom); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, addr := range al.Addresses {
+		if addr.Empty() {
+			return fmt.Errorf("address list for %s contains an empty address", al.Denom)
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate address in list for %s: %s", al.Denom, addr)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (al AddressList) String() string {
+	return fmt.Sprintf(`Address List:
+	Denom: %s
+	Addresses: %s
+	`, al.Denom, al.Addresses)
+}
+
+// AddressLists is a slice of AddressList
+type AddressLists []AddressList
+
+// Validate checks each address list and that each asset has at most one list
+func (als AddressLists) Validate() error {
+	seen := make(map[string]bool)
+	for _, al := range als {
+		if err := al.Validate(); err != nil {
+			return err
+		}
+		if seen[al.Denom] {
+			return fmt.Errorf("duplicate address list for %s", al.Denom)
+		}
+		seen[al.Denom] = true
+	}
+	return nil
+}
@@ -19,5 +19,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBlockAddress{}, "issuance/MsgBlockAddress", nil)
 	cdc.RegisterConcrete(MsgUnblockAddress{}, "issuance/MsgUnblockAddress", nil)
 	cdc.RegisterConcrete(MsgSetPauseStatus{}, "issuance/MsgChangePauseStatus", nil)
+	cdc.RegisterConcrete(MsgGrantRole{}, "issuance/MsgGrantRole", nil)
+	cdc.RegisterConcrete(MsgRevokeRole{}, "issuance/MsgRevokeRole", nil)
+	cdc.RegisterConcrete(MsgTransferOwnership{}, "issuance/MsgTransferOwnership", nil)
+	cdc.RegisterConcrete(MsgAcceptOwnership{}, "issuance/MsgAcceptOwnership", nil)
+	cdc.RegisterConcrete(MsgAllowAddress{}, "issuance/MsgAllowAddress", nil)
+	cdc.RegisterConcrete(MsgDisallowAddress{}, "issuance/MsgDisallowAddress", nil)
 	cdc.RegisterConcrete(Asset{}, "issuance/Asset", nil)
 }
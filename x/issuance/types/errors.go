@@ -18,4 +18,11 @@ var (
 	ErrExceedsSupplyLimit      = sdkerrors.Register(ModuleName, 9, "asset supply over limit")
 	ErrAssetUnblockable        = sdkerrors.Register(ModuleName, 10, "asset does not support block/unblock functionality")
 	ErrAccountNotFound         = sdkerrors.Register(ModuleName, 11, "cannot block account that does not exist in state")
+	ErrInvalidRole             = sdkerrors.Register(ModuleName, 12, "invalid role")
+	ErrRoleNotFound            = sdkerrors.Register(ModuleName, 13, "role not found")
+	ErrExceedsMinterAllowance  = sdkerrors.Register(ModuleName, 14, "issuance exceeds minter allowance")
+	ErrNoPendingOwner          = sdkerrors.Register(ModuleName, 15, "no pending owner for asset")
+	ErrAccountNotAllowed       = sdkerrors.Register(ModuleName, 16, "account is not on the asset allowlist")
+	ErrAccountAlreadyAllowed   = sdkerrors.Register(ModuleName, 17, "account is already on the asset allowlist")
+	ErrRateLimitNotActive      = sdkerrors.Register(ModuleName, 18, "asset is not rate limited")
 )
@@ -8,12 +8,24 @@ const (
 	EventTypeUnblock         = "unblock_address"
 	EventTypePause           = "change_pause_status"
 	EventTypeSeize           = "seize_coins_from_blocked_address"
+	EventTypeGrantRole       = "grant_role"
+	EventTypeRevokeRole      = "revoke_role"
+	EventTypeTransferOwner   = "transfer_ownership"
+	EventTypeAcceptOwner     = "accept_ownership"
+	EventTypeAllow           = "allow_address"
+	EventTypeDisallow        = "disallow_address"
 	AttributeValueCategory   = ModuleName
 	AttributeKeyDenom        = "denom"
 	AttributeKeyIssueAmount  = "amount_issued"
 	AttributeKeyRedeemAmount = "amount_redeemed"
 	AttributeKeyBlock        = "address_blocked"
 	AttributeKeyUnblock      = "address_unblocked"
+	AttributeKeyAllow        = "address_allowed"
+	AttributeKeyDisallow     = "address_disallowed"
 	AttributeKeyAddress      = "address"
 	AttributeKeyPauseStatus  = "pause_status"
+	AttributeKeyRole         = "role"
+	AttributeKeyAllowance    = "allowance"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyPendingOwner = "pending_owner"
 )
@@ -1,39 +1,139 @@
 package types
 
-import "bytes"
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/kava-labs/kava/ratelimit"
+)
 
 // GenesisState is the state that must be provided at genesis for the issuance module
 type GenesisState struct {
-	Params   Params        `json:"params" yaml:"params"`
-	Supplies AssetSupplies `json:"supplies" yaml:"supplies"`
+	Params           Params            `json:"params" yaml:"params"`
+	Supplies         AssetSupplies     `json:"supplies" yaml:"supplies"`
+	RateLimitBuckets ratelimit.Buckets `json:"rate_limit_buckets" yaml:"rate_limit_buckets"`
+	RoleHolders      RoleHolders       `json:"role_holders" yaml:"role_holders"`
+	Owners           AssetOwners       `json:"owners" yaml:"owners"`
+	PendingOwners    PendingOwners     `json:"pending_owners" yaml:"pending_owners"`
+	BlockedAddresses AddressLists      `json:"blocked_addresses" yaml:"blocked_addresses"`
+	AllowedAddresses AddressLists      `json:"allowed_addresses" yaml:"allowed_addresses"`
 }
 
 // NewGenesisState returns a new GenesisState
-func NewGenesisState(params Params, supplies AssetSupplies) GenesisState {
+func NewGenesisState(params Params, supplies AssetSupplies, rateLimitBuckets ratelimit.Buckets, roleHolders RoleHolders, owners AssetOwners, pendingOwners PendingOwners,
+	blockedAddresses, allowedAddresses AddressLists) GenesisState {
 	return GenesisState{
-		Params:   params,
-		Supplies: supplies,
+		Params:           params,
+		Supplies:         supplies,
+		RateLimitBuckets: rateLimitBuckets,
+		RoleHolders:      roleHolders,
+		Owners:           owners,
+		PendingOwners:    pendingOwners,
+		BlockedAddresses: blockedAddresses,
+		AllowedAddresses: allowedAddresses,
 	}
 }
 
 // DefaultGenesisState returns the default GenesisState for the issuance module
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:   DefaultParams(),
-		Supplies: AssetSupplies{},
+		Params:           DefaultParams(),
+		Supplies:         AssetSupplies{},
+		RateLimitBuckets: ratelimit.Buckets{},
+		RoleHolders:      RoleHolders{},
+		Owners:           AssetOwners{},
+		PendingOwners:    PendingOwners{},
+		BlockedAddresses: AddressLists{},
+		AllowedAddresses: AddressLists{},
 	}
 }
 
 // Validate performs basic validation of genesis data returning an
 // error for any failed validation criteria.
 func (gs GenesisState) Validate() error {
+	supplies := make(map[string]AssetSupply)
 	for _, supply := range gs.Supplies {
 		err := supply.Validate()
 		if err != nil {
 			return err
 		}
+		supplies[supply.GetDenom()] = supply
+	}
+	if err := gs.RateLimitBuckets.Validate(); err != nil {
+		return err
+	}
+	for _, bucket := range gs.RateLimitBuckets {
+		if _, found := supplies[bucket.Denom]; !found {
+			return fmt.Errorf("rate limit bucket for asset without a supply: %s", bucket.Denom)
+		}
+	}
+	for _, supply := range gs.Supplies {
+		if windowed := gs.RateLimitBuckets.AmountOf(supply.GetDenom()); !windowed.Equal(supply.CurrentSupply.Amount) {
+			return fmt.Errorf("rate limit buckets for %s add up to %s, not the asset's supply %s", supply.GetDenom(), windowed, supply.CurrentSupply)
+		}
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.RoleHolders.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Owners.Validate(); err != nil {
+		return err
+	}
+	if err := gs.PendingOwners.Validate(); err != nil {
+		return err
+	}
+	if err := gs.BlockedAddresses.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AllowedAddresses.Validate(); err != nil {
+		return err
+	}
+
+	assets := make(map[string]Asset)
+	for _, asset := range gs.Params.Assets {
+		assets[asset.Denom] = asset
+	}
+	for _, owner := range gs.Owners {
+		asset, found := assets[owner.Denom]
+		if !found {
+			return fmt.Errorf("owner for unknown asset: %s", owner.Denom)
+		}
+		// the owner in the store takes the place of the one in params, and is checked against the block list the same way
+		asset.Owner = owner.Address
+		assets[owner.Denom] = asset
+	}
+	for _, blocked := range gs.BlockedAddresses {
+		asset, found := assets[blocked.Denom]
+		if !found {
+			return fmt.Errorf("block list for unknown asset: %s", blocked.Denom)
+		}
+		if !asset.Blockable {
+			return fmt.Errorf("asset %s does not support blocking, blocked-list should be empty: %s", asset.Denom, blocked.Addresses)
+		}
+		for _, addr := range blocked.Addresses {
+			if asset.Owner.Equals(addr) {
+				return fmt.Errorf("asset owner cannot be blocked")
+			}
+		}
+	}
+	for _, allowed := range gs.AllowedAddresses {
+		if _, found := assets[allowed.Denom]; !found {
+			return fmt.Errorf("allowlist for unknown asset: %s", allowed.Denom)
+		}
+	}
+	for _, rh := range gs.RoleHolders {
+		if _, found := assets[rh.Denom]; !found {
+			return fmt.Errorf("role holder for unknown asset: %s", rh.Denom)
+		}
+	}
+	for _, po := range gs.PendingOwners {
+		if _, found := assets[po.Denom]; !found {
+			return fmt.Errorf("pending owner for unknown asset: %s", po.Denom)
+		}
 	}
-	return gs.Params.Validate()
+	return nil
 }
 
 // Equal checks whether two GenesisState structs are equivalent
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/ratelimit"
 	"github.com/kava-labs/kava/x/issuance/types"
 )
 
@@ -29,8 +30,12 @@ func (suite *GenesisTestSuite) SetupTest() {
 
 func (suite *GenesisTestSuite) TestValidate() {
 	type args struct {
-		assets   types.Assets
-		supplies types.AssetSupplies
+		assets           types.Assets
+		supplies         types.AssetSupplies
+		buckets          ratelimit.Buckets
+		roleHolders      types.RoleHolders
+		owners           types.AssetOwners
+		blockedAddresses types.AddressLists
 	}
 	type errArgs struct {
 		expectPass bool
@@ -56,9 +61,13 @@ func (suite *GenesisTestSuite) TestValidate() {
 			"with asset",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				supplies: types.AssetSupplies{types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.NewInt(1000000)), time.Hour)},
+				buckets: ratelimit.Buckets{
+					ratelimit.NewBucket("usdtoken", time.Date(2021, 8, 30, 15, 0, 0, 0, time.UTC), sdk.NewInt(400000)),
+					ratelimit.NewBucket("usdtoken", time.Date(2021, 8, 30, 15, 30, 0, 0, time.UTC), sdk.NewInt(600000)),
+				},
 			},
 			errArgs{
 				expectPass: true,
@@ -66,24 +75,55 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 		},
 		{
-			"with asset rate limit",
+			"rate limit buckets not adding up to supply",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(1000000000), time.Hour*24)),
+				},
+				supplies: types.AssetSupplies{types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.NewInt(1000000)), time.Hour)},
+				buckets:  ratelimit.Buckets{ratelimit.NewBucket("usdtoken", time.Date(2021, 8, 30, 15, 0, 0, 0, time.UTC), sdk.NewInt(400000))},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "rate limit buckets for usdtoken add up to",
+			},
+		},
+		{
+			"rate limit bucket without supply",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(true, sdk.NewInt(1000000000), time.Hour*24)),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(1000000000), time.Hour*24)),
 				},
 				supplies: types.AssetSupplies{},
+				buckets:  ratelimit.Buckets{ratelimit.NewBucket("usdtoken", time.Date(2021, 8, 30, 15, 0, 0, 0, time.UTC), sdk.NewInt(400000))},
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass: false,
+				contains:   "rate limit bucket for asset without a supply",
 			},
 		},
 		{
-			"with multiple assets",
+			"duplicate rate limit bucket",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(1000000000), time.Hour*24)),
+				},
+				supplies: types.AssetSupplies{types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.NewInt(800000)), time.Hour)},
+				buckets: ratelimit.Buckets{
+					ratelimit.NewBucket("usdtoken", time.Date(2021, 8, 30, 15, 0, 0, 0, time.UTC), sdk.NewInt(400000)),
+					ratelimit.NewBucket("usdtoken", time.Date(2021, 8, 30, 15, 0, 0, 0, time.UTC), sdk.NewInt(400000)),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "duplicate bucket",
+			},
+		},
+		{
+			"with asset rate limit",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
-					types.NewAsset(suite.addrs[0], "pegtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(true, sdk.NewInt(1000000000), time.Hour*24)),
 				},
 				supplies: types.AssetSupplies{},
 			},
@@ -93,23 +133,24 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 		},
 		{
-			"blocked owner",
+			"with multiple assets",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[0]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "pegtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				supplies: types.AssetSupplies{},
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "asset owner cannot be blocked",
+				expectPass: true,
+				contains:   "",
 			},
 		},
 		{
 			"empty owner",
 			args{
 				assets: types.Assets{
-					types.NewAsset(sdk.AccAddress{}, "usdtoken", []sdk.AccAddress{suite.addrs[0]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(sdk.AccAddress{}, "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				supplies: types.AssetSupplies{},
 			},
@@ -122,20 +163,21 @@ func (suite *GenesisTestSuite) TestValidate() {
 			"empty blocked address",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{nil}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
-				supplies: types.AssetSupplies{},
+				supplies:         types.AssetSupplies{},
+				blockedAddresses: types.AddressLists{types.NewAddressList("usdtoken", []sdk.AccAddress{nil})},
 			},
 			errArgs{
 				expectPass: false,
-				contains:   "blocked address must not be empty",
+				contains:   "contains an empty address",
 			},
 		},
 		{
 			"invalid denom",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "USD2T ", []sdk.AccAddress{}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "USD2T ", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				supplies: types.AssetSupplies{},
 			},
@@ -148,8 +190,8 @@ func (suite *GenesisTestSuite) TestValidate() {
 			"duplicate denom",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
-					types.NewAsset(suite.addrs[1], "usdtoken", []sdk.AccAddress{}, true, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[1], "usdtoken", true, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				supplies: types.AssetSupplies{},
 			},
@@ -162,8 +204,8 @@ func (suite *GenesisTestSuite) TestValidate() {
 			"duplicate asset",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, true, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
 				supplies: types.AssetSupplies{},
 			},
@@ -176,19 +218,125 @@ func (suite *GenesisTestSuite) TestValidate() {
 			"invalid block list",
 			args{
 				assets: types.Assets{
-					types.NewAsset(suite.addrs[0], "usdtoken", []sdk.AccAddress{suite.addrs[1]}, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+					types.NewAsset(suite.addrs[0], "usdtoken", false, false, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
 				},
-				supplies: types.AssetSupplies{types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour)},
+				supplies:         types.AssetSupplies{types.NewAssetSupply(sdk.NewCoin("usdtoken", sdk.ZeroInt()), time.Hour)},
+				blockedAddresses: types.AddressLists{types.NewAddressList("usdtoken", []sdk.AccAddress{suite.addrs[1]})},
 			},
 			errArgs{
 				expectPass: false,
 				contains:   "blocked-list should be empty",
 			},
 		},
+		{
+			"valid role holders",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies: types.AssetSupplies{},
+				roleHolders: types.RoleHolders{
+					types.NewRoleHolder("usdtoken", types.RoleMinter, suite.addrs[1], sdk.NewInt(1000)),
+					types.NewRoleHolder("usdtoken", types.RolePauser, suite.addrs[1], sdk.ZeroInt()),
+				},
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"role holder for unknown asset",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies:    types.AssetSupplies{},
+				roleHolders: types.RoleHolders{types.NewRoleHolder("othertoken", types.RoleMinter, suite.addrs[1], sdk.NewInt(1000))},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "role holder for unknown asset",
+			},
+		},
+		{
+			"duplicate role holder",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies: types.AssetSupplies{},
+				roleHolders: types.RoleHolders{
+					types.NewRoleHolder("usdtoken", types.RoleMinter, suite.addrs[1], sdk.NewInt(1000)),
+					types.NewRoleHolder("usdtoken", types.RoleMinter, suite.addrs[1], sdk.NewInt(5)),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "duplicate minter role",
+			},
+		},
+		{
+			"allowance for non-minter role",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies:    types.AssetSupplies{},
+				roleHolders: types.RoleHolders{types.NewRoleHolder("usdtoken", types.RoleBlocker, suite.addrs[1], sdk.NewInt(1000))},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "allowance must be zero",
+			},
+		},
+		{
+			"blocked owner",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies:         types.AssetSupplies{},
+				blockedAddresses: types.AddressLists{types.NewAddressList("usdtoken", []sdk.AccAddress{suite.addrs[0]})},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "asset owner cannot be blocked",
+			},
+		},
+		{
+			"blocked owner in store",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies:         types.AssetSupplies{},
+				owners:           types.AssetOwners{types.NewAssetOwner("usdtoken", suite.addrs[1])},
+				blockedAddresses: types.AddressLists{types.NewAddressList("usdtoken", []sdk.AccAddress{suite.addrs[1]})},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "asset owner cannot be blocked",
+			},
+		},
+		{
+			"owner for unknown asset",
+			args{
+				assets: types.Assets{
+					types.NewAsset(suite.addrs[0], "usdtoken", false, true, false, types.NewRateLimit(false, sdk.ZeroInt(), time.Duration(0))),
+				},
+				supplies: types.AssetSupplies{},
+				owners:   types.AssetOwners{types.NewAssetOwner("othertoken", suite.addrs[1])},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "owner for unknown asset",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			gs := types.NewGenesisState(types.NewParams(tc.args.assets), tc.args.supplies)
+			gs := types.NewGenesisState(types.NewParams(tc.args.assets), tc.args.supplies, tc.args.buckets, tc.args.roleHolders, tc.args.owners, types.PendingOwners{}, tc.args.blockedAddresses, types.AddressLists{})
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err, tc.name)
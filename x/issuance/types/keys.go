@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "issuance"
@@ -21,4 +25,38 @@ const (
 var (
 	AssetSupplyPrefix    = []byte{0x01}
 	PreviousBlockTimeKey = []byte{0x02}
+	RoleHolderPrefix     = []byte{0x03}
+	PendingOwnerPrefix   = []byte{0x04}
+	BlockedAddressPrefix = []byte{0x05}
+	AllowedAddressPrefix = []byte{0x06}
+	RateLimitPrefix      = []byte{0x07}
+	OwnerPrefix          = []byte{0x08}
+	AssetDenomPrefix     = []byte{0x09}
+
+	sep = []byte{0x00}
 )
+
+// RoleHolderKey returns the store key of a role holder, relative to the role holder prefix.
+// Keys are ordered by denom then role, so role holders can be iterated for an asset or an asset's role.
+func RoleHolderKey(denom, role string, addr sdk.AccAddress) []byte {
+	return append(RoleHoldersKey(denom, role), addr...)
+}
+
+// RoleHoldersKey returns the key prefix for an asset's role holders. An empty role returns the prefix for all of the asset's roles.
+func RoleHoldersKey(denom, role string) []byte {
+	key := append([]byte(denom), sep...)
+	if role == "" {
+		return key
+	}
+	return append(append(key, []byte(role)...), sep...)
+}
+
+// AssetAddressKey returns the store key of an address on an asset's block list or allowlist, relative to the list's prefix
+func AssetAddressKey(denom string, addr sdk.AccAddress) []byte {
+	return append(AssetAddressesKey(denom), addr...)
+}
+
+// AssetAddressesKey returns the key prefix for the addresses on an asset's block list or allowlist
+func AssetAddressesKey(denom string) []byte {
+	return append([]byte(denom), sep...)
+}
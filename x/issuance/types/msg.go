@@ -13,6 +13,12 @@ var _ sdk.Msg = &MsgRedeemTokens{}
 var _ sdk.Msg = &MsgBlockAddress{}
 var _ sdk.Msg = &MsgUnblockAddress{}
 var _ sdk.Msg = &MsgSetPauseStatus{}
+var _ sdk.Msg = &MsgGrantRole{}
+var _ sdk.Msg = &MsgRevokeRole{}
+var _ sdk.Msg = &MsgTransferOwnership{}
+var _ sdk.Msg = &MsgAcceptOwnership{}
+var _ sdk.Msg = &MsgAllowAddress{}
+var _ sdk.Msg = &MsgDisallowAddress{}
 
 // MsgIssueTokens message type used by the issuer to issue new tokens
 type MsgIssueTokens struct {
@@ -280,3 +286,334 @@ func (msg MsgSetPauseStatus) String() string {
 	`, msg.Sender, msg.Denom, msg.Status,
 	)
 }
+
+// MsgGrantRole message type used by the issuer to grant a role for an asset to an address, or to update a minter's allowance
+type MsgGrantRole struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	Role      string         `json:"role" yaml:"role"`
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Allowance sdk.Int        `json:"allowance" yaml:"allowance"`
+}
+
+// NewMsgGrantRole returns a new MsgGrantRole
+func NewMsgGrantRole(sender sdk.AccAddress, denom, role string, addr sdk.AccAddress, allowance sdk.Int) MsgGrantRole {
+	return MsgGrantRole{
+		Sender:    sender,
+		Denom:     denom,
+		Role:      role,
+		Address:   addr,
+		Allowance: allowance,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgGrantRole) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgGrantRole) Type() string { return "grant_role" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgGrantRole) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	return NewRoleHolder(msg.Denom, msg.Role, msg.Address, msg.Allowance).Validate()
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgGrantRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgGrantRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements fmt.Stringer
+func (msg MsgGrantRole) String() string {
+	return fmt.Sprintf(`Grant Role:
+	Sender %s
+	Denom %s
+	Role %s
+	Address %s
+	Allowance %s
+	`, msg.Sender, msg.Denom, msg.Role, msg.Address, msg.Allowance,
+	)
+}
+
+// MsgRevokeRole message type used by the issuer to revoke a role for an asset from an address
+type MsgRevokeRole struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	Role    string         `json:"role" yaml:"role"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewMsgRevokeRole returns a new MsgRevokeRole
+func NewMsgRevokeRole(sender sdk.AccAddress, denom, role string, addr sdk.AccAddress) MsgRevokeRole {
+	return MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: addr,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeRole) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevokeRole) Type() string { return "revoke_role" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRevokeRole) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "role holder address cannot be empty")
+	}
+	if err := ValidateRole(msg.Role); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgRevokeRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements fmt.Stringer
+func (msg MsgRevokeRole) String() string {
+	return fmt.Sprintf(`Revoke Role:
+	Sender %s
+	Denom %s
+	Role %s
+	Address %s
+	`, msg.Sender, msg.Denom, msg.Role, msg.Address,
+	)
+}
+
+// MsgTransferOwnership message type used by the issuer to nominate a new owner for an asset.
+// Ownership is transferred once the new owner accepts it with MsgAcceptOwnership.
+type MsgTransferOwnership struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// NewMsgTransferOwnership returns a new MsgTransferOwnership
+func NewMsgTransferOwnership(sender sdk.AccAddress, denom string, newOwner sdk.AccAddress) MsgTransferOwnership {
+	return MsgTransferOwnership{
+		Sender:   sender,
+		Denom:    denom,
+		NewOwner: newOwner,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferOwnership) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferOwnership) Type() string { return "transfer_ownership" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgTransferOwnership) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new owner address cannot be empty")
+	}
+	if msg.NewOwner.Equals(msg.Sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new owner cannot be the sender")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgTransferOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgTransferOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements fmt.Stringer
+func (msg MsgTransferOwnership) String() string {
+	return fmt.Sprintf(`Transfer Ownership:
+	Sender %s
+	Denom %s
+	New Owner %s
+	`, msg.Sender, msg.Denom, msg.NewOwner,
+	)
+}
+
+// MsgAcceptOwnership message type used by a pending owner to accept ownership of an asset
+type MsgAcceptOwnership struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+}
+
+// NewMsgAcceptOwnership returns a new MsgAcceptOwnership
+func NewMsgAcceptOwnership(sender sdk.AccAddress, denom string) MsgAcceptOwnership {
+	return MsgAcceptOwnership{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgAcceptOwnership) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgAcceptOwnership) Type() string { return "accept_ownership" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgAcceptOwnership) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgAcceptOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgAcceptOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements fmt.Stringer
+func (msg MsgAcceptOwnership) String() string {
+	return fmt.Sprintf(`Accept Ownership:
+	Sender %s
+	Denom %s
+	`, msg.Sender, msg.Denom,
+	)
+}
+
+// MsgAllowAddress message type used by the issuer to add an address to an asset's allowlist
+type MsgAllowAddress struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewMsgAllowAddress returns a new MsgAllowAddress
+func NewMsgAllowAddress(sender sdk.AccAddress, denom string, addr sdk.AccAddress) MsgAllowAddress {
+	return MsgAllowAddress{
+		Sender:  sender,
+		Denom:   denom,
+		Address: addr,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgAllowAddress) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgAllowAddress) Type() string { return "allow_address" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgAllowAddress) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "allowed address cannot be empty")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgAllowAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgAllowAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements fmt.Stringer
+func (msg MsgAllowAddress) String() string {
+	return fmt.Sprintf(`Allow Address:
+	Sender %s
+	Denom %s
+	Address %s
+	`, msg.Sender, msg.Denom, msg.Address,
+	)
+}
+
+// MsgDisallowAddress message type used by the issuer to remove an address from an asset's allowlist
+type MsgDisallowAddress struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewMsgDisallowAddress returns a new MsgDisallowAddress
+func NewMsgDisallowAddress(sender sdk.AccAddress, denom string, addr sdk.AccAddress) MsgDisallowAddress {
+	return MsgDisallowAddress{
+		Sender:  sender,
+		Denom:   denom,
+		Address: addr,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDisallowAddress) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDisallowAddress) Type() string { return "disallow_address" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgDisallowAddress) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "allowed address cannot be empty")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg
+func (msg MsgDisallowAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign
+func (msg MsgDisallowAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements fmt.Stringer
+func (msg MsgDisallowAddress) String() string {
+	return fmt.Sprintf(`Disallow Address:
+	Sender %s
+	Denom %s
+	Address %s
+	`, msg.Sender, msg.Denom, msg.Address,
+	)
+}
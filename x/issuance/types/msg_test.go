@@ -388,6 +388,41 @@ func (suite *MsgTestSuite) TestMsgSetPauseStatus() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgGrantRole() {
+	testCases := []struct {
+		name       string
+		msg        types.MsgGrantRole
+		expectPass bool
+		contains   string
+	}{
+		{"valid minter", types.NewMsgGrantRole(suite.addrs[0], "valid", types.RoleMinter, suite.addrs[1], sdk.NewInt(100)), true, ""},
+		{"valid pauser", types.NewMsgGrantRole(suite.addrs[0], "valid", types.RolePauser, suite.addrs[1], sdk.ZeroInt()), true, ""},
+		{"invalid sender", types.NewMsgGrantRole(sdk.AccAddress{}, "valid", types.RoleMinter, suite.addrs[1], sdk.NewInt(100)), false, "sender address cannot be empty"},
+		{"invalid role", types.NewMsgGrantRole(suite.addrs[0], "valid", "admin", suite.addrs[1], sdk.ZeroInt()), false, "invalid role"},
+		{"invalid address", types.NewMsgGrantRole(suite.addrs[0], "valid", types.RoleMinter, sdk.AccAddress{}, sdk.NewInt(100)), false, "role holder address cannot be empty"},
+		{"negative allowance", types.NewMsgGrantRole(suite.addrs[0], "valid", types.RoleMinter, suite.addrs[1], sdk.NewInt(-1)), false, "invalid allowance"},
+		{"allowance for blocker", types.NewMsgGrantRole(suite.addrs[0], "valid", types.RoleBlocker, suite.addrs[1], sdk.NewInt(100)), false, "allowance must be zero"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.contains))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgTransferOwnership() {
+	suite.Require().NoError(types.NewMsgTransferOwnership(suite.addrs[0], "valid", suite.addrs[1]).ValidateBasic())
+	suite.Require().Error(types.NewMsgTransferOwnership(suite.addrs[0], "valid", suite.addrs[0]).ValidateBasic())
+	suite.Require().Error(types.NewMsgTransferOwnership(suite.addrs[0], "valid", sdk.AccAddress{}).ValidateBasic())
+	suite.Require().Error(types.NewMsgTransferOwnership(suite.addrs[0], "Invalid", suite.addrs[1]).ValidateBasic())
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
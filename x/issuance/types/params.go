@@ -64,22 +64,23 @@ func (p Params) String() string {
 
 // Asset type for assets in the issuance module
 type Asset struct {
-	Owner            sdk.AccAddress   `json:"owner" yaml:"owner"`
-	Denom            string           `json:"denom" yaml:"denom"`
-	BlockedAddresses []sdk.AccAddress `json:"blocked_addresses" yaml:"blocked_addresses"`
-	Paused           bool             `json:"paused" yaml:"paused"`
-	Blockable        bool             `json:"blockable" yaml:"blockable"`
-	RateLimit        RateLimit        `json:"rate_limit" yaml:"rate_limit"`
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	Paused    bool           `json:"paused" yaml:"paused"`
+	Blockable bool           `json:"blockable" yaml:"blockable"`
+	// AllowlistEnabled restricts holding and receiving the asset to the owner and addresses on the asset's allowlist
+	AllowlistEnabled bool      `json:"allowlist_enabled" yaml:"allowlist_enabled"`
+	RateLimit        RateLimit `json:"rate_limit" yaml:"rate_limit"`
 }
 
 // NewAsset returns a new Asset
-func NewAsset(owner sdk.AccAddress, denom string, blockedAddresses []sdk.AccAddress, paused bool, blockable bool, limit RateLimit) Asset {
+func NewAsset(owner sdk.AccAddress, denom string, paused bool, blockable bool, allowlistEnabled bool, limit RateLimit) Asset {
 	return Asset{
 		Owner:            owner,
 		Denom:            denom,
-		BlockedAddresses: blockedAddresses,
 		Paused:           paused,
 		Blockable:        blockable,
+		AllowlistEnabled: allowlistEnabled,
 		RateLimit:        limit,
 	}
 }
@@ -89,17 +90,6 @@ func (a Asset) Validate() error {
 	if a.Owner.Empty() {
 		return fmt.Errorf("owner must not be empty")
 	}
-	if !a.Blockable && len(a.BlockedAddresses) > 0 {
-		return fmt.Errorf("asset %s does not support blocking, blocked-list should be empty: %s", a.Denom, a.BlockedAddresses)
-	}
-	for _, address := range a.BlockedAddresses {
-		if address.Empty() {
-			return fmt.Errorf("blocked address must not be empty")
-		}
-		if a.Owner.Equals(address) {
-			return fmt.Errorf("asset owner cannot be blocked")
-		}
-	}
 	return sdk.ValidateDenom(a.Denom)
 }
 
@@ -109,9 +99,9 @@ func (a Asset) String() string {
 	Owner: %s
 	Paused: %t
 	Denom: %s
-	Blocked Addresses: %s
+	Allowlist Enabled: %t
 	Rate limits: %s`,
-		a.Owner, a.Paused, a.Denom, a.BlockedAddresses, a.RateLimit)
+		a.Owner, a.Paused, a.Denom, a.AllowlistEnabled, a.RateLimit)
 }
 
 // Assets slice of Asset
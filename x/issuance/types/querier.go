@@ -2,11 +2,55 @@ package types
 
 // Querier routes for the issuance module
 const (
-	QueryGetParams = "parameters"
-	QueryGetAsset  = "asset"
+	QueryGetParams           = "parameters"
+	QueryGetAsset            = "asset"
+	QueryGetRoles            = "roles"
+	QueryGetOwners           = "owners"
+	QueryGetPendingOwners    = "pending-owners"
+	QueryGetBlockedAddresses = "blocked-addresses"
+	QueryGetAllowedAddresses = "allowed-addresses"
+	QueryGetCapacities       = "capacities"
 )
 
 // QueryAssetParams params for querying an asset by denom
 type QueryAssetParams struct {
 	Denom string `json:"denom" yaml:"denom"`
 }
+
+// NewQueryAssetParams returns QueryAssetParams
+func NewQueryAssetParams(denom string) QueryAssetParams {
+	return QueryAssetParams{
+		Denom: denom,
+	}
+}
+
+// QueryRolesParams params for querying role holders. An empty role returns holders of all roles,
+// and an empty denom returns role holders for all assets.
+type QueryRolesParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+	Role  string `json:"role" yaml:"role"`
+}
+
+// NewQueryRolesParams returns QueryRolesParams
+func NewQueryRolesParams(denom, role string) QueryRolesParams {
+	return QueryRolesParams{
+		Denom: denom,
+		Role:  role,
+	}
+}
+
+// QueryAddressesParams params for querying a page of an asset's block list or allowlist
+type QueryAddressesParams struct {
+	Page  int    `json:"page" yaml:"page"`
+	Limit int    `json:"limit" yaml:"limit"`
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryAddressesParams returns QueryAddressesParams
+func NewQueryAddressesParams(page, limit int, denom string) QueryAddressesParams {
+	return QueryAddressesParams{
+		Page:  page,
+		Limit: limit,
+		Denom: denom,
+	}
+}
@@ -0,0 +1,195 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Roles that an asset owner can grant to other addresses
+const (
+	RoleMinter  = "minter"  // issues tokens up to an allowance, and redeems tokens
+	RolePauser  = "pauser"  // pauses and un-pauses the asset
+	RoleBlocker = "blocker" // blocks and unblocks addresses
+)
+
+// ValidateRole checks that a role is one of the roles that can be granted
+func ValidateRole(role string) error {
+	switch role {
+	case RoleMinter, RolePauser, RoleBlocker:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidRole, "role: %s", role)
+	}
+}
+
+// RoleHolder is an address that has been granted a role for an asset
+type RoleHolder struct {
+	Denom   string         `json:"denom" yaml:"denom"`
+	Role    string         `json:"role" yaml:"role"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	// Allowance is the amount of tokens a minter can still issue, it is zero for other roles
+	Allowance sdk.Int `json:"allowance" yaml:"allowance"`
+}
+
+// NewRoleHolder returns a new RoleHolder
+func NewRoleHolder(denom, role string, addr sdk.AccAddress, allowance sdk.Int) RoleHolder {
+	return RoleHolder{
+		Denom:     denom,
+		Role:      role,
+		Address:   addr,
+		Allowance: allowance,
+	}
+}
+
+// Validate performs a basic validation of role holder fields
+func (rh RoleHolder) Validate() error {
+	if err := sdk.ValidateDenom(rh.Denom); err != nil {
+		return err
+	}
+	if err := ValidateRole(rh.Role); err != nil {
+		return err
+	}
+	if rh.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "role holder address cannot be empty")
+	}
+	if rh.Allowance.IsNil() || rh.Allowance.IsNegative() {
+		return fmt.Errorf("invalid allowance for %s %s: %s", rh.Denom, rh.Role, rh.Allowance)
+	}
+	if rh.Role != RoleMinter && !rh.Allowance.IsZero() {
+		return fmt.Errorf("allowance must be zero for %s role: %s", rh.Role, rh.Allowance)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (rh RoleHolder) String() string {
+	return fmt.Sprintf(`Role Holder:
+	Denom: %s
+	Role: %s
+	Address: %s
+	Allowance: %s
+	`, rh.Denom, rh.Role, rh.Address, rh.Allowance)
+}
+
+// RoleHolders is a slice of RoleHolder
+type RoleHolders []RoleHolder
+
+// Validate checks each role holder and that no address holds the same role for an asset twice
+func (rhs RoleHolders) Validate() error {
+	seen := make(map[string]bool)
+	for _, rh := range rhs {
+		if err := rh.Validate(); err != nil {
+			return err
+		}
+		key := string(RoleHolderKey(rh.Denom, rh.Role, rh.Address))
+		if seen[key] {
+			return fmt.Errorf("duplicate %s role for %s: %s", rh.Role, rh.Denom, rh.Address)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// PendingOwner is an address that has been nominated as the new owner of an asset, and has yet to accept ownership
+type PendingOwner struct {
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewPendingOwner returns a new PendingOwner
+func NewPendingOwner(denom string, addr sdk.AccAddress) PendingOwner {
+	return PendingOwner{
+		Denom:   denom,
+		Address: addr,
+	}
+}
+
+// Validate performs a basic validation of pending owner fields
+func (po PendingOwner) Validate() error {
+	if err := sdk.ValidateDenom(po.Denom); err != nil {
+		return err
+	}
+	if po.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "pending owner address cannot be empty")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (po PendingOwner) String() string {
+	return fmt.Sprintf(`Pending Owner:
+	Denom: %s
+	Address: %s
+	`, po.Denom, po.Address)
+}
+
+// PendingOwners is a slice of PendingOwner
+type PendingOwners []PendingOwner
+
+// Validate checks each pending owner and that each asset has at most one pending owner
+func (pos PendingOwners) Validate() error {
+	seen := make(map[string]bool)
+	for _, po := range pos {
+		if err := po.Validate(); err != nil {
+			return err
+		}
+		if seen[po.Denom] {
+			return fmt.Errorf("duplicate pending owner for %s", po.Denom)
+		}
+		seen[po.Denom] = true
+	}
+	return nil
+}
+
+// AssetOwner is the address that has accepted ownership of an asset. It takes the place of the owner set in params.
+type AssetOwner struct {
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewAssetOwner returns a new AssetOwner
+func NewAssetOwner(denom string, addr sdk.AccAddress) AssetOwner {
+	return AssetOwner{
+		Denom:   denom,
+		Address: addr,
+	}
+}
+
+// Validate performs a basic validation of asset owner fields
+func (ao AssetOwner) Validate() error {
+	if err := sdk.ValidateDenom(ao.Denom); err != nil {
+		return err
+	}
+	if ao.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ao AssetOwner) String() string {
+	return fmt.Sprintf(`Asset Owner:
+	Denom: %s
+	Address: %s
+	`, ao.Denom, ao.Address)
+}
+
+// AssetOwners is a slice of AssetOwner
+type AssetOwners []AssetOwner
+
+// Validate checks each asset owner and that each asset has at most one owner
+func (aos AssetOwners) Validate() error {
+	seen := make(map[string]bool)
+	for _, ao := range aos {
+		if err := ao.Validate(); err != nil {
+			return err
+		}
+		if seen[ao.Denom] {
+			return fmt.Errorf("duplicate owner for %s", ao.Denom)
+		}
+		seen[ao.Denom] = true
+	}
+	return nil
+}
@@ -8,7 +8,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// AssetSupply contains information about an asset's rate-limited supply (the total supply of the asset is tracked in the top-level supply module)
+// AssetSupply contains information about an asset's rate-limited supply (the total supply of the asset is tracked in the top-level supply module).
+// CurrentSupply is the net amount issued within the rolling window of the asset's rate limit.
+// TimeElapsed is no longer used, as the window has no fixed start; it is kept so existing genesis files can be imported.
 type AssetSupply struct {
 	CurrentSupply sdk.Coin      `json:"current_supply"  yaml:"current_supply"`
 	TimeElapsed   time.Duration `json:"time_elapsed" yaml:"time_elapsed"`
@@ -13,57 +13,100 @@ import (
 )
 
 const (
-	EventTypeKavaDist                   = types.EventTypeKavaDist
-	AttributeKeyInflation               = types.AttributeKeyInflation
-	AttributeKeyStatus                  = types.AttributeKeyStatus
-	AttributeValueInactive              = types.AttributeValueInactive
-	ModuleName                          = types.ModuleName
-	StoreKey                            = types.StoreKey
-	RouterKey                           = types.RouterKey
-	QuerierRoute                        = types.QuerierRoute
-	DefaultParamspace                   = types.DefaultParamspace
-	KavaDistMacc                        = types.KavaDistMacc
-	ProposalTypeCommunityPoolMultiSpend = types.ProposalTypeCommunityPoolMultiSpend
-	QueryGetParams                      = types.QueryGetParams
-	QueryGetBalance                     = types.QueryGetBalance
+	AttributeKeyReturned                  = types.AttributeKeyReturned
+	AttributeKeyStreamID                  = types.AttributeKeyStreamID
+	AttributeValueCategory                = types.AttributeValueCategory
+	EventTypeCancelStream                 = types.EventTypeCancelStream
+	EventTypeClaimStream                  = types.EventTypeClaimStream
+	EventTypeCreateStream                 = types.EventTypeCreateStream
+	EventTypeKavaDist                     = types.EventTypeKavaDist
+	AttributeKeyInflation                 = types.AttributeKeyInflation
+	AttributeKeyRecipient                 = types.AttributeKeyRecipient
+	AttributeKeyStatus                    = types.AttributeKeyStatus
+	AttributeValueInactive                = types.AttributeValueInactive
+	ModuleName                            = types.ModuleName
+	ProposalTypeCommunityPoolStreamCancel = types.ProposalTypeCommunityPoolStreamCancel
+	ProposalTypeCommunityPoolStreamSpend  = types.ProposalTypeCommunityPoolStreamSpend
+	QueryGetStream                        = types.QueryGetStream
+	QueryGetStreams                       = types.QueryGetStreams
+	StoreKey                              = types.StoreKey
+	RouterKey                             = types.RouterKey
+	QuerierRoute                          = types.QuerierRoute
+	DefaultParamspace                     = types.DefaultParamspace
+	KavaDistMacc                          = types.KavaDistMacc
+	ProposalTypeCommunityPoolMultiSpend   = types.ProposalTypeCommunityPoolMultiSpend
+	QueryGetParams                        = types.QueryGetParams
+	QueryGetBalance                       = types.QueryGetBalance
+	StreamEscrowMacc                      = types.StreamEscrowMacc
 )
 
 var (
 	// functions aliases
-	RegisterCodec                         = types.RegisterCodec
-	NewGenesisState                       = types.NewGenesisState
-	DefaultGenesisState                   = types.DefaultGenesisState
-	NewPeriod                             = types.NewPeriod
-	NewParams                             = types.NewParams
-	DefaultParams                         = types.DefaultParams
-	ParamKeyTable                         = types.ParamKeyTable
-	NewCommunityPoolMultiSpendProposal    = types.NewCommunityPoolMultiSpendProposal
-	NewKeeper                             = keeper.NewKeeper
-	HandleCommunityPoolMultiSpendProposal = keeper.HandleCommunityPoolMultiSpendProposal
-	NewQuerier                            = keeper.NewQuerier
+	GetStreamKey                            = types.GetStreamKey
+	NewCommunityPoolStreamCancelProposal    = types.NewCommunityPoolStreamCancelProposal
+	NewCommunityPoolStreamSpendProposal     = types.NewCommunityPoolStreamSpendProposal
+	NewInflationRecipient                   = types.NewInflationRecipient
+	NewInflationSchedule                    = types.NewInflationSchedule
+	NewMsgClaimStream                       = types.NewMsgClaimStream
+	NewQueryStreamParams                    = types.NewQueryStreamParams
+	NewQueryStreamsParams                   = types.NewQueryStreamsParams
+	NewSpendStream                          = types.NewSpendStream
+	RegisterCodec                           = types.RegisterCodec
+	NewGenesisState                         = types.NewGenesisState
+	DefaultGenesisState                     = types.DefaultGenesisState
+	NewPeriod                               = types.NewPeriod
+	NewParams                               = types.NewParams
+	DefaultParams                           = types.DefaultParams
+	ParamKeyTable                           = types.ParamKeyTable
+	NewCommunityPoolMultiSpendProposal      = types.NewCommunityPoolMultiSpendProposal
+	HandleCommunityPoolStreamCancelProposal = keeper.HandleCommunityPoolStreamCancelProposal
+	HandleCommunityPoolStreamSpendProposal  = keeper.HandleCommunityPoolStreamSpendProposal
+	NewKeeper                               = keeper.NewKeeper
+	HandleCommunityPoolMultiSpendProposal   = keeper.HandleCommunityPoolMultiSpendProposal
+	NewQuerier                              = keeper.NewQuerier
 
 	// variable aliases
-	ModuleCdc                 = types.ModuleCdc
-	ErrInvalidProposalAmount  = types.ErrInvalidProposalAmount
-	ErrEmptyProposalRecipient = types.ErrEmptyProposalRecipient
-	CurrentDistPeriodKey      = types.CurrentDistPeriodKey
-	PreviousBlockTimeKey      = types.PreviousBlockTimeKey
-	KeyActive                 = types.KeyActive
-	KeyPeriods                = types.KeyPeriods
-	DefaultActive             = types.DefaultActive
-	DefaultPeriods            = types.DefaultPeriods
-	DefaultPreviousBlockTime  = types.DefaultPreviousBlockTime
-	GovDenom                  = types.GovDenom
-	ProposalHandler           = client.ProposalHandler
+	DefaultNextStreamID         = types.DefaultNextStreamID
+	DefaultSchedules            = types.DefaultSchedules
+	ErrInvalidStreamSchedule    = types.ErrInvalidStreamSchedule
+	ErrNothingToClaim           = types.ErrNothingToClaim
+	ErrNotStreamRecipient       = types.ErrNotStreamRecipient
+	ErrStreamNotFound           = types.ErrStreamNotFound
+	KeySchedules                = types.KeySchedules
+	ModuleCdc                   = types.ModuleCdc
+	ErrInvalidProposalAmount    = types.ErrInvalidProposalAmount
+	ErrEmptyProposalRecipient   = types.ErrEmptyProposalRecipient
+	CurrentDistPeriodKey        = types.CurrentDistPeriodKey
+	NextStreamIDKey             = types.NextStreamIDKey
+	PreviousBlockTimeKey        = types.PreviousBlockTimeKey
+	KeyActive                   = types.KeyActive
+	DefaultActive               = types.DefaultActive
+	DefaultPreviousBlockTime    = types.DefaultPreviousBlockTime
+	GovDenom                    = types.GovDenom
+	StreamKeyPrefix             = types.StreamKeyPrefix
+	ProposalHandler             = client.ProposalHandler
+	StreamCancelProposalHandler = client.StreamCancelProposalHandler
+	StreamSpendProposalHandler  = client.StreamSpendProposalHandler
 )
 
 type (
-	GenesisState                    = types.GenesisState
-	Params                          = types.Params
-	Period                          = types.Period
-	Periods                         = types.Periods
-	CommunityPoolMultiSpendProposal = types.CommunityPoolMultiSpendProposal
-	MultiSpendRecipient             = types.MultiSpendRecipient
-	MultiSpendRecipients            = types.MultiSpendRecipients
-	Keeper                          = keeper.Keeper
+	CommunityPoolStreamCancelProposal = types.CommunityPoolStreamCancelProposal
+	CommunityPoolStreamSpendProposal  = types.CommunityPoolStreamSpendProposal
+	GenesisState                      = types.GenesisState
+	InflationRecipient                = types.InflationRecipient
+	InflationRecipients               = types.InflationRecipients
+	InflationSchedule                 = types.InflationSchedule
+	InflationSchedules                = types.InflationSchedules
+	MsgClaimStream                    = types.MsgClaimStream
+	Params                            = types.Params
+	Period                            = types.Period
+	Periods                           = types.Periods
+	CommunityPoolMultiSpendProposal   = types.CommunityPoolMultiSpendProposal
+	MultiSpendRecipient               = types.MultiSpendRecipient
+	MultiSpendRecipients              = types.MultiSpendRecipients
+	QueryStreamParams                 = types.QueryStreamParams
+	QueryStreamsParams                = types.QueryStreamsParams
+	SpendStream                       = types.SpendStream
+	SpendStreams                      = types.SpendStreams
+	Keeper                            = keeper.Keeper
 )
@@ -2,17 +2,25 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
 
+// Query streams flags
+const (
+	flagRecipient = "recipient"
+)
+
 // GetQueryCmd returns the cli query commands for the kavadist module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	kavadistQueryCmd := &cobra.Command{
@@ -23,6 +31,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	kavadistQueryCmd.AddCommand(flags.GetCommands(
 		queryParamsCmd(queryRoute, cdc),
 		queryBalanceCmd(queryRoute, cdc),
+		queryStreamCmd(queryRoute, cdc),
+		queryStreamsCmd(queryRoute, cdc),
 	)...)
 
 	return kavadistQueryCmd
@@ -80,3 +90,85 @@ func queryBalanceCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func queryStreamCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "stream [stream-id]",
+		Short:   "get a spend stream",
+		Long:    "Get a community pool spend stream by its ID.",
+		Example: fmt.Sprintf("%s query %s stream 1", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryStreamParams(streamID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetStream)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var stream types.SpendStream
+			if err := cdc.UnmarshalJSON(res, &stream); err != nil {
+				return fmt.Errorf("failed to unmarshal stream: %w", err)
+			}
+			return cliCtx.PrintOutput(stream)
+		},
+	}
+}
+
+func queryStreamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streams",
+		Short: "get spend streams",
+		Long:  "Get community pool spend streams, optionally filtered by recipient.",
+		Example: fmt.Sprintf(`%[1]s query %[2]s streams
+%[1]s query %[2]s streams --recipient kava1mz2003lathm95n5vnlthmtfvrzrjkrr53j4464 --page 2 --limit 10`, version.ClientName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var recipient sdk.AccAddress
+			if recipientStr := viper.GetString(flagRecipient); recipientStr != "" {
+				addr, err := sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+				recipient = addr
+			}
+			params := types.NewQueryStreamsParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), recipient)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetStreams)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var streams types.SpendStreams
+			if err := cdc.UnmarshalJSON(res, &streams); err != nil {
+				return fmt.Errorf("failed to unmarshal streams: %w", err)
+			}
+			return cliCtx.PrintOutput(streams)
+		},
+	}
+
+	cmd.Flags().String(flagRecipient, "", "(optional) filter for streams by recipient address")
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit (max 100)")
+
+	return cmd
+}
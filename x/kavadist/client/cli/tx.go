@@ -3,11 +3,14 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -18,6 +21,48 @@ import (
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
 
+// GetTxCmd returns the transaction commands for the kavadist module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	kavadistTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "kavadist transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	kavadistTxCmd.AddCommand(flags.PostCommands(
+		getCmdClaimStream(cdc),
+	)...)
+
+	return kavadistTxCmd
+}
+
+func getCmdClaimStream(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "claim-stream [stream-id]",
+		Short:   "claim the coins released by a spend stream",
+		Example: fmt.Sprintf("%s tx %s claim-stream 1 --from <key>", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			msg := types.NewMsgClaimStream(cliCtx.GetFromAddress(), streamID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool multi-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -91,3 +136,128 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitStreamSpendProposal implements the command to submit a community-pool stream spend proposal
+func GetCmdSubmitStreamSpendProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool stream spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Each recipient's amount is released linearly
+over the duration, starting when the proposal passes, with nothing released before the cliff.
+Durations are in nanoseconds.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Stream Spend",
+  "description": "Pay a grantee over one year, with a three month cliff",
+  "recipient_list": [
+		{
+			"address": "kava1mz2003lathm95n5vnlthmtfvrzrjkrr53j4464",
+			"amount": [
+				{
+					"denom": "ukava",
+					"amount": "1000000000"
+				}
+			]
+		}
+	],
+	"cliff_duration": "7776000000000000",
+	"duration": "31536000000000000",
+	"deposit": [
+		{
+			"denom": "ukava",
+			"amount": "1000000000"
+		}
+	]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolStreamSpendProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolStreamSpendProposal(proposal.Title, proposal.Description, proposal.RecipientList, proposal.CliffDuration, proposal.Duration)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitStreamCancelProposal implements the command to submit a community-pool stream cancel proposal
+func GetCmdSubmitStreamCancelProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream-cancel [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream cancel proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool stream cancel proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Coins the stream has released are paid to the
+recipient and the rest are returned to the kavadist module account.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream-cancel <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Stream 1",
+  "description": "The grantee has stopped work on the project",
+  "stream_id": "1",
+	"deposit": [
+		{
+			"denom": "ukava",
+			"amount": "1000000000"
+		}
+	]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolStreamCancelProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolStreamCancelProposal(proposal.Title, proposal.Description, proposal.StreamID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"io/ioutil"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,24 @@ type (
 		RecipientList types.MultiSpendRecipients `json:"recipient_list" yaml:"recipient_list"`
 		Deposit       sdk.Coins                  `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolStreamSpendProposalJSON defines a CommunityPoolStreamSpendProposal with a deposit
+	CommunityPoolStreamSpendProposalJSON struct {
+		Title         string                     `json:"title" yaml:"title"`
+		Description   string                     `json:"description" yaml:"description"`
+		RecipientList types.MultiSpendRecipients `json:"recipient_list" yaml:"recipient_list"`
+		CliffDuration time.Duration              `json:"cliff_duration" yaml:"cliff_duration"`
+		Duration      time.Duration              `json:"duration" yaml:"duration"`
+		Deposit       sdk.Coins                  `json:"deposit" yaml:"deposit"`
+	}
+
+	// CommunityPoolStreamCancelProposalJSON defines a CommunityPoolStreamCancelProposal with a deposit
+	CommunityPoolStreamCancelProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		StreamID    uint64    `json:"stream_id" yaml:"stream_id"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolMultiSpendProposalJSON reads and parses a CommunityPoolMultiSpendProposalJSON from a file.
@@ -34,3 +53,35 @@ func ParseCommunityPoolMultiSpendProposalJSON(cdc *codec.Codec, proposalFile str
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolStreamSpendProposalJSON reads and parses a CommunityPoolStreamSpendProposalJSON from a file.
+func ParseCommunityPoolStreamSpendProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolStreamSpendProposalJSON, error) {
+	proposal := CommunityPoolStreamSpendProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCommunityPoolStreamCancelProposalJSON reads and parses a CommunityPoolStreamCancelProposalJSON from a file.
+func ParseCommunityPoolStreamCancelProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolStreamCancelProposalJSON, error) {
+	proposal := CommunityPoolStreamCancelProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/kava-labs/kava/x/kavadist/client/rest"
 )
 
-// community-pool multi-spend proposal handler
+// community-pool multi-spend, stream spend and stream cancel proposal handlers
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler             = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	StreamSpendProposalHandler  = govclient.NewProposalHandler(cli.GetCmdSubmitStreamSpendProposal, rest.StreamSpendProposalRESTHandler)
+	StreamCancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitStreamCancelProposal, rest.StreamCancelProposalRESTHandler)
 )
@@ -15,9 +15,16 @@ import (
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
 
+// REST variable names
+const (
+	RestStreamID  = "stream-id"
+	RestRecipient = "recipient"
+)
+
 // RegisterRoutes registers kavadist-related REST handlers to a router
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
 }
 
 // ProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool multi-spend REST handler with a given sub-route.
@@ -27,6 +34,23 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 		Handler:  postProposalHandlerFn(cliCtx),
 	}
 }
+
+// StreamSpendProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool stream spend REST handler with a given sub-route.
+func StreamSpendProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ProposalTypeCommunityPoolStreamSpend,
+		Handler:  postStreamSpendProposalHandlerFn(cliCtx),
+	}
+}
+
+// StreamCancelProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool stream cancel REST handler with a given sub-route.
+func StreamCancelProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ProposalTypeCommunityPoolStreamCancel,
+		Handler:  postStreamCancelProposalHandlerFn(cliCtx),
+	}
+}
+
 func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req CommunityPoolMultiSpendProposalReq
@@ -46,3 +70,43 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postStreamSpendProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolStreamSpendProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		content := types.NewCommunityPoolStreamSpendProposal(req.Title, req.Description, req.RecipientList, req.CliffDuration, req.Duration)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postStreamCancelProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolStreamCancelProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		content := types.NewCommunityPoolStreamCancelProposal(req.Title, req.Description, req.StreamID)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
@@ -18,4 +20,35 @@ type (
 		Deposit       sdk.Coins                  `json:"deposit" yaml:"deposit"`
 		Proposer      sdk.AccAddress             `json:"proposer" yaml:"proposer"`
 	}
+
+	// CommunityPoolStreamSpendProposalReq defines a community pool stream spend proposal request body.
+	CommunityPoolStreamSpendProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title         string                     `json:"title" yaml:"title"`
+		Description   string                     `json:"description" yaml:"description"`
+		RecipientList types.MultiSpendRecipients `json:"recipient_list" yaml:"recipient_list"`
+		CliffDuration time.Duration              `json:"cliff_duration" yaml:"cliff_duration"`
+		Duration      time.Duration              `json:"duration" yaml:"duration"`
+		Deposit       sdk.Coins                  `json:"deposit" yaml:"deposit"`
+		Proposer      sdk.AccAddress             `json:"proposer" yaml:"proposer"`
+	}
+
+	// CommunityPoolStreamCancelProposalReq defines a community pool stream cancel proposal request body.
+	CommunityPoolStreamCancelProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		StreamID    uint64         `json:"stream_id" yaml:"stream_id"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
+
+	// PostClaimStreamReq defines the properties of a claim stream request's body.
+	PostClaimStreamReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		StreamID  uint64         `json:"stream_id" yaml:"stream_id"`
+	}
 )
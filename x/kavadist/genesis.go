@@ -27,6 +27,20 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs
 		panic(fmt.Sprintf("%s module account has not been set", KavaDistMacc))
 	}
 
+	// check the escrow account holds the unclaimed coins of all streams
+	escrowAcc := supplyKeeper.GetModuleAccount(ctx, StreamEscrowMacc)
+	if escrowAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", StreamEscrowMacc))
+	}
+	unclaimed := sdk.NewCoins()
+	for _, stream := range gs.Streams {
+		k.SetStream(ctx, stream)
+		unclaimed = unclaimed.Add(stream.Amount.Sub(stream.Claimed)...)
+	}
+	if !unclaimed.IsAllLTE(escrowAcc.GetCoins()) {
+		panic(fmt.Sprintf("%s module account balance %s is less than unclaimed stream coins %s", StreamEscrowMacc, escrowAcc.GetCoins(), unclaimed))
+	}
+	k.SetNextStreamID(ctx, gs.NextStreamID)
 }
 
 // ExportGenesis export genesis state for cdp module
@@ -36,5 +50,5 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, previousBlockTime)
+	return NewGenesisState(params, previousBlockTime, k.GetAllStreams(ctx), k.GetNextStreamID(ctx))
 }
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/kava-labs/kava/x/kavadist/keeper"
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
@@ -13,17 +14,44 @@ func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
+		case MsgClaimStream:
+			return handleMsgClaimStream(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
 	}
 }
 
+// handleMsgClaimStream handles requests to claim the coins released by a spend stream
+func handleMsgClaimStream(ctx sdk.Context, k Keeper, msg MsgClaimStream) (*sdk.Result, error) {
+	_, err := k.ClaimStream(ctx, msg.Recipient, msg.StreamID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Recipient.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// NewCommunityPoolMultiSpendProposalHandler handles all kavadist proposals
 func NewCommunityPoolMultiSpendProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case types.CommunityPoolMultiSpendProposal:
 			return keeper.HandleCommunityPoolMultiSpendProposal(ctx, k, c)
+		case types.CommunityPoolStreamSpendProposal:
+			return keeper.HandleCommunityPoolStreamSpendProposal(ctx, k, c)
+		case types.CommunityPoolStreamCancelProposal:
+			return keeper.HandleCommunityPoolStreamCancelProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized kavadist proposal content type: %T", c)
@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/kavadist/types"
 )
 
-// MintPeriodInflation mints new tokens according to the inflation schedule specified in the parameters
+// MintPeriodInflation mints new tokens according to the inflation schedules specified in the parameters
 func (k Keeper) MintPeriodInflation(ctx sdk.Context) error {
 	params := k.GetParams(ctx)
 	if !params.Active {
@@ -27,8 +30,21 @@ func (k Keeper) MintPeriodInflation(ctx sdk.Context) error {
 		return nil
 	}
 
+	for _, schedule := range params.Schedules {
+		if err := k.mintScheduleInflation(ctx, schedule, previousBlockTime); err != nil {
+			return err
+		}
+	}
+	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
+	return nil
+}
+
+// mintScheduleInflation mints the coins due for an inflation schedule since the previous block time and sends them to the schedule's recipients
+func (k Keeper) mintScheduleInflation(ctx sdk.Context, schedule types.InflationSchedule, previousBlockTime time.Time) error {
 	var err error
-	for _, period := range params.Periods {
+	totalMinted := sdk.ZeroInt()
+	for _, period := range schedule.Periods {
+		minted := sdk.ZeroInt()
 		switch {
 		// Case 1 - period is fully expired
 		case period.End.Before(previousBlockTime):
@@ -38,7 +54,7 @@ func (k Keeper) MintPeriodInflation(ctx sdk.Context) error {
 		case period.End.After(previousBlockTime) && period.End.Before(ctx.BlockTime()):
 			// calculate time elapsed relative to the periods end time
 			timeElapsed := sdk.NewInt(period.End.Unix() - previousBlockTime.Unix())
-			err = k.mintInflationaryCoins(ctx, period.Inflation, timeElapsed, types.GovDenom)
+			minted, err = k.mintInflationaryCoins(ctx, period.Inflation, timeElapsed, schedule.Denom)
 			// update the value of previousBlockTime so that the next period starts from the end of the last
 			// period and not the original value of previousBlockTime
 			previousBlockTime = period.End
@@ -47,7 +63,7 @@ func (k Keeper) MintPeriodInflation(ctx sdk.Context) error {
 		case (period.Start.Before(previousBlockTime) || period.Start.Equal(previousBlockTime)) && period.End.After(ctx.BlockTime()):
 			// calculate time elapsed relative to the current block time
 			timeElapsed := sdk.NewInt(ctx.BlockTime().Unix() - previousBlockTime.Unix())
-			err = k.mintInflationaryCoins(ctx, period.Inflation, timeElapsed, types.GovDenom)
+			minted, err = k.mintInflationaryCoins(ctx, period.Inflation, timeElapsed, schedule.Denom)
 
 		// Case 4 - period hasn't started
 		case period.Start.After(ctx.BlockTime()) || period.Start.Equal(ctx.BlockTime()):
@@ -57,12 +73,43 @@ func (k Keeper) MintPeriodInflation(ctx sdk.Context) error {
 		if err != nil {
 			return err
 		}
+		totalMinted = totalMinted.Add(minted)
+	}
+	return k.distributeInflation(ctx, sdk.NewCoin(schedule.Denom, totalMinted), schedule.Recipients)
+}
+
+// distributeInflation sends each recipient its weighted share of the minted coins.
+// The remainder, including the share of any recipient that isn't a registered module account, stays in the kavadist module account.
+func (k Keeper) distributeInflation(ctx sdk.Context, minted sdk.Coin, recipients types.InflationRecipients) error {
+	if !minted.IsPositive() {
+		return nil
+	}
+	for _, recipient := range recipients {
+		amount := recipient.Weight.MulInt(minted.Amount).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+		if k.supplyKeeper.GetModuleAddress(recipient.ModuleAccount) == nil {
+			k.Logger(ctx).Error(fmt.Sprintf("inflation recipient %s is not a module account, keeping its share in %s", recipient.ModuleAccount, types.KavaDistMacc))
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(minted.Denom, amount))
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.KavaDistMacc, recipient.ModuleAccount, coins); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeKavaDist,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.ModuleAccount),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			),
+		)
 	}
-	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
 	return nil
 }
 
-func (k Keeper) mintInflationaryCoins(ctx sdk.Context, inflationRate sdk.Dec, timePeriods sdk.Int, denom string) error {
+func (k Keeper) mintInflationaryCoins(ctx sdk.Context, inflationRate sdk.Dec, timePeriods sdk.Int, denom string) (sdk.Int, error) {
 	totalSupply := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
 	// used to scale accumulator calculations by 10^18
 	scalar := sdk.NewInt(1000000000000000000)
@@ -74,11 +121,11 @@ func (k Keeper) mintInflationaryCoins(ctx sdk.Context, inflationRate sdk.Dec, ti
 	// calculate the number of coins to mint
 	amountToMint := (sdk.NewDecFromInt(totalSupply).Mul(accumulator)).Sub(sdk.NewDecFromInt(totalSupply)).TruncateInt()
 	if amountToMint.IsZero() {
-		return nil
+		return sdk.ZeroInt(), nil
 	}
 	err := k.supplyKeeper.MintCoins(ctx, types.KavaDistMacc, sdk.NewCoins(sdk.NewCoin(denom, amountToMint)))
 	if err != nil {
-		return err
+		return sdk.ZeroInt(), err
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -88,5 +135,5 @@ func (k Keeper) mintInflationaryCoins(ctx sdk.Context, inflationRate sdk.Dec, ti
 		),
 	)
 
-	return nil
+	return amountToMint, nil
 }
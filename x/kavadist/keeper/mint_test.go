@@ -40,14 +40,14 @@ func (suite *KeeperTestSuite) SetupTest() {
 	app.SetBech32AddressPrefixes(config)
 	tApp := app.NewTestApp()
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	coins := []sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000000000000)))}
+	coins := []sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000000000000)), sdk.NewCoin("hard", sdk.NewInt(1000000000000)))}
 	authGS := app.NewAuthGenState(
 		addrs, coins)
 
 	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
 
-	params := types.NewParams(true, testPeriods)
-	gs := app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(types.NewGenesisState(params, types.DefaultPreviousBlockTime))}
+	params := types.NewParams(true, types.InflationSchedules{types.NewInflationSchedule(types.GovDenom, testPeriods, nil)})
+	gs := app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(types.NewGenesisState(params, types.DefaultPreviousBlockTime, types.SpendStreams{}, types.DefaultNextStreamID))}
 	tApp.InitializeFromGenesisStates(
 		authGS,
 		gs,
@@ -111,7 +111,7 @@ func (suite *KeeperTestSuite) TestMintPeriodTransition() {
 			Inflation: sdk.MustNewDecFromStr("1.000000003022265980"),
 		},
 	}
-	params.Periods = periods
+	params.Schedules[0].Periods = periods
 	suite.NotPanics(func() {
 		suite.keeper.SetParams(suite.ctx, params)
 	})
@@ -142,6 +142,73 @@ func (suite *KeeperTestSuite) TestMintNotActive() {
 	suite.Equal(initialSupply, finalSupply)
 }
 
+func (suite *KeeperTestSuite) TestMintMultipleSchedules() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Schedules = types.InflationSchedules{
+		types.NewInflationSchedule(types.GovDenom, testPeriods, types.InflationRecipients{
+			types.NewInflationRecipient("hard", sdk.MustNewDecFromStr("0.5")),
+			types.NewInflationRecipient("swap", sdk.MustNewDecFromStr("0.25")),
+		}),
+		types.NewInflationSchedule("hard", testPeriods, types.InflationRecipients{
+			types.NewInflationRecipient("swap", sdk.OneDec()),
+		}),
+	}
+	suite.NotPanics(func() {
+		suite.keeper.SetParams(suite.ctx, params)
+	})
+	suite.NotPanics(func() {
+		suite.keeper.SetPreviousBlockTime(suite.ctx, time.Date(2020, time.March, 1, 1, 0, 1, 0, time.UTC))
+	})
+	initialKavaSupply := suite.supplyKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(types.GovDenom)
+	initialHardSupply := suite.supplyKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("hard")
+	moduleBalance := func(ctx sdk.Context, name, denom string) sdk.Int {
+		return suite.supplyKeeper.GetModuleAccount(ctx, name).GetCoins().AmountOf(denom)
+	}
+
+	ctx := suite.ctx.WithBlockTime(time.Date(2020, time.June, 1, 1, 0, 0, 0, time.UTC))
+	err := suite.keeper.MintPeriodInflation(ctx)
+	suite.NoError(err)
+
+	// each schedule mints its own denom
+	mintedKava := suite.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(types.GovDenom).Sub(initialKavaSupply)
+	mintedHard := suite.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf("hard").Sub(initialHardSupply)
+	suite.True(mintedKava.IsPositive())
+	suite.True(mintedHard.IsPositive())
+
+	// minted coins are split between the recipients and the remainder stays in kavadist
+	suite.Equal(sdk.MustNewDecFromStr("0.5").MulInt(mintedKava).TruncateInt(), moduleBalance(ctx, "hard", types.GovDenom))
+	suite.Equal(sdk.MustNewDecFromStr("0.25").MulInt(mintedKava).TruncateInt(), moduleBalance(ctx, "swap", types.GovDenom))
+	suite.Equal(
+		mintedKava.Sub(moduleBalance(ctx, "hard", types.GovDenom)).Sub(moduleBalance(ctx, "swap", types.GovDenom)),
+		moduleBalance(ctx, types.KavaDistMacc, types.GovDenom),
+	)
+	suite.Equal(mintedHard, moduleBalance(ctx, "swap", "hard"))
+	suite.True(moduleBalance(ctx, types.KavaDistMacc, "hard").IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMintUnknownRecipient() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Schedules[0].Recipients = types.InflationRecipients{
+		types.NewInflationRecipient("not-a-module-account", sdk.OneDec()),
+	}
+	suite.NotPanics(func() {
+		suite.keeper.SetParams(suite.ctx, params)
+	})
+	suite.NotPanics(func() {
+		suite.keeper.SetPreviousBlockTime(suite.ctx, time.Date(2020, time.March, 1, 1, 0, 1, 0, time.UTC))
+	})
+	initialSupply := suite.supplyKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(types.GovDenom)
+
+	ctx := suite.ctx.WithBlockTime(time.Date(2020, time.June, 1, 1, 0, 0, 0, time.UTC))
+	err := suite.keeper.MintPeriodInflation(ctx)
+	suite.NoError(err)
+
+	// the share of a recipient that isn't a module account stays in kavadist
+	minted := suite.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(types.GovDenom).Sub(initialSupply)
+	suite.True(minted.IsPositive())
+	suite.Equal(minted, suite.supplyKeeper.GetModuleAccount(ctx, types.KavaDistMacc).GetCoins().AmountOf(types.GovDenom))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
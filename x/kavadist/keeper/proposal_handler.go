@@ -21,3 +21,20 @@ func HandleCommunityPoolMultiSpendProposal(ctx sdk.Context, k Keeper, p types.Co
 
 	return nil
 }
+
+// HandleCommunityPoolStreamSpendProposal is a handler for executing a passed community pool stream spend proposal
+func HandleCommunityPoolStreamSpendProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolStreamSpendProposal) error {
+	for _, receiverInfo := range p.RecipientList {
+		_, err := k.CreateStream(ctx, receiverInfo.Address, receiverInfo.Amount, p.CliffDuration, p.Duration)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleCommunityPoolStreamCancelProposal is a handler for executing a passed community pool stream cancel proposal
+func HandleCommunityPoolStreamCancelProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolStreamCancelProposal) error {
+	return k.CancelStream(ctx, p.StreamID)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +19,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, k)
 		case types.QueryGetBalance:
 			return queryGetBalance(ctx, req, k)
+		case types.QueryGetStream:
+			return queryGetStream(ctx, req, k)
+		case types.QueryGetStreams:
+			return queryGetStreams(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -48,3 +53,53 @@ func queryGetBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 
 	return bz, nil
 }
+
+// queryGetStream returns a spend stream
+func queryGetStream(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryStreamParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	stream, found := k.GetStream(ctx, params.StreamID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrStreamNotFound, "%d", params.StreamID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, stream)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// queryGetStreams returns a page of spend streams, optionally filtered by recipient
+func queryGetStreams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryStreamsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	streams := types.SpendStreams{}
+	k.IterateStreams(ctx, func(stream types.SpendStream) bool {
+		if params.Recipient.Empty() || stream.Recipient.Equals(params.Recipient) {
+			streams = append(streams, stream)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(streams), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		streams = types.SpendStreams{}
+	} else {
+		streams = streams[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, streams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
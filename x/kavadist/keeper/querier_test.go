@@ -14,7 +14,7 @@ func (suite *KeeperTestSuite) TestQuerierGetParams() {
 	suite.Require().NoError(err)
 	suite.NotNil(bz)
 
-	testParams := types.NewParams(true, testPeriods)
+	testParams := types.NewParams(true, types.InflationSchedules{types.NewInflationSchedule(types.GovDenom, testPeriods, nil)})
 	var p types.Params
 	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &p))
 	suite.Require().Equal(testParams, p)
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// CreateStream escrows the stream amount from the kavadist module account and opens a spend stream to the recipient that starts at the current block time
+func (k Keeper) CreateStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, cliffDuration, duration time.Duration) (uint64, error) {
+	if k.blacklistedAddrs[recipient.String()] {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", recipient)
+	}
+
+	startTime := ctx.BlockTime()
+	stream := types.NewSpendStream(k.GetNextStreamID(ctx), recipient, amount, startTime, startTime.Add(cliffDuration), startTime.Add(duration))
+	if err := stream.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidStreamSchedule, err.Error())
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.KavaDistMacc, types.StreamEscrowMacc, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetStream(ctx, stream)
+	k.SetNextStreamID(ctx, stream.ID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return stream.ID, nil
+}
+
+// ClaimStream pays the recipient the coins a stream has released since the last claim. Fully claimed streams are deleted.
+func (k Keeper) ClaimStream(ctx sdk.Context, recipient sdk.AccAddress, streamID uint64) (sdk.Coins, error) {
+	stream, found := k.GetStream(ctx, streamID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrStreamNotFound, "%d", streamID)
+	}
+	if !stream.Recipient.Equals(recipient) {
+		return nil, sdkerrors.Wrapf(types.ErrNotStreamRecipient, "%s", recipient)
+	}
+
+	claimable := stream.GetClaimableAmount(ctx.BlockTime())
+	if claimable.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNothingToClaim, "%d", streamID)
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.StreamEscrowMacc, recipient, claimable)
+	if err != nil {
+		return nil, err
+	}
+
+	stream.Claimed = stream.Claimed.Add(claimable...)
+	if stream.Claimed.IsEqual(stream.Amount) {
+		k.DeleteStream(ctx, streamID)
+	} else {
+		k.SetStream(ctx, stream)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", streamID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimable.String()),
+		),
+	)
+
+	return claimable, nil
+}
+
+// CancelStream pays the recipient any coins the stream has released but that haven't been claimed,
+// returns the rest of the stream amount to the kavadist module account, and deletes the stream
+func (k Keeper) CancelStream(ctx sdk.Context, streamID uint64) error {
+	stream, found := k.GetStream(ctx, streamID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrStreamNotFound, "%d", streamID)
+	}
+
+	vested := stream.GetVestedAmount(ctx.BlockTime())
+	claimable := vested.Sub(stream.Claimed)
+	if !claimable.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.StreamEscrowMacc, stream.Recipient, claimable)
+		if err != nil {
+			return err
+		}
+	}
+	returned := stream.Amount.Sub(vested)
+	if !returned.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.StreamEscrowMacc, types.KavaDistMacc, returned)
+		if err != nil {
+			return err
+		}
+	}
+	k.DeleteStream(ctx, streamID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", streamID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimable.String()),
+			sdk.NewAttribute(types.AttributeKeyReturned, returned.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetStream returns a spend stream from the store
+func (k Keeper) GetStream(ctx sdk.Context, streamID uint64) (types.SpendStream, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StreamKeyPrefix)
+	bz := store.Get(types.GetStreamKey(streamID))
+	if bz == nil {
+		return types.SpendStream{}, false
+	}
+	var stream types.SpendStream
+	k.cdc.MustUnmarshalBinaryBare(bz, &stream)
+	return stream, true
+}
+
+// SetStream puts a spend stream into the store
+func (k Keeper) SetStream(ctx sdk.Context, stream types.SpendStream) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StreamKeyPrefix)
+	store.Set(types.GetStreamKey(stream.ID), k.cdc.MustMarshalBinaryBare(stream))
+}
+
+// DeleteStream removes a spend stream from the store
+func (k Keeper) DeleteStream(ctx sdk.Context, streamID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StreamKeyPrefix)
+	store.Delete(types.GetStreamKey(streamID))
+}
+
+// IterateStreams iterates over all spend streams in order of ID and performs a callback function
+func (k Keeper) IterateStreams(ctx sdk.Context, cb func(stream types.SpendStream) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.StreamKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.SpendStream
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// GetAllStreams returns all spend streams from the store
+func (k Keeper) GetAllStreams(ctx sdk.Context) (streams types.SpendStreams) {
+	k.IterateStreams(ctx, func(stream types.SpendStream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return
+}
+
+// GetNextStreamID returns the ID to be used for the next spend stream
+func (k Keeper) GetNextStreamID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextStreamIDKey)
+	if bz == nil {
+		return types.DefaultNextStreamID
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextStreamID stores the ID to be used for the next spend stream
+func (k Keeper) SetNextStreamID(ctx sdk.Context, streamID uint64) {
+	ctx.KVStore(k.key).Set(types.NextStreamIDKey, sdk.Uint64ToBigEndian(streamID))
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/kavadist/keeper"
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// newRecipients returns addresses without a genesis balance
+func newRecipients(count int) []sdk.AccAddress {
+	_, addrs := app.GeneratePrivKeyAddressPairs(count + 1)
+	return addrs[1:]
+}
+
+func (suite *KeeperTestSuite) fundKavaDist(amount sdk.Coins) {
+	suite.Require().NoError(suite.supplyKeeper.MintCoins(suite.ctx, types.KavaDistMacc, amount))
+}
+
+func (suite *KeeperTestSuite) moduleBalance(ctx sdk.Context, name string) sdk.Coins {
+	return suite.supplyKeeper.GetModuleAccount(ctx, name).GetCoins()
+}
+
+func (suite *KeeperTestSuite) accountBalance(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	acc := suite.app.GetAccountKeeper().GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.NewCoins()
+	}
+	return acc.GetCoins()
+}
+
+func (suite *KeeperTestSuite) TestCreateStream() {
+	addrs := newRecipients(1)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000e6))
+
+	// streams are funded from the kavadist module account
+	_, err := suite.keeper.CreateStream(suite.ctx, addrs[0], amount, 0, time.Hour)
+	suite.Require().Error(err)
+
+	suite.fundKavaDist(amount)
+	id, err := suite.keeper.CreateStream(suite.ctx, addrs[0], amount, 10*time.Minute, time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultNextStreamID, id)
+	suite.Require().Equal(id+1, suite.keeper.GetNextStreamID(suite.ctx))
+	suite.Require().True(suite.moduleBalance(suite.ctx, types.KavaDistMacc).IsZero())
+	suite.Require().Equal(amount, suite.moduleBalance(suite.ctx, types.StreamEscrowMacc))
+
+	stream, found := suite.keeper.GetStream(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(addrs[0], stream.Recipient)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(10*time.Minute), stream.CliffTime)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(time.Hour), stream.EndTime)
+
+	// module accounts can't receive streams
+	suite.fundKavaDist(amount)
+	_, err = suite.keeper.CreateStream(suite.ctx, supply.NewModuleAddress(types.KavaDistMacc), amount, 0, time.Hour)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrUnauthorized))
+}
+
+func (suite *KeeperTestSuite) TestClaimStream() {
+	addrs := newRecipients(2)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000e6))
+	suite.fundKavaDist(amount)
+	id, err := suite.keeper.CreateStream(suite.ctx, addrs[0], amount, 15*time.Minute, time.Hour)
+	suite.Require().NoError(err)
+
+	// nothing can be claimed before the cliff
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+	_, err = suite.keeper.ClaimStream(ctx, addrs[0], id)
+	suite.Require().True(errors.Is(err, types.ErrNothingToClaim))
+
+	// only the recipient can claim
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(15 * time.Minute))
+	_, err = suite.keeper.ClaimStream(ctx, addrs[1], id)
+	suite.Require().True(errors.Is(err, types.ErrNotStreamRecipient))
+
+	// at the cliff everything released since the start can be claimed
+	claimed, err := suite.keeper.ClaimStream(ctx, addrs[0], id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250e6)), claimed)
+	suite.Require().Equal(claimed, suite.accountBalance(ctx, addrs[0]))
+
+	// coins are released linearly after the cliff
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+	claimed, err = suite.keeper.ClaimStream(ctx, addrs[0], id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 250e6)), claimed)
+	stream, found := suite.keeper.GetStream(ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 500e6)), stream.Claimed)
+
+	// the stream is deleted once fully claimed
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	claimed, err = suite.keeper.ClaimStream(ctx, addrs[0], id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 500e6)), claimed)
+	suite.Require().Equal(amount, suite.accountBalance(ctx, addrs[0]))
+	suite.Require().True(suite.moduleBalance(ctx, types.StreamEscrowMacc).IsZero())
+	_, found = suite.keeper.GetStream(ctx, id)
+	suite.Require().False(found)
+	_, err = suite.keeper.ClaimStream(ctx, addrs[0], id)
+	suite.Require().True(errors.Is(err, types.ErrStreamNotFound))
+}
+
+func (suite *KeeperTestSuite) TestCancelStream() {
+	addrs := newRecipients(1)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000e6))
+	suite.fundKavaDist(amount)
+	id, err := suite.keeper.CreateStream(suite.ctx, addrs[0], amount, 0, time.Hour)
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(15 * time.Minute))
+	_, err = suite.keeper.ClaimStream(ctx, addrs[0], id)
+	suite.Require().NoError(err)
+
+	// released but unclaimed coins are paid to the recipient and the rest returned to kavadist
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * time.Minute))
+	proposal := types.NewCommunityPoolStreamCancelProposal("Cancel Stream", "The grantee stopped work.", id)
+	suite.Require().NoError(keeper.HandleCommunityPoolStreamCancelProposal(ctx, suite.keeper, proposal))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 500e6)), suite.accountBalance(ctx, addrs[0]))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 500e6)), suite.moduleBalance(ctx, types.KavaDistMacc))
+	suite.Require().True(suite.moduleBalance(ctx, types.StreamEscrowMacc).IsZero())
+	_, found := suite.keeper.GetStream(ctx, id)
+	suite.Require().False(found)
+
+	err = keeper.HandleCommunityPoolStreamCancelProposal(ctx, suite.keeper, proposal)
+	suite.Require().True(errors.Is(err, types.ErrStreamNotFound))
+}
+
+func (suite *KeeperTestSuite) TestHandleCommunityPoolStreamSpendProposal() {
+	addrs := newRecipients(2)
+	suite.fundKavaDist(sdk.NewCoins(sdk.NewInt64Coin("ukava", 300e6)))
+	proposal := types.NewCommunityPoolStreamSpendProposal("Grants", "Stream grants to two teams.", types.MultiSpendRecipients{
+		{Address: addrs[0], Amount: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6))},
+		{Address: addrs[1], Amount: sdk.NewCoins(sdk.NewInt64Coin("ukava", 200e6))},
+	}, time.Hour, 24*time.Hour)
+
+	suite.Require().NoError(keeper.HandleCommunityPoolStreamSpendProposal(suite.ctx, suite.keeper, proposal))
+	streams := suite.keeper.GetAllStreams(suite.ctx)
+	suite.Require().Len(streams, 2)
+	suite.Require().Equal(addrs[0], streams[0].Recipient)
+	suite.Require().Equal(addrs[1], streams[1].Recipient)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 300e6)), suite.moduleBalance(suite.ctx, types.StreamEscrowMacc))
+
+	// the kavadist module account can't cover another spend
+	err := keeper.HandleCommunityPoolStreamSpendProposal(suite.ctx, suite.keeper, proposal)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerierGetStreams() {
+	addrs := newRecipients(2)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6))
+	suite.fundKavaDist(amount.Add(amount...).Add(amount...))
+	for _, addr := range []sdk.AccAddress{addrs[0], addrs[1], addrs[0]} {
+		_, err := suite.keeper.CreateStream(suite.ctx, addr, amount, 0, time.Hour)
+		suite.Require().NoError(err)
+	}
+	querier := keeper.NewQuerier(suite.keeper)
+
+	bz, err := querier(suite.ctx, []string{types.QueryGetStream}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryStreamParams(2))})
+	suite.Require().NoError(err)
+	var stream types.SpendStream
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &stream))
+	suite.Require().Equal(addrs[1], stream.Recipient)
+
+	_, err = querier(suite.ctx, []string{types.QueryGetStream}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryStreamParams(4))})
+	suite.Require().True(errors.Is(err, types.ErrStreamNotFound))
+
+	bz, err = querier(suite.ctx, []string{types.QueryGetStreams}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryStreamsParams(1, 10, addrs[0]))})
+	suite.Require().NoError(err)
+	var streams types.SpendStreams
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &streams))
+	suite.Require().Len(streams, 2)
+	suite.Require().Equal(uint64(1), streams[0].ID)
+	suite.Require().Equal(uint64(3), streams[1].ID)
+
+	bz, err = querier(suite.ctx, []string{types.QueryGetStreams}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryStreamsParams(2, 2, nil))})
+	suite.Require().NoError(err)
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &streams))
+	suite.Require().Len(streams, 1)
+	suite.Require().Equal(uint64(3), streams[0].ID)
+}
@@ -61,8 +61,10 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 	rest.RegisterRoutes(ctx, rtr)
 }
 
-// GetTxCmd returns the root tx command for the crisis module.
-func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command { return nil }
+// GetTxCmd returns the root tx command for the kavadist module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
+}
 
 // GetQueryCmd returns no root query command for the kavadist module.
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -21,6 +22,17 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
 		return fmt.Sprintf("%s\n%s", timeA, timeB)
 
+	case bytes.Equal(kvA.Key[:1], types.StreamKeyPrefix):
+		var streamA, streamB types.SpendStream
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &streamA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &streamB)
+		return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+	case bytes.Equal(kvA.Key[:1], types.NextStreamIDKey):
+		idA := binary.BigEndian.Uint64(kvA.Value)
+		idB := binary.BigEndian.Uint64(kvB.Value)
+		return fmt.Sprintf("%d\n%d", idA, idB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
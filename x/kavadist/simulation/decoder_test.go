@@ -27,9 +27,12 @@ func TestDecodeDistributionStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	prevBlockTime := time.Now().UTC()
+	stream := types.NewSpendStream(1, sdk.AccAddress("test"), sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)), prevBlockTime, prevBlockTime, prevBlockTime.Add(time.Hour))
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: []byte(types.PreviousBlockTimeKey), Value: cdc.MustMarshalBinaryLengthPrefixed(prevBlockTime)},
+		kv.Pair{Key: append(types.StreamKeyPrefix, types.GetStreamKey(1)...), Value: cdc.MustMarshalBinaryBare(stream)},
+		kv.Pair{Key: types.NextStreamIDKey, Value: sdk.Uint64ToBigEndian(2)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -38,6 +41,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"PreviousBlockTime", fmt.Sprintf("%s\n%s", prevBlockTime, prevBlockTime)},
+		{"Stream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"NextStreamID", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -27,7 +27,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		panic(err)
 	}
 
-	kavadistGenesis := types.NewGenesisState(params, types.DefaultPreviousBlockTime)
+	kavadistGenesis := types.NewGenesisState(params, types.DefaultPreviousBlockTime, types.SpendStreams{}, types.DefaultNextStreamID)
 	if err := kavadistGenesis.Validate(); err != nil {
 		panic(err)
 	}
@@ -37,11 +37,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 }
 
 func genRandomParams(simState *module.SimulationState) types.Params {
-	periods := genRandomPeriods(simState.Rand, simState.GenTimestamp)
-	params := types.NewParams(true, periods)
+	schedules := genRandomSchedules(simState.Rand, simState.GenTimestamp)
+	params := types.NewParams(true, schedules)
 	return params
 }
 
+func genRandomSchedules(r *rand.Rand, timestamp time.Time) types.InflationSchedules {
+	// minted coins stay in the kavadist module account as simulations don't depend on other module accounts
+	periods := genRandomPeriods(r, timestamp)
+	return types.InflationSchedules{types.NewInflationSchedule(types.GovDenom, periods, types.InflationRecipients{})}
+}
+
 func genRandomPeriods(r *rand.Rand, timestamp time.Time) types.Periods {
 	var periods types.Periods
 	numPeriods := simulation.RandIntBetween(r, 1, 10)
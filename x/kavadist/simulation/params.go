@@ -12,10 +12,10 @@ import (
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simulation.ParamChange {
-	// Hacky way to validate periods since validation is wrapped in params
+	// Hacky way to validate schedules since validation is wrapped in params
 	active := genRandomActive(r)
-	periods := genRandomPeriods(r, simulation.RandTimestamp(r))
-	if err := types.NewParams(active, periods).Validate(); err != nil {
+	schedules := genRandomSchedules(r, simulation.RandTimestamp(r))
+	if err := types.NewParams(active, schedules).Validate(); err != nil {
 		panic(err)
 	}
 
@@ -25,9 +25,9 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("%t", active)
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPeriods),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySchedules),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%v", periods)
+				return fmt.Sprintf("%v", schedules)
 			},
 		),
 	}
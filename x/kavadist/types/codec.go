@@ -14,5 +14,8 @@ func init() {
 
 // RegisterCodec registers the necessary types for cdp module
 func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgClaimStream{}, "kavadist/MsgClaimStream", nil)
 	cdc.RegisterConcrete(CommunityPoolMultiSpendProposal{}, "kava/CommunityPoolMultiSpendProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolStreamSpendProposal{}, "kava/CommunityPoolStreamSpendProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolStreamCancelProposal{}, "kava/CommunityPoolStreamCancelProposal", nil)
 }
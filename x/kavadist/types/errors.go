@@ -8,4 +8,8 @@ import (
 var (
 	ErrInvalidProposalAmount  = sdkerrors.Register(ModuleName, 2, "invalid community pool multi-spend proposal amount")
 	ErrEmptyProposalRecipient = sdkerrors.Register(ModuleName, 3, "invalid community pool multi-spend proposal recipient")
+	ErrInvalidStreamSchedule  = sdkerrors.Register(ModuleName, 4, "invalid spend stream schedule")
+	ErrStreamNotFound         = sdkerrors.Register(ModuleName, 5, "spend stream not found")
+	ErrNotStreamRecipient     = sdkerrors.Register(ModuleName, 6, "address is not the spend stream recipient")
+	ErrNothingToClaim         = sdkerrors.Register(ModuleName, 7, "spend stream has no claimable coins")
 )
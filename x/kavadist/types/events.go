@@ -3,7 +3,14 @@ package types
 // Event types for cdp module
 const (
 	EventTypeKavaDist      = ModuleName
+	EventTypeCreateStream  = "create_stream"
+	EventTypeClaimStream   = "claim_stream"
+	EventTypeCancelStream  = "cancel_stream"
 	AttributeKeyInflation  = "kava_dist_inflation"
+	AttributeKeyRecipient  = "kava_dist_recipient"
 	AttributeKeyStatus     = "kava_dist_status"
+	AttributeKeyStreamID   = "stream_id"
+	AttributeKeyReturned   = "returned"
+	AttributeValueCategory = ModuleName
 	AttributeValueInactive = "inactive"
 )
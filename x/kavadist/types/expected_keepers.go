@@ -11,6 +11,7 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, name string) exported.ModuleAccountI
 	GetSupply(ctx sdk.Context) (supply exported.SupplyI)
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
@@ -8,15 +8,19 @@ import (
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	Params            Params    `json:"params" yaml:"params"`
-	PreviousBlockTime time.Time `json:"previous_block_time" yaml:"previous_block_time"`
+	Params            Params       `json:"params" yaml:"params"`
+	PreviousBlockTime time.Time    `json:"previous_block_time" yaml:"previous_block_time"`
+	Streams           SpendStreams `json:"streams" yaml:"streams"`
+	NextStreamID      uint64       `json:"next_stream_id" yaml:"next_stream_id"`
 }
 
 // NewGenesisState returns a new genesis state
-func NewGenesisState(params Params, previousBlockTime time.Time) GenesisState {
+func NewGenesisState(params Params, previousBlockTime time.Time, streams SpendStreams, nextStreamID uint64) GenesisState {
 	return GenesisState{
 		Params:            params,
 		PreviousBlockTime: previousBlockTime,
+		Streams:           streams,
+		NextStreamID:      nextStreamID,
 	}
 }
 
@@ -25,6 +29,8 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Params:            DefaultParams(),
 		PreviousBlockTime: DefaultPreviousBlockTime,
+		Streams:           SpendStreams{},
+		NextStreamID:      DefaultNextStreamID,
 	}
 }
 
@@ -38,6 +44,17 @@ func (gs GenesisState) Validate() error {
 	if gs.PreviousBlockTime.Equal(time.Time{}) {
 		return fmt.Errorf("previous block time not set")
 	}
+	if gs.NextStreamID == 0 {
+		return fmt.Errorf("next stream id cannot be 0")
+	}
+	if err := gs.Streams.Validate(); err != nil {
+		return err
+	}
+	for _, s := range gs.Streams {
+		if s.ID >= gs.NextStreamID {
+			return fmt.Errorf("stream id %d is not below the next stream id %d", s.ID, gs.NextStreamID)
+		}
+	}
 	return nil
 }
 
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "kavadist"
@@ -18,9 +22,19 @@ const (
 
 	// KavaDistMacc module account for kavadist
 	KavaDistMacc = ModuleName
+
+	// StreamEscrowMacc module account that holds the coins of spend streams until they are claimed
+	StreamEscrowMacc = "kavadist_stream_escrow"
 )
 
 var (
 	CurrentDistPeriodKey = []byte{0x00}
 	PreviousBlockTimeKey = []byte{0x01}
+	StreamKeyPrefix      = []byte{0x02} // prefix for keys that store spend streams
+	NextStreamIDKey      = []byte{0x03} // key for the next spend stream id
 )
+
+// GetStreamKey returns the store key of a spend stream
+func GetStreamKey(streamID uint64) []byte {
+	return sdk.Uint64ToBigEndian(streamID)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var _ sdk.Msg = &MsgClaimStream{}
+
+// MsgClaimStream claims the coins a spend stream has released to its recipient.
+type MsgClaimStream struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	StreamID  uint64         `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewMsgClaimStream returns a new MsgClaimStream
+func NewMsgClaimStream(recipient sdk.AccAddress, streamID uint64) MsgClaimStream {
+	return MsgClaimStream{
+		Recipient: recipient,
+		StreamID:  streamID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimStream) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimStream) Type() string { return "claim_stream" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgClaimStream) ValidateBasic() error {
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient address cannot be empty")
+	}
+	if msg.StreamID == 0 {
+		return sdkerrors.Wrap(ErrStreamNotFound, "stream id cannot be 0")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// String implements the Stringer interface
+func (msg MsgClaimStream) String() string {
+	return fmt.Sprintf(`Claim Stream Message:
+	Recipient: %s
+	Stream ID: %d
+`, msg.Recipient, msg.StreamID)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,20 +16,21 @@ import (
 // Parameter keys and default values
 var (
 	KeyActive                = []byte("Active")
-	KeyPeriods               = []byte("Periods")
+	KeySchedules             = []byte("Schedules")
 	DefaultActive            = false
-	DefaultPeriods           = Periods{}
+	DefaultSchedules         = InflationSchedules{}
 	DefaultPreviousBlockTime = tmtime.Canonical(time.Unix(1, 0))
+	DefaultNextStreamID      = uint64(1)
 	GovDenom                 = cdptypes.DefaultGovDenom
 )
 
 // Params governance parameters for kavadist module
 type Params struct {
-	Active  bool    `json:"active" yaml:"active"`
-	Periods Periods `json:"periods" yaml:"periods"`
+	Active    bool               `json:"active" yaml:"active"`
+	Schedules InflationSchedules `json:"schedules" yaml:"schedules"`
 }
 
-// Period stores the specified start and end dates, and the inflation, expressed as a decimal representing the yearly APR of KAVA tokens that will be minted during that period
+// Period stores the specified start and end dates, and the inflation, expressed as a decimal representing the yearly APR of the inflation schedule's tokens that will be minted during that period
 type Period struct {
 	Start     time.Time `json:"start" yaml:"start"`         // example "2020-03-01T15:20:00Z"
 	End       time.Time `json:"end" yaml:"end"`             // example "2020-06-01T15:20:00Z"
@@ -64,24 +66,130 @@ func (prs Periods) String() string {
 	return out
 }
 
+// InflationRecipient is a module account that receives a fraction of the coins minted by an inflation schedule
+type InflationRecipient struct {
+	ModuleAccount string  `json:"module_account" yaml:"module_account"`
+	Weight        sdk.Dec `json:"weight" yaml:"weight"` // example "0.25" - 25% of the minted coins
+}
+
+// NewInflationRecipient returns a new instance of InflationRecipient
+func NewInflationRecipient(moduleAccount string, weight sdk.Dec) InflationRecipient {
+	return InflationRecipient{
+		ModuleAccount: moduleAccount,
+		Weight:        weight,
+	}
+}
+
+// String implements fmt.Stringer
+func (ir InflationRecipient) String() string {
+	return fmt.Sprintf(`Recipient:
+	Module Account: %s
+	Weight: %s`, ir.ModuleAccount, ir.Weight)
+}
+
+// Validate performs a basic check of an InflationRecipient's fields
+func (ir InflationRecipient) Validate() error {
+	if strings.TrimSpace(ir.ModuleAccount) == "" {
+		return fmt.Errorf("recipient module account cannot be blank: %s", ir)
+	}
+	if ir.Weight.IsNil() || !ir.Weight.IsPositive() || ir.Weight.GT(sdk.OneDec()) {
+		return fmt.Errorf("recipient weight must be greater than 0 and at most 1: %s", ir)
+	}
+	return nil
+}
+
+// InflationRecipients array of InflationRecipient
+type InflationRecipients []InflationRecipient
+
+// Validate checks that the recipients are unique and that their weights sum to at most 1
+func (irs InflationRecipients) Validate() error {
+	seen := make(map[string]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, ir := range irs {
+		if err := ir.Validate(); err != nil {
+			return err
+		}
+		if seen[ir.ModuleAccount] {
+			return fmt.Errorf("duplicate recipient module account: %s", ir.ModuleAccount)
+		}
+		seen[ir.ModuleAccount] = true
+		totalWeight = totalWeight.Add(ir.Weight)
+	}
+	if totalWeight.GT(sdk.OneDec()) {
+		return fmt.Errorf("recipient weights sum to more than 1: %s", totalWeight)
+	}
+	return nil
+}
+
+// InflationSchedule mints a denom according to a list of periods and splits the minted coins between recipient module accounts.
+// Any coins not sent to a recipient remain in the kavadist module account.
+type InflationSchedule struct {
+	Denom      string              `json:"denom" yaml:"denom"`
+	Periods    Periods             `json:"periods" yaml:"periods"`
+	Recipients InflationRecipients `json:"recipients" yaml:"recipients"`
+}
+
+// NewInflationSchedule returns a new instance of InflationSchedule
+func NewInflationSchedule(denom string, periods Periods, recipients InflationRecipients) InflationSchedule {
+	return InflationSchedule{
+		Denom:      denom,
+		Periods:    periods,
+		Recipients: recipients,
+	}
+}
+
+// String implements fmt.Stringer
+func (is InflationSchedule) String() string {
+	out := fmt.Sprintf(`Inflation Schedule:
+	Denom: %s
+	%s`, is.Denom, is.Periods)
+	for _, ir := range is.Recipients {
+		out += fmt.Sprintf("%s\n", ir)
+	}
+	return out
+}
+
+// Validate performs a basic check of an InflationSchedule's fields
+func (is InflationSchedule) Validate() error {
+	if err := sdk.ValidateDenom(is.Denom); err != nil {
+		return fmt.Errorf("invalid inflation schedule denom: %w", err)
+	}
+	if err := validatePeriods(is.Periods); err != nil {
+		return err
+	}
+	return is.Recipients.Validate()
+}
+
+// InflationSchedules array of InflationSchedule
+type InflationSchedules []InflationSchedule
+
+// String implements fmt.Stringer
+func (iss InflationSchedules) String() string {
+	out := "Inflation Schedules\n"
+	for _, is := range iss {
+		out += fmt.Sprintf("%s\n", is)
+	}
+	return out
+}
+
 // NewParams returns a new params object
-func NewParams(active bool, periods Periods) Params {
+func NewParams(active bool, schedules InflationSchedules) Params {
 	return Params{
-		Active:  active,
-		Periods: periods,
+		Active:    active,
+		Schedules: schedules,
 	}
 }
 
 // DefaultParams returns default params for kavadist module
 func DefaultParams() Params {
-	return NewParams(DefaultActive, DefaultPeriods)
+	return NewParams(DefaultActive, DefaultSchedules)
 }
 
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	Active: %t
-	Periods %s`, p.Active, p.Periods)
+	Schedules %s`, p.Active, p.Schedules)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -93,7 +201,7 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyActive, &p.Active, validateActiveParam),
-		params.NewParamSetPair(KeyPeriods, &p.Periods, validatePeriodsParams),
+		params.NewParamSetPair(KeySchedules, &p.Schedules, validateSchedulesParams),
 	}
 }
 
@@ -103,7 +211,7 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validatePeriodsParams(p.Periods)
+	return validateSchedulesParams(p.Schedules)
 }
 
 func validateActiveParam(i interface{}) error {
@@ -115,12 +223,27 @@ func validateActiveParam(i interface{}) error {
 	return nil
 }
 
-func validatePeriodsParams(i interface{}) error {
-	periods, ok := i.(Periods)
+func validateSchedulesParams(i interface{}) error {
+	schedules, ok := i.(InflationSchedules)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	seenDenoms := make(map[string]bool)
+	for _, is := range schedules {
+		if err := is.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[is.Denom] {
+			return fmt.Errorf("duplicate inflation schedule denom: %s", is.Denom)
+		}
+		seenDenoms[is.Denom] = true
+	}
+
+	return nil
+}
+
+func validatePeriods(periods Periods) error {
 	prevEnd := tmtime.Canonical(time.Unix(0, 0))
 	for _, pr := range periods {
 		if pr.End.Before(pr.Start) {
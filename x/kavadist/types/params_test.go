@@ -25,7 +25,7 @@ type ParamTestSuite struct {
 func (suite *ParamTestSuite) SetupTest() {
 	p1 := types.Params{
 		Active: true,
-		Periods: types.Periods{
+		Schedules: types.InflationSchedules{types.NewInflationSchedule(types.GovDenom, types.Periods{
 			types.Period{
 				Start:     time.Date(2020, time.March, 1, 1, 0, 0, 0, time.UTC),
 				End:       time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC),
@@ -36,11 +36,11 @@ func (suite *ParamTestSuite) SetupTest() {
 				End:       time.Date(2022, time.March, 1, 1, 0, 0, 0, time.UTC),
 				Inflation: sdk.MustNewDecFromStr("1.000000003022265980"),
 			},
-		},
+		}, nil)},
 	}
 	p2 := types.Params{
 		Active: true,
-		Periods: types.Periods{
+		Schedules: types.InflationSchedules{types.NewInflationSchedule(types.GovDenom, types.Periods{
 			types.Period{
 				Start:     time.Date(2022, time.March, 1, 1, 0, 0, 0, time.UTC),
 				End:       time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC),
@@ -51,11 +51,11 @@ func (suite *ParamTestSuite) SetupTest() {
 				End:       time.Date(2024, time.March, 1, 1, 0, 0, 0, time.UTC),
 				Inflation: sdk.MustNewDecFromStr("1.000000003022265980"),
 			},
-		},
+		}, nil)},
 	}
 	p3 := types.Params{
 		Active: true,
-		Periods: types.Periods{
+		Schedules: types.InflationSchedules{types.NewInflationSchedule(types.GovDenom, types.Periods{
 			types.Period{
 				Start:     time.Date(2020, time.March, 1, 1, 0, 0, 0, time.UTC),
 				End:       time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC),
@@ -66,9 +66,47 @@ func (suite *ParamTestSuite) SetupTest() {
 				End:       time.Date(2022, time.March, 1, 1, 0, 0, 0, time.UTC),
 				Inflation: sdk.MustNewDecFromStr("1.000000003022265980"),
 			},
-		},
+		}, nil)},
 	}
 
+	validPeriods := p1.Schedules[0].Periods
+	p4 := types.NewParams(true, types.InflationSchedules{
+		types.NewInflationSchedule(types.GovDenom, validPeriods, types.InflationRecipients{
+			types.NewInflationRecipient("hard", sdk.MustNewDecFromStr("0.5")),
+			types.NewInflationRecipient("swap", sdk.MustNewDecFromStr("0.5")),
+		}),
+		types.NewInflationSchedule("hard", validPeriods, nil),
+	})
+	// recipient weights sum to more than 1
+	p5 := types.NewParams(true, types.InflationSchedules{
+		types.NewInflationSchedule(types.GovDenom, validPeriods, types.InflationRecipients{
+			types.NewInflationRecipient("hard", sdk.MustNewDecFromStr("0.75")),
+			types.NewInflationRecipient("swap", sdk.MustNewDecFromStr("0.5")),
+		}),
+	})
+	// duplicate recipient
+	p6 := types.NewParams(true, types.InflationSchedules{
+		types.NewInflationSchedule(types.GovDenom, validPeriods, types.InflationRecipients{
+			types.NewInflationRecipient("hard", sdk.MustNewDecFromStr("0.25")),
+			types.NewInflationRecipient("hard", sdk.MustNewDecFromStr("0.25")),
+		}),
+	})
+	// zero recipient weight
+	p7 := types.NewParams(true, types.InflationSchedules{
+		types.NewInflationSchedule(types.GovDenom, validPeriods, types.InflationRecipients{
+			types.NewInflationRecipient("hard", sdk.ZeroDec()),
+		}),
+	})
+	// duplicate schedule denom
+	p8 := types.NewParams(true, types.InflationSchedules{
+		types.NewInflationSchedule(types.GovDenom, validPeriods, nil),
+		types.NewInflationSchedule(types.GovDenom, validPeriods, nil),
+	})
+	// invalid schedule denom
+	p9 := types.NewParams(true, types.InflationSchedules{
+		types.NewInflationSchedule("", validPeriods, nil),
+	})
+
 	suite.tests = []paramTest{
 		{
 			params:     p1,
@@ -82,6 +120,30 @@ func (suite *ParamTestSuite) SetupTest() {
 			params:     p3,
 			expectPass: false,
 		},
+		{
+			params:     p4,
+			expectPass: true,
+		},
+		{
+			params:     p5,
+			expectPass: false,
+		},
+		{
+			params:     p6,
+			expectPass: false,
+		},
+		{
+			params:     p7,
+			expectPass: false,
+		},
+		{
+			params:     p8,
+			expectPass: false,
+		},
+		{
+			params:     p9,
+			expectPass: false,
+		},
 	}
 }
 
@@ -3,22 +3,36 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	// ProposalTypeCommunityPoolMultiSpend defines the type for a CommunityPoolMultiSpendProposal
 	ProposalTypeCommunityPoolMultiSpend = "CommunityPoolMultiSpend"
+	// ProposalTypeCommunityPoolStreamSpend defines the type for a CommunityPoolStreamSpendProposal
+	ProposalTypeCommunityPoolStreamSpend = "CommunityPoolStreamSpend"
+	// ProposalTypeCommunityPoolStreamCancel defines the type for a CommunityPoolStreamCancelProposal
+	ProposalTypeCommunityPoolStreamCancel = "CommunityPoolStreamCancel"
 )
 
-// Assert CommunityPoolMultiSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolMultiSpendProposal{}
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolMultiSpendProposal{}
+	_ govtypes.Content = CommunityPoolStreamSpendProposal{}
+	_ govtypes.Content = CommunityPoolStreamCancelProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolMultiSpend)
 	govtypes.RegisterProposalTypeCodec(CommunityPoolMultiSpendProposal{}, "kava/CommunityPoolMultiSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStreamSpend)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolStreamSpendProposal{}, "kava/CommunityPoolStreamSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStreamCancel)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolStreamCancelProposal{}, "kava/CommunityPoolStreamCancelProposal")
 }
 
 // CommunityPoolMultiSpendProposal spends from the community pool by sending to one or more addresses
@@ -73,6 +87,128 @@ func (csp CommunityPoolMultiSpendProposal) String() string {
 	return b.String()
 }
 
+// CommunityPoolStreamSpendProposal spends from the kavadist module account by opening a spend stream to each recipient.
+// Streams start when the proposal passes and release coins linearly over the duration, with nothing released before the cliff.
+type CommunityPoolStreamSpendProposal struct {
+	Title         string               `json:"title" yaml:"title"`
+	Description   string               `json:"description" yaml:"description"`
+	RecipientList MultiSpendRecipients `json:"recipient_list" yaml:"recipient_list"`
+	CliffDuration time.Duration        `json:"cliff_duration" yaml:"cliff_duration"`
+	Duration      time.Duration        `json:"duration" yaml:"duration"`
+}
+
+// NewCommunityPoolStreamSpendProposal creates a new community pool stream spend proposal.
+func NewCommunityPoolStreamSpendProposal(title, description string, recipientList MultiSpendRecipients, cliffDuration, duration time.Duration) CommunityPoolStreamSpendProposal {
+	return CommunityPoolStreamSpendProposal{
+		Title:         title,
+		Description:   description,
+		RecipientList: recipientList,
+		CliffDuration: cliffDuration,
+		Duration:      duration,
+	}
+}
+
+// GetTitle returns the title of a community pool stream spend proposal.
+func (sp CommunityPoolStreamSpendProposal) GetTitle() string { return sp.Title }
+
+// GetDescription returns the description of a community pool stream spend proposal.
+func (sp CommunityPoolStreamSpendProposal) GetDescription() string { return sp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream spend proposal.
+func (sp CommunityPoolStreamSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream spend proposal.
+func (sp CommunityPoolStreamSpendProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolStreamSpend
+}
+
+// ValidateBasic stateless validation of a community pool stream spend proposal.
+func (sp CommunityPoolStreamSpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+	if len(sp.RecipientList) == 0 {
+		return sdkerrors.Wrap(ErrEmptyProposalRecipient, "recipient list cannot be empty")
+	}
+	if err := sp.RecipientList.Validate(); err != nil {
+		return err
+	}
+	for _, msr := range sp.RecipientList {
+		if msr.Amount.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidProposalAmount, "stream amount for %s cannot be empty", msr.Address)
+		}
+	}
+	if sp.Duration <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidStreamSchedule, "duration must be positive: %s", sp.Duration)
+	}
+	if sp.CliffDuration < 0 || sp.CliffDuration > sp.Duration {
+		return sdkerrors.Wrapf(ErrInvalidStreamSchedule, "cliff duration must be between 0 and the duration: %s", sp.CliffDuration)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sp CommunityPoolStreamSpendProposal) String() string {
+	return fmt.Sprintf(`Community Pool Stream Spend Proposal:
+  Title:            %s
+  Description:      %s
+  Cliff Duration:   %s
+  Duration:         %s
+  Recipient List:   %s
+`, sp.Title, sp.Description, sp.CliffDuration, sp.Duration, sp.RecipientList)
+}
+
+// CommunityPoolStreamCancelProposal cancels a spend stream. Coins released before the cancellation are paid to the recipient
+// and the rest are returned to the kavadist module account.
+type CommunityPoolStreamCancelProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	StreamID    uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewCommunityPoolStreamCancelProposal creates a new community pool stream cancel proposal.
+func NewCommunityPoolStreamCancelProposal(title, description string, streamID uint64) CommunityPoolStreamCancelProposal {
+	return CommunityPoolStreamCancelProposal{
+		Title:       title,
+		Description: description,
+		StreamID:    streamID,
+	}
+}
+
+// GetTitle returns the title of a community pool stream cancel proposal.
+func (cp CommunityPoolStreamCancelProposal) GetTitle() string { return cp.Title }
+
+// GetDescription returns the description of a community pool stream cancel proposal.
+func (cp CommunityPoolStreamCancelProposal) GetDescription() string { return cp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream cancel proposal.
+func (cp CommunityPoolStreamCancelProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream cancel proposal.
+func (cp CommunityPoolStreamCancelProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolStreamCancel
+}
+
+// ValidateBasic stateless validation of a community pool stream cancel proposal.
+func (cp CommunityPoolStreamCancelProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cp); err != nil {
+		return err
+	}
+	if cp.StreamID == 0 {
+		return sdkerrors.Wrap(ErrStreamNotFound, "stream id cannot be 0")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (cp CommunityPoolStreamCancelProposal) String() string {
+	return fmt.Sprintf(`Community Pool Stream Cancel Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, cp.Title, cp.Description, cp.StreamID)
+}
+
 // MultiSpendRecipient defines a recipient and the amount of coins they are receiving
 type MultiSpendRecipient struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
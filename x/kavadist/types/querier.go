@@ -1,7 +1,41 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Querier routes for the kavadist module
 const (
 	QueryGetParams  = "params"
 	QueryGetBalance = "balance"
+	QueryGetStream  = "stream"
+	QueryGetStreams = "streams"
 )
+
+// QueryStreamParams params for query /kavadist/stream
+type QueryStreamParams struct {
+	StreamID uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewQueryStreamParams creates a new QueryStreamParams
+func NewQueryStreamParams(streamID uint64) QueryStreamParams {
+	return QueryStreamParams{
+		StreamID: streamID,
+	}
+}
+
+// QueryStreamsParams params for query /kavadist/streams
+type QueryStreamsParams struct {
+	Page      int            `json:"page" yaml:"page"`
+	Limit     int            `json:"limit" yaml:"limit"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// NewQueryStreamsParams creates a new QueryStreamsParams. An empty recipient matches all streams.
+func NewQueryStreamsParams(page, limit int, recipient sdk.AccAddress) QueryStreamsParams {
+	return QueryStreamsParams{
+		Page:      page,
+		Limit:     limit,
+		Recipient: recipient,
+	}
+}
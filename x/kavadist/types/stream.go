@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SpendStream releases coins escrowed from the kavadist module account to a recipient linearly between its start and end times.
+// Nothing is released before the cliff time, when all coins released linearly since the start time become claimable at once.
+type SpendStream struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	Claimed   sdk.Coins      `json:"claimed" yaml:"claimed"`
+	StartTime time.Time      `json:"start_time" yaml:"start_time"`
+	CliffTime time.Time      `json:"cliff_time" yaml:"cliff_time"`
+	EndTime   time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewSpendStream returns a new instance of SpendStream with nothing claimed
+func NewSpendStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins, startTime, cliffTime, endTime time.Time) SpendStream {
+	return SpendStream{
+		ID:        id,
+		Recipient: recipient,
+		Amount:    amount,
+		Claimed:   sdk.NewCoins(),
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+	}
+}
+
+// GetVestedAmount returns the coins released by the stream as of the block time, including those already claimed
+func (s SpendStream) GetVestedAmount(blockTime time.Time) sdk.Coins {
+	if blockTime.Before(s.CliffTime) {
+		return sdk.NewCoins()
+	}
+	if !blockTime.Before(s.EndTime) {
+		return s.Amount
+	}
+	elapsed := sdk.NewDec(int64(blockTime.Sub(s.StartTime)))
+	duration := sdk.NewDec(int64(s.EndTime.Sub(s.StartTime)))
+	var vested []sdk.Coin
+	for _, coin := range s.Amount {
+		vested = append(vested, sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(elapsed).Quo(duration).TruncateInt()))
+	}
+	return sdk.NewCoins(vested...)
+}
+
+// GetClaimableAmount returns the coins released by the stream as of the block time that haven't been claimed
+func (s SpendStream) GetClaimableAmount(blockTime time.Time) sdk.Coins {
+	return s.GetVestedAmount(blockTime).Sub(s.Claimed)
+}
+
+// Validate performs a basic check of a SpendStream's fields
+func (s SpendStream) Validate() error {
+	if s.ID == 0 {
+		return fmt.Errorf("stream id cannot be 0")
+	}
+	if s.Recipient.Empty() {
+		return fmt.Errorf("stream %d recipient cannot be empty", s.ID)
+	}
+	if s.Amount.Empty() || !s.Amount.IsValid() {
+		return fmt.Errorf("invalid stream %d amount: %s", s.ID, s.Amount)
+	}
+	if !s.Claimed.IsValid() || !s.Claimed.IsAllLTE(s.Amount) {
+		return fmt.Errorf("invalid stream %d claimed amount: %s", s.ID, s.Claimed)
+	}
+	if s.CliffTime.Before(s.StartTime) || s.EndTime.Before(s.CliffTime) || !s.EndTime.After(s.StartTime) {
+		return fmt.Errorf("stream %d times must satisfy start <= cliff <= end and start < end", s.ID)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (s SpendStream) String() string {
+	return fmt.Sprintf(`Spend Stream %d:
+	Recipient: %s
+	Amount: %s
+	Claimed: %s
+	Start Time: %s
+	Cliff Time: %s
+	End Time: %s`, s.ID, s.Recipient, s.Amount, s.Claimed, s.StartTime, s.CliffTime, s.EndTime)
+}
+
+// SpendStreams array of SpendStream
+type SpendStreams []SpendStream
+
+// Validate checks that each stream is valid and that stream IDs are unique
+func (ss SpendStreams) Validate() error {
+	ids := make(map[uint64]bool)
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if ids[s.ID] {
+			return fmt.Errorf("duplicate stream id: %d", s.ID)
+		}
+		ids[s.ID] = true
+	}
+	return nil
+}
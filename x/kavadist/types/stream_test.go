@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+func TestSpendStreamVestedAmount(t *testing.T) {
+	start := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000), sdk.NewInt64Coin("hard", 10))
+	stream := types.NewSpendStream(1, sdk.AccAddress("recipient"), amount, start, start.Add(25*time.Hour), start.Add(100*time.Hour))
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		expected  sdk.Coins
+	}{
+		{"before start", start.Add(-time.Hour), sdk.NewCoins()},
+		{"before cliff", start.Add(24 * time.Hour), sdk.NewCoins()},
+		{"at cliff", start.Add(25 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("ukava", 250), sdk.NewInt64Coin("hard", 2))},
+		{"after cliff", start.Add(50 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("ukava", 500), sdk.NewInt64Coin("hard", 5))},
+		{"at end", start.Add(100 * time.Hour), amount},
+		{"after end", start.Add(200 * time.Hour), amount},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, stream.GetVestedAmount(tc.blockTime))
+		})
+	}
+
+	stream.Claimed = sdk.NewCoins(sdk.NewInt64Coin("ukava", 250), sdk.NewInt64Coin("hard", 2))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 250), sdk.NewInt64Coin("hard", 3)), stream.GetClaimableAmount(start.Add(50*time.Hour)))
+}
+
+func TestSpendStreamsValidate(t *testing.T) {
+	start := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+	valid := types.NewSpendStream(1, sdk.AccAddress("recipient"), amount, start, start, start.Add(time.Hour))
+
+	testCases := []struct {
+		name       string
+		streams    func() types.SpendStreams
+		expectPass bool
+	}{
+		{"valid", func() types.SpendStreams { return types.SpendStreams{valid} }, true},
+		{"zero id", func() types.SpendStreams {
+			s := valid
+			s.ID = 0
+			return types.SpendStreams{s}
+		}, false},
+		{"empty recipient", func() types.SpendStreams {
+			s := valid
+			s.Recipient = nil
+			return types.SpendStreams{s}
+		}, false},
+		{"empty amount", func() types.SpendStreams {
+			s := valid
+			s.Amount = sdk.NewCoins()
+			return types.SpendStreams{s}
+		}, false},
+		{"claimed more than amount", func() types.SpendStreams {
+			s := valid
+			s.Claimed = sdk.NewCoins(sdk.NewInt64Coin("ukava", 1001))
+			return types.SpendStreams{s}
+		}, false},
+		{"cliff after end", func() types.SpendStreams {
+			s := valid
+			s.CliffTime = start.Add(2 * time.Hour)
+			return types.SpendStreams{s}
+		}, false},
+		{"end equals start", func() types.SpendStreams {
+			s := valid
+			s.EndTime = start
+			return types.SpendStreams{s}
+		}, false},
+		{"duplicate id", func() types.SpendStreams { return types.SpendStreams{valid, valid} }, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.streams().Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCommunityPoolStreamSpendProposalValidateBasic(t *testing.T) {
+	recipients := types.MultiSpendRecipients{
+		{Address: sdk.AccAddress("recipient"), Amount: sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))},
+	}
+
+	testCases := []struct {
+		name       string
+		proposal   types.CommunityPoolStreamSpendProposal
+		expectPass bool
+	}{
+		{"valid", types.NewCommunityPoolStreamSpendProposal("title", "description", recipients, time.Hour, 24*time.Hour), true},
+		{"no cliff", types.NewCommunityPoolStreamSpendProposal("title", "description", recipients, 0, 24*time.Hour), true},
+		{"no recipients", types.NewCommunityPoolStreamSpendProposal("title", "description", types.MultiSpendRecipients{}, 0, 24*time.Hour), false},
+		{"empty amount", types.NewCommunityPoolStreamSpendProposal("title", "description", types.MultiSpendRecipients{
+			{Address: sdk.AccAddress("recipient"), Amount: sdk.NewCoins()},
+		}, 0, 24*time.Hour), false},
+		{"zero duration", types.NewCommunityPoolStreamSpendProposal("title", "description", recipients, 0, 0), false},
+		{"cliff after end", types.NewCommunityPoolStreamSpendProposal("title", "description", recipients, 25*time.Hour, 24*time.Hour), false},
+		{"negative cliff", types.NewCommunityPoolStreamSpendProposal("title", "description", recipients, -time.Hour, 24*time.Hour), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -9,15 +9,36 @@ import (
 
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
+	params := k.GetParams(ctx)
+	markets := params.Markets
+	twapWindows := markets.TWAPSourceWindows()
+
+	// Update the current price of each asset. Markets are ordered so that source markets are updated before
+	// the markets derived from them.
+	for _, market := range markets {
 		if !market.Active {
 			continue
 		}
 
-		err := k.SetCurrentPrices(ctx, market.MarketID)
+		var err error
+		if market.IsDerived() {
+			err = k.SetDerivedPrice(ctx, market.MarketID)
+		} else {
+			err = k.SetCurrentPrices(ctx, market.MarketID)
+		}
 		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
+
+		// Record the market's price history, keeping at least the history needed by the markets averaging it.
+		k.UpdatePriceHistory(ctx, market.MarketID, params.PriceHistoryLength, twapWindows[market.MarketID])
+	}
+
+	// Remove price commitments whose reveal period has passed.
+	k.DeleteExpiredPriceCommitments(ctx)
+
+	// Record oracle performance once the current performance window has elapsed.
+	if err := k.UpdateOraclePerformances(ctx); err != nil {
+		panic(err)
 	}
 }
@@ -8,71 +8,169 @@ import (
 )
 
 const (
+	AttributeAmount             = types.AttributeAmount
+	AttributeCommitment         = types.AttributeCommitment
+	AttributeCommitPeriod       = types.AttributeCommitPeriod
 	AttributeExpiry             = types.AttributeExpiry
 	AttributeMarketID           = types.AttributeMarketID
 	AttributeMarketPrice        = types.AttributeMarketPrice
+	AttributeMissedWindows      = types.AttributeMissedWindows
 	AttributeOracle             = types.AttributeOracle
+	AttributeOracleRemoved      = types.AttributeOracleRemoved
+	AttributeOutlierCount       = types.AttributeOutlierCount
+	AttributeSlashedAmount      = types.AttributeSlashedAmount
 	AttributeValueCategory      = types.AttributeValueCategory
+	CommitmentHashLength        = types.CommitmentHashLength
 	DefaultParamspace           = types.DefaultParamspace
+	DerivationTypeInverse       = types.DerivationTypeInverse
+	DerivationTypeProduct       = types.DerivationTypeProduct
+	DerivationTypeTWAP          = types.DerivationTypeTWAP
 	EventTypeMarketPriceUpdated = types.EventTypeMarketPriceUpdated
 	EventTypeNoValidPrices      = types.EventTypeNoValidPrices
+	EventTypeOracleBond         = types.EventTypeOracleBond
+	EventTypeOraclePenalized    = types.EventTypeOraclePenalized
+	EventTypeOracleUnbond       = types.EventTypeOracleUnbond
 	EventTypeOracleUpdatedPrice = types.EventTypeOracleUpdatedPrice
+	EventTypePriceCommitted     = types.EventTypePriceCommitted
+	EventTypePriceRevealed      = types.EventTypePriceRevealed
 	MaxExpiry                   = types.MaxExpiry
+	MaxSaltLength               = types.MaxSaltLength
+	ModuleAccountName           = types.ModuleAccountName
 	ModuleName                  = types.ModuleName
 	QuerierRoute                = types.QuerierRoute
 	QueryGetParams              = types.QueryGetParams
 	QueryMarkets                = types.QueryMarkets
+	QueryOracleBond             = types.QueryOracleBond
+	QueryOraclePerformance      = types.QueryOraclePerformance
 	QueryOracles                = types.QueryOracles
 	QueryPrice                  = types.QueryPrice
+	QueryPriceAtHeight          = types.QueryPriceAtHeight
+	QueryPriceCommitments       = types.QueryPriceCommitments
+	QueryPriceHistory           = types.QueryPriceHistory
+	QueryPriceOHLC              = types.QueryPriceOHLC
+	QueryPriceTWAP              = types.QueryPriceTWAP
 	QueryRawPrices              = types.QueryRawPrices
 	RouterKey                   = types.RouterKey
 	StoreKey                    = types.StoreKey
+	TypeMsgCommitPrice          = types.TypeMsgCommitPrice
+	TypeMsgDepositOracleBond    = types.TypeMsgDepositOracleBond
 	TypeMsgPostPrice            = types.TypeMsgPostPrice
+	TypeMsgRevealPrice          = types.TypeMsgRevealPrice
+	TypeMsgWithdrawOracleBond   = types.TypeMsgWithdrawOracleBond
 )
 
 var (
 	// function aliases
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	CurrentPriceKey            = types.CurrentPriceKey
-	DefaultGenesisState        = types.DefaultGenesisState
-	DefaultParams              = types.DefaultParams
-	NewCurrentPrice            = types.NewCurrentPrice
-	NewGenesisState            = types.NewGenesisState
-	NewMarket                  = types.NewMarket
-	NewMsgPostPrice            = types.NewMsgPostPrice
-	NewParams                  = types.NewParams
-	NewPostedPrice             = types.NewPostedPrice
-	NewQueryWithMarketIDParams = types.NewQueryWithMarketIDParams
-	ParamKeyTable              = types.ParamKeyTable
-	RawPriceKey                = types.RawPriceKey
-	RegisterCodec              = types.RegisterCodec
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	CalculatePriceCommitment        = types.CalculatePriceCommitment
+	CommitPeriodAt                  = types.CommitPeriodAt
+	CurrentPriceKey                 = types.CurrentPriceKey
+	DefaultGenesisState             = types.DefaultGenesisState
+	DefaultParams                   = types.DefaultParams
+	NewCurrentPrice                 = types.NewCurrentPrice
+	NewGenesisState                 = types.NewGenesisState
+	NewMarket                       = types.NewMarket
+	NewMarketDerivation             = types.NewMarketDerivation
+	NewMsgCommitPrice               = types.NewMsgCommitPrice
+	NewMsgDepositOracleBond         = types.NewMsgDepositOracleBond
+	NewMsgPostPrice                 = types.NewMsgPostPrice
+	NewMsgRevealPrice               = types.NewMsgRevealPrice
+	NewMsgWithdrawOracleBond        = types.NewMsgWithdrawOracleBond
+	NewOracleBond                   = types.NewOracleBond
+	NewOraclePerformance            = types.NewOraclePerformance
+	NewParams                       = types.NewParams
+	NewPerformanceParams            = types.NewPerformanceParams
+	NewPostedPrice                  = types.NewPostedPrice
+	NewPriceCommitment              = types.NewPriceCommitment
+	NewPriceOHLC                    = types.NewPriceOHLC
+	NewPriceSnapshot                = types.NewPriceSnapshot
+	NewPriceTWAP                    = types.NewPriceTWAP
+	NewQueryOracleBondParams        = types.NewQueryOracleBondParams
+	NewQueryOraclePerformanceParams = types.NewQueryOraclePerformanceParams
+	NewQueryPriceAtHeightParams     = types.NewQueryPriceAtHeightParams
+	NewQueryPriceHistoryParams      = types.NewQueryPriceHistoryParams
+	NewQueryPriceRangeParams        = types.NewQueryPriceRangeParams
+	NewQueryWithMarketIDParams      = types.NewQueryWithMarketIDParams
+	OracleBondKey                   = types.OracleBondKey
+	OraclePerformanceKey            = types.OraclePerformanceKey
+	OraclePerformanceMarketKey      = types.OraclePerformanceMarketKey
+	ParamKeyTable                   = types.ParamKeyTable
+	PriceCommitmentKey              = types.PriceCommitmentKey
+	PriceHistoryMarketKey           = types.PriceHistoryMarketKey
+	PriceSnapshotKey                = types.PriceSnapshotKey
+	RawPriceKey                     = types.RawPriceKey
+	RegisterCodec                   = types.RegisterCodec
 
 	// variable aliases
-	CurrentPricePrefix = types.CurrentPricePrefix
-	DefaultMarkets     = types.DefaultMarkets
-	ErrAssetNotFound   = types.ErrAssetNotFound
-	ErrEmptyInput      = types.ErrEmptyInput
-	ErrExpired         = types.ErrExpired
-	ErrInvalidMarket   = types.ErrInvalidMarket
-	ErrInvalidOracle   = types.ErrInvalidOracle
-	ErrNoValidPrice    = types.ErrNoValidPrice
-	KeyMarkets         = types.KeyMarkets
-	ModuleCdc          = types.ModuleCdc
-	RawPriceFeedPrefix = types.RawPriceFeedPrefix
+	CurrentPricePrefix        = types.CurrentPricePrefix
+	DefaultMarkets            = types.DefaultMarkets
+	DefaultMaxDeviation       = types.DefaultMaxDeviation
+	DefaultMinOracleBond      = types.DefaultMinOracleBond
+	DefaultMissedWindowLimit  = types.DefaultMissedWindowLimit
+	DefaultOutlierWindowLimit = types.DefaultOutlierWindowLimit
+	DefaultPerformanceWindow  = types.DefaultPerformanceWindow
+	DefaultPriceHistoryLength = types.DefaultPriceHistoryLength
+	DefaultSlashFraction      = types.DefaultSlashFraction
+	ErrAssetNotFound          = types.ErrAssetNotFound
+	ErrBondNotFound           = types.ErrBondNotFound
+	ErrCommitmentNotFound     = types.ErrCommitmentNotFound
+	ErrCommitRevealNotEnabled = types.ErrCommitRevealNotEnabled
+	ErrCommitRevealRequired   = types.ErrCommitRevealRequired
+	ErrEmptyInput             = types.ErrEmptyInput
+	ErrExpired                = types.ErrExpired
+	ErrInsufficientBond       = types.ErrInsufficientBond
+	ErrInvalidMarket          = types.ErrInvalidMarket
+	ErrInvalidOracle          = types.ErrInvalidOracle
+	ErrInvalidReveal          = types.ErrInvalidReveal
+	ErrInvalidRevealPeriod    = types.ErrInvalidRevealPeriod
+	ErrNotDerivedMarket       = types.ErrNotDerivedMarket
+	ErrNoValidPrice           = types.ErrNoValidPrice
+	ErrPriceHistoryNotFound   = types.ErrPriceHistoryNotFound
+	KeyMarkets                = types.KeyMarkets
+	KeyPerformanceParams      = types.KeyPerformanceParams
+	KeyPriceHistoryLength     = types.KeyPriceHistoryLength
+	ModuleCdc                 = types.ModuleCdc
+	OracleBondPrefix          = types.OracleBondPrefix
+	OraclePerformancePrefix   = types.OraclePerformancePrefix
+	PerformanceWindowStartKey = types.PerformanceWindowStartKey
+	PriceCommitmentPrefix     = types.PriceCommitmentPrefix
+	PriceHistoryPrefix        = types.PriceHistoryPrefix
+	RawPriceFeedPrefix        = types.RawPriceFeedPrefix
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	CurrentPrice            = types.CurrentPrice
-	CurrentPrices           = types.CurrentPrices
-	GenesisState            = types.GenesisState
-	Market                  = types.Market
-	Markets                 = types.Markets
-	MsgPostPrice            = types.MsgPostPrice
-	Params                  = types.Params
-	PostedPrice             = types.PostedPrice
-	PostedPrices            = types.PostedPrices
-	QueryWithMarketIDParams = types.QueryWithMarketIDParams
-	SortDecs                = types.SortDecs
+	Keeper                       = keeper.Keeper
+	CurrentPrice                 = types.CurrentPrice
+	CurrentPrices                = types.CurrentPrices
+	GenesisState                 = types.GenesisState
+	Market                       = types.Market
+	MarketDerivation             = types.MarketDerivation
+	Markets                      = types.Markets
+	MsgCommitPrice               = types.MsgCommitPrice
+	MsgDepositOracleBond         = types.MsgDepositOracleBond
+	MsgPostPrice                 = types.MsgPostPrice
+	MsgRevealPrice               = types.MsgRevealPrice
+	MsgWithdrawOracleBond        = types.MsgWithdrawOracleBond
+	OracleBond                   = types.OracleBond
+	OracleBonds                  = types.OracleBonds
+	OraclePerformance            = types.OraclePerformance
+	OraclePerformances           = types.OraclePerformances
+	Params                       = types.Params
+	PerformanceParams            = types.PerformanceParams
+	PostedPrice                  = types.PostedPrice
+	PostedPrices                 = types.PostedPrices
+	PriceCommitment              = types.PriceCommitment
+	PriceCommitments             = types.PriceCommitments
+	PriceOHLC                    = types.PriceOHLC
+	PriceSnapshot                = types.PriceSnapshot
+	PriceSnapshots               = types.PriceSnapshots
+	PriceTWAP                    = types.PriceTWAP
+	QueryOracleBondParams        = types.QueryOracleBondParams
+	QueryOraclePerformanceParams = types.QueryOraclePerformanceParams
+	QueryPriceAtHeightParams     = types.QueryPriceAtHeightParams
+	QueryPriceHistoryParams      = types.QueryPriceHistoryParams
+	QueryPriceRangeParams        = types.QueryPriceRangeParams
+	QueryWithMarketIDParams      = types.QueryWithMarketIDParams
+	SortDecs                     = types.SortDecs
 )
@@ -2,18 +2,28 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+// Query flags
+const (
+	flagMarketID = "market-id"
+	flagOracle   = "oracle"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -32,6 +42,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdOracles(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdOraclePerformance(queryRoute, cdc),
+		GetCmdOracleBond(queryRoute, cdc),
+		GetCmdPriceCommitments(queryRoute, cdc),
+		GetCmdPriceHistory(queryRoute, cdc),
+		GetCmdPriceAtHeight(queryRoute, cdc),
+		GetCmdPriceOHLC(queryRoute, cdc),
+		GetCmdPriceTWAP(queryRoute, cdc),
 	)...)
 
 	return pricefeedQueryCmd
@@ -193,3 +210,229 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdOraclePerformance queries the performance records of oracles
+func GetCmdOraclePerformance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-performance",
+		Short: "get oracle performance records, optionally filtered by market and oracle",
+		Example: fmt.Sprintf("%s query %s oracle-performance --market-id bnb:usd --oracle kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj",
+			version.ClientName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var oracle sdk.AccAddress
+			if oracleStr := viper.GetString(flagOracle); oracleStr != "" {
+				var err error
+				oracle, err = sdk.AccAddressFromBech32(oracleStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryOraclePerformanceParams(viper.GetString(flagMarketID), oracle))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOraclePerformance)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var performances types.OraclePerformances
+			cdc.MustUnmarshalJSON(res, &performances)
+			return cliCtx.PrintOutput(performances)
+		},
+	}
+	cmd.Flags().String(flagMarketID, "", "(optional) filter for performance records by market id")
+	cmd.Flags().String(flagOracle, "", "(optional) filter for performance records by oracle address")
+	return cmd
+}
+
+// GetCmdOracleBond queries the bond deposited by an oracle
+func GetCmdOracleBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-bond [oracle-addr]",
+		Short: "get the bond deposited by an oracle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			oracle, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryOracleBondParams(oracle))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleBond)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var bond types.OracleBond
+			cdc.MustUnmarshalJSON(res, &bond)
+			return cliCtx.PrintOutput(bond)
+		},
+	}
+}
+
+// GetCmdPriceCommitments queries the pending price commitments of a market
+func GetCmdPriceCommitments(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-commitments [marketID]",
+		Short: "get the pending price commitments for a commit-reveal market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryWithMarketIDParams(args[0]))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceCommitments)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var commitments types.PriceCommitments
+			cdc.MustUnmarshalJSON(res, &commitments)
+			return cliCtx.PrintOutput(commitments)
+		},
+	}
+}
+
+// GetCmdPriceHistory queries the recorded prices of a market
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-history [marketID]",
+		Short:   "get the recorded median prices of a market",
+		Example: fmt.Sprintf("%s query %s price-history bnb:usd --page 1 --limit 50", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+			bz, err := cdc.MarshalJSON(types.NewQueryPriceHistoryParams(args[0], page, limit))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceHistory)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var snapshots types.PriceSnapshots
+			cdc.MustUnmarshalJSON(res, &snapshots)
+			return cliCtx.PrintOutput(snapshots)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of prices to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of prices to query for")
+	return cmd
+}
+
+// GetCmdPriceAtHeight queries the price of a market at a block height
+func GetCmdPriceAtHeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "price-at-height [marketID] [height]",
+		Short:   "get the median price of a market at the end of a block",
+		Example: fmt.Sprintf("%s query %s price-at-height bnb:usd 1000", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[1])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryPriceAtHeightParams(args[0], height))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceAtHeight)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var snapshot types.PriceSnapshot
+			cdc.MustUnmarshalJSON(res, &snapshot)
+			return cliCtx.PrintOutput(snapshot)
+		},
+	}
+}
+
+// GetCmdPriceOHLC queries the open, high, low and close prices of a market over a time range
+func GetCmdPriceOHLC(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "ohlc [marketID] [start-time] [end-time]",
+		Short:   "get the open, high, low and close prices of a market between two RFC3339 times",
+		Example: fmt.Sprintf("%s query %s ohlc bnb:usd 2021-01-01T00:00:00Z 2021-01-02T00:00:00Z", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := priceRangeParams(cdc, args)
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceOHLC)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var ohlc types.PriceOHLC
+			cdc.MustUnmarshalJSON(res, &ohlc)
+			return cliCtx.PrintOutput(ohlc)
+		},
+	}
+}
+
+// GetCmdPriceTWAP queries the time-weighted average price of a market over a time range
+func GetCmdPriceTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [start-time] [end-time]",
+		Short:   "get the time-weighted average price of a market between two RFC3339 times",
+		Example: fmt.Sprintf("%s query %s twap bnb:usd 2021-01-01T00:00:00Z 2021-01-01T00:30:00Z", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := priceRangeParams(cdc, args)
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceTWAP)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var twap types.PriceTWAP
+			cdc.MustUnmarshalJSON(res, &twap)
+			return cliCtx.PrintOutput(twap)
+		},
+	}
+}
+
+// priceRangeParams parses [marketID] [start-time] [end-time] arguments into marshaled query params
+func priceRangeParams(cdc *codec.Codec, args []string) ([]byte, error) {
+	start, err := time.Parse(time.RFC3339, args[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time %s: %w", args[1], err)
+	}
+	end, err := time.Parse(time.RFC3339, args[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time %s: %w", args[2], err)
+	}
+	return cdc.MarshalJSON(types.NewQueryPriceRangeParams(args[0], start, end))
+}
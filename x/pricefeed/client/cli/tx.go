@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -34,6 +35,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdDepositOracleBond(cdc),
+		GetCmdWithdrawOracleBond(cdc),
+		GetCmdCommitPrice(cdc),
+		GetCmdRevealPrice(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -77,3 +82,130 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdDepositOracleBond cli command for depositing into an oracle bond.
+func GetCmdDepositOracleBond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-bond [amount]",
+		Short: "deposit coins into the sender's oracle bond",
+		Example: fmt.Sprintf("%s tx %s deposit-bond 1000000000ukava --from oracle",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositOracleBond(cliCtx.GetFromAddress(), amount)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawOracleBond cli command for withdrawing from an oracle bond.
+func GetCmdWithdrawOracleBond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-bond [amount]",
+		Short: "withdraw coins from the sender's oracle bond",
+		Example: fmt.Sprintf("%s tx %s withdraw-bond 1000000000ukava --from oracle",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawOracleBond(cliCtx.GetFromAddress(), amount)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCommitPrice cli command for committing to a price in a commit-reveal market.
+func GetCmdCommitPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-price [marketID] [price] [salt]",
+		Short: "commit to a price for a commit-reveal market without disclosing it",
+		Long: strings.TrimSpace(`Commit to a price for a market that uses commit-reveal price posting.
+The price and salt are hashed locally and only the hash is broadcast. The same price and salt must
+be revealed with the reveal-price command in the following period.`),
+		Example: fmt.Sprintf("%s tx %s commit-price bnb:usd 25 d5a2ff1c7ab9 --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			hash := types.CalculatePriceCommitment(args[0], cliCtx.GetFromAddress(), price, args[2])
+			msg := types.NewMsgCommitPrice(cliCtx.GetFromAddress(), args[0], hash)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevealPrice cli command for revealing a previously committed price.
+func GetCmdRevealPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-price [marketID] [price] [salt] [expiry]",
+		Short: "reveal a committed price with a given expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s reveal-price bnb:usd 25 d5a2ff1c7ab9 9999999999 --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			expiryInt, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiry %s: %w", args[3], err)
+			}
+
+			if expiryInt > types.MaxExpiry {
+				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			}
+
+			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+
+			msg := types.NewMsgRevealPrice(cliCtx.GetFromAddress(), args[0], price, args[2], expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -8,7 +8,11 @@ import (
 )
 
 const (
-	RestMarketID = "market_id"
+	RestMarketID  = "market_id"
+	RestOracle    = "oracle"
+	RestHeight    = "height"
+	RestStartTime = "start_time"
+	RestEndTime   = "end_time"
 )
 
 // PostPriceReq defines the properties of a PostPrice request's body.
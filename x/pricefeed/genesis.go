@@ -1,11 +1,16 @@
 package pricefeed
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // InitGenesis sets distribution information for genesis.
-func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper, gs GenesisState) {
 	// Set the markets and oracles from params
 	keeper.SetParams(ctx, gs.Params)
 
@@ -20,11 +25,44 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 	}
 	params := keeper.GetParams(ctx)
 
+	for _, performance := range gs.OraclePerformances {
+		keeper.SetOraclePerformance(ctx, performance)
+	}
+
+	for _, commitment := range gs.PriceCommitments {
+		keeper.SetPriceCommitment(ctx, commitment)
+	}
+
+	for _, snapshot := range gs.PriceHistory {
+		keeper.SetPriceSnapshot(ctx, snapshot)
+	}
+
+	// check if the module account exists and holds the oracle bonds
+	moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleAccountName))
+	}
+	totalBonds := sdk.NewCoins()
+	for _, bond := range gs.OracleBonds {
+		keeper.SetOracleBond(ctx, bond)
+		totalBonds = totalBonds.Add(bond.Amount...)
+	}
+	if !moduleAcc.GetCoins().IsAllGTE(totalBonds) {
+		panic(fmt.Sprintf("module account coins %s less than oracle bonds %s", moduleAcc.GetCoins(), totalBonds))
+	}
+
 	// Set the current price (if any) based on what's now in the store
 	for _, market := range params.Markets {
 		if !market.Active {
 			continue
 		}
+		if market.IsDerived() {
+			// twap markets have no price until their source price history has been recorded or imported
+			if err := keeper.SetDerivedPrice(ctx, market.MarketID); err != nil && !errors.Is(err, types.ErrNoValidPrice) {
+				panic(err)
+			}
+			continue
+		}
 		rps, err := keeper.GetRawPrices(ctx, market.MarketID)
 		if err != nil {
 			panic(err)
@@ -54,5 +92,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return NewGenesisState(params, postedPrices)
+	return NewGenesisState(
+		params, postedPrices, keeper.GetOraclePerformances(ctx), keeper.GetOracleBonds(ctx),
+		keeper.GetPriceCommitments(ctx), keeper.GetPriceHistory(ctx),
+	)
 }
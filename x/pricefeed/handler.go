@@ -12,6 +12,14 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgDepositOracleBond:
+			return handleMsgDepositOracleBond(ctx, k, msg)
+		case MsgWithdrawOracleBond:
+			return handleMsgWithdrawOracleBond(ctx, k, msg)
+		case MsgCommitPrice:
+			return handleMsgCommitPrice(ctx, k, msg)
+		case MsgRevealPrice:
+			return handleMsgRevealPrice(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -27,10 +35,14 @@ func HandleMsgPostPrice(
 	k Keeper,
 	msg MsgPostPrice) (*sdk.Result, error) {
 
-	_, err := k.GetOracle(ctx, msg.MarketID, msg.From)
+	err := validateOracle(ctx, k, msg.MarketID, msg.From)
 	if err != nil {
 		return nil, err
 	}
+	market, _ := k.GetMarket(ctx, msg.MarketID)
+	if market.UsesCommitReveal() {
+		return nil, sdkerrors.Wrap(ErrCommitRevealRequired, msg.MarketID)
+	}
 	_, err = k.SetPrice(ctx, msg.From, msg.MarketID, msg.Price, msg.Expiry)
 	if err != nil {
 		return nil, err
@@ -46,3 +58,91 @@ func HandleMsgPostPrice(
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgDepositOracleBond(ctx sdk.Context, k Keeper, msg MsgDepositOracleBond) (*sdk.Result, error) {
+	err := k.DepositOracleBond(ctx, msg.From, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgWithdrawOracleBond(ctx sdk.Context, k Keeper, msg MsgWithdrawOracleBond) (*sdk.Result, error) {
+	err := k.WithdrawOracleBond(ctx, msg.From, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCommitPrice(ctx sdk.Context, k Keeper, msg MsgCommitPrice) (*sdk.Result, error) {
+	err := validateOracle(ctx, k, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = k.CommitPrice(ctx, msg.From, msg.MarketID, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevealPrice(ctx sdk.Context, k Keeper, msg MsgRevealPrice) (*sdk.Result, error) {
+	err := validateOracle(ctx, k, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = k.RevealPrice(ctx, msg.From, msg.MarketID, msg.Price, msg.Salt, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// validateOracle checks that the sender is an oracle for the market and holds the minimum bond
+func validateOracle(ctx sdk.Context, k Keeper, marketID string, oracle sdk.AccAddress) error {
+	_, err := k.GetOracle(ctx, marketID, oracle)
+	if err != nil {
+		return err
+	}
+	if !k.HasSufficientBond(ctx, oracle) {
+		return sdkerrors.Wrap(ErrInsufficientBond, oracle.String())
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetOracleBond returns the bond deposited by an oracle
+func (k Keeper) GetOracleBond(ctx sdk.Context, oracle sdk.AccAddress) (types.OracleBond, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleBondKey(oracle))
+	if bz == nil {
+		return types.OracleBond{}, false
+	}
+	var bond types.OracleBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+	return bond, true
+}
+
+// SetOracleBond stores an oracle's bond, removing it if the bond is empty
+func (k Keeper) SetOracleBond(ctx sdk.Context, bond types.OracleBond) {
+	store := ctx.KVStore(k.key)
+	if bond.Amount.Empty() {
+		store.Delete(types.OracleBondKey(bond.OracleAddress))
+		return
+	}
+	store.Set(types.OracleBondKey(bond.OracleAddress), k.cdc.MustMarshalBinaryBare(bond))
+}
+
+// IterateOracleBonds iterates over all oracle bonds and performs a callback function
+func (k Keeper) IterateOracleBonds(ctx sdk.Context, cb func(bond types.OracleBond) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleBondPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.OracleBond
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetOracleBonds returns all oracle bonds from the store
+func (k Keeper) GetOracleBonds(ctx sdk.Context) types.OracleBonds {
+	bonds := types.OracleBonds{}
+	k.IterateOracleBonds(ctx, func(bond types.OracleBond) (stop bool) {
+		bonds = append(bonds, bond)
+		return false
+	})
+	return bonds
+}
+
+// DepositOracleBond transfers coins from an oracle to the module account and adds them to the oracle's bond
+func (k Keeper) DepositOracleBond(ctx sdk.Context, oracle sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, oracle, types.ModuleAccountName, amount); err != nil {
+		return err
+	}
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found {
+		bond = types.NewOracleBond(oracle, sdk.NewCoins())
+	}
+	bond.Amount = bond.Amount.Add(amount...)
+	k.SetOracleBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleBond,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawOracleBond returns coins from an oracle's bond. Oracles that are still part of a market's
+// oracle set cannot withdraw below the minimum bond.
+func (k Keeper) WithdrawOracleBond(ctx sdk.Context, oracle sdk.AccAddress, amount sdk.Coins) error {
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found {
+		return sdkerrors.Wrap(types.ErrBondNotFound, oracle.String())
+	}
+	remaining, isNegative := bond.Amount.SafeSub(amount)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "withdraw amount %s exceeds bond %s", amount, bond.Amount)
+	}
+	minBond := k.GetParams(ctx).PerformanceParams.MinOracleBond
+	if k.isOracle(ctx, oracle) && !remaining.IsAllGTE(minBond) {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "remaining bond %s is below minimum %s", remaining, minBond)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, oracle, amount); err != nil {
+		return err
+	}
+	bond.Amount = remaining
+	k.SetOracleBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleUnbond,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// SlashOracleBond burns a fraction of an oracle's bond and returns the amount burned
+func (k Keeper) SlashOracleBond(ctx sdk.Context, oracle sdk.AccAddress, fraction sdk.Dec) (sdk.Coins, error) {
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found || fraction.IsNil() || !fraction.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+	slashed := sdk.NewCoins()
+	for _, coin := range bond.Amount {
+		amount := coin.Amount.ToDec().Mul(fraction).TruncateInt()
+		if amount.IsPositive() {
+			slashed = slashed.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	if slashed.Empty() {
+		return slashed, nil
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleAccountName, slashed); err != nil {
+		return sdk.NewCoins(), err
+	}
+	bond.Amount = bond.Amount.Sub(slashed)
+	k.SetOracleBond(ctx, bond)
+	return slashed, nil
+}
+
+// HasSufficientBond returns true if the oracle's bond covers the minimum oracle bond
+func (k Keeper) HasSufficientBond(ctx sdk.Context, oracle sdk.AccAddress) bool {
+	minBond := k.GetParams(ctx).PerformanceParams.MinOracleBond
+	if minBond.Empty() {
+		return true
+	}
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found {
+		return false
+	}
+	return bond.Amount.IsAllGTE(minBond)
+}
+
+// isOracle returns true if the address is an oracle for any market
+func (k Keeper) isOracle(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, oracle := range k.GetAuthorizedAddresses(ctx) {
+		if oracle.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
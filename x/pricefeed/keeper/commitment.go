@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetPriceCommitment returns an oracle's price commitment for a market
+func (k Keeper) GetPriceCommitment(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.PriceCommitment, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceCommitmentKey(marketID, oracle))
+	if bz == nil {
+		return types.PriceCommitment{}, false
+	}
+	var commitment types.PriceCommitment
+	k.cdc.MustUnmarshalBinaryBare(bz, &commitment)
+	return commitment, true
+}
+
+// SetPriceCommitment stores an oracle's price commitment for a market
+func (k Keeper) SetPriceCommitment(ctx sdk.Context, commitment types.PriceCommitment) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceCommitmentKey(commitment.MarketID, commitment.OracleAddress), k.cdc.MustMarshalBinaryBare(commitment))
+}
+
+// DeletePriceCommitment removes an oracle's price commitment for a market
+func (k Keeper) DeletePriceCommitment(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.PriceCommitmentKey(marketID, oracle))
+}
+
+// IteratePriceCommitments iterates over all price commitments and performs a callback function
+func (k Keeper) IteratePriceCommitments(ctx sdk.Context, cb func(commitment types.PriceCommitment) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceCommitmentPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.PriceCommitment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commitment)
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
+// GetPriceCommitments returns all price commitments from the store
+func (k Keeper) GetPriceCommitments(ctx sdk.Context) types.PriceCommitments {
+	commitments := types.PriceCommitments{}
+	k.IteratePriceCommitments(ctx, func(commitment types.PriceCommitment) (stop bool) {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return commitments
+}
+
+// CommitPrice records an oracle's commitment to a price for a commit-reveal market in the current period,
+// replacing any earlier commitment by the oracle for that market.
+func (k Keeper) CommitPrice(ctx sdk.Context, oracle sdk.AccAddress, marketID string, hash tmbytes.HexBytes) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.UsesCommitReveal() {
+		return sdkerrors.Wrap(types.ErrCommitRevealNotEnabled, marketID)
+	}
+
+	period := types.CommitPeriodAt(ctx.BlockTime(), market.CommitRevealPeriod)
+	k.SetPriceCommitment(ctx, types.NewPriceCommitment(marketID, oracle, hash, period))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePriceCommitted,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeCommitment, hash.String()),
+			sdk.NewAttribute(types.AttributeCommitPeriod, fmt.Sprintf("%d", period)),
+		),
+	)
+	return nil
+}
+
+// RevealPrice checks a revealed price against the oracle's commitment from the previous period and,
+// if it matches, sets it as the oracle's posted price so it is included in the market's median.
+func (k Keeper) RevealPrice(ctx sdk.Context, oracle sdk.AccAddress, marketID string, price sdk.Dec, salt string, expiry time.Time) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.UsesCommitReveal() {
+		return sdkerrors.Wrap(types.ErrCommitRevealNotEnabled, marketID)
+	}
+	commitment, found := k.GetPriceCommitment(ctx, marketID, oracle)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCommitmentNotFound, "market %s, oracle %s", marketID, oracle)
+	}
+	period := types.CommitPeriodAt(ctx.BlockTime(), market.CommitRevealPeriod)
+	if period != commitment.Period+1 {
+		return sdkerrors.Wrapf(types.ErrInvalidRevealPeriod, "committed in period %d, current period %d", commitment.Period, period)
+	}
+	if !bytes.Equal(types.CalculatePriceCommitment(marketID, oracle, price, salt), commitment.Hash) {
+		return sdkerrors.Wrapf(types.ErrInvalidReveal, "market %s, oracle %s", marketID, oracle)
+	}
+
+	if _, err := k.SetPrice(ctx, oracle, marketID, price, expiry); err != nil {
+		return err
+	}
+	k.DeletePriceCommitment(ctx, marketID, oracle)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePriceRevealed,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+		),
+	)
+	return nil
+}
+
+// DeleteExpiredPriceCommitments removes commitments that can no longer be revealed,
+// including those for markets that have been removed or no longer use commit-reveal.
+func (k Keeper) DeleteExpiredPriceCommitments(ctx sdk.Context) {
+	var expired types.PriceCommitments
+	k.IteratePriceCommitments(ctx, func(commitment types.PriceCommitment) (stop bool) {
+		market, found := k.GetMarket(ctx, commitment.MarketID)
+		if !found || !market.UsesCommitReveal() ||
+			types.CommitPeriodAt(ctx.BlockTime(), market.CommitRevealPeriod) > commitment.Period+1 {
+			expired = append(expired, commitment)
+		}
+		return false
+	})
+	for _, commitment := range expired {
+		k.DeletePriceCommitment(ctx, commitment.MarketID, commitment.OracleAddress)
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_CommitRevealPrice tests committing to and revealing prices in a commit-reveal market
+func TestKeeper_CommitRevealPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	commitRevealMarket := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	commitRevealMarket.CommitRevealPeriod = time.Minute
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		commitRevealMarket,
+		types.NewMarket("tst2usd", "tst2", "usd", addrs, true),
+	}, types.DefaultParams().PerformanceParams, types.DefaultPriceHistoryLength))
+
+	price := sdk.MustNewDecFromStr("1.25")
+	expiry := startTime.Add(time.Hour)
+	hash := types.CalculatePriceCommitment("tstusd", addrs[0], price, "salt")
+
+	// markets without commit-reveal reject commitments
+	err := keeper.CommitPrice(ctx, addrs[0], "tst2usd", hash)
+	require.True(t, types.ErrCommitRevealNotEnabled.Is(err))
+
+	require.NoError(t, keeper.CommitPrice(ctx, addrs[0], "tstusd", hash))
+	// another oracle replaying the same commitment cannot reveal the original price
+	require.NoError(t, keeper.CommitPrice(ctx, addrs[1], "tstusd", hash))
+
+	// reveals are not accepted within the commit period
+	err = keeper.RevealPrice(ctx, addrs[0], "tstusd", price, "salt", expiry)
+	require.True(t, types.ErrInvalidRevealPeriod.Is(err))
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute))
+	err = keeper.RevealPrice(ctx, addrs[0], "tstusd", price, "wrong salt", expiry)
+	require.True(t, types.ErrInvalidReveal.Is(err))
+	err = keeper.RevealPrice(ctx, addrs[1], "tstusd", price, "salt", expiry)
+	require.True(t, types.ErrInvalidReveal.Is(err))
+
+	require.NoError(t, keeper.RevealPrice(ctx, addrs[0], "tstusd", price, "salt", expiry))
+	_, found := keeper.GetPriceCommitment(ctx, "tstusd", addrs[0])
+	require.False(t, found)
+	rawPrices, err := keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, types.PostedPrices{types.NewPostedPrice("tstusd", addrs[0], price, expiry)}, rawPrices)
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	currentPrice, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, price, currentPrice.Price)
+
+	// unrevealed commitments are removed once their reveal period has passed
+	ctx = ctx.WithBlockTime(startTime.Add(90 * time.Second))
+	keeper.DeleteExpiredPriceCommitments(ctx)
+	_, found = keeper.GetPriceCommitment(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Minute))
+	keeper.DeleteExpiredPriceCommitments(ctx)
+	_, found = keeper.GetPriceCommitment(ctx, "tstusd", addrs[1])
+	require.False(t, found)
+}
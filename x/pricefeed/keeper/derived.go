@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SetDerivedPrice updates the current price of a derived market from the current prices of its source markets
+func (k Keeper) SetDerivedPrice(ctx sdk.Context, marketID string) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.IsDerived() {
+		return sdkerrors.Wrap(types.ErrNotDerivedMarket, marketID)
+	}
+
+	price, err := k.calculateDerivedPrice(ctx, market)
+	if err != nil {
+		if errors.Is(err, types.ErrNoValidPrice) {
+			// zero out the current price so that callers of GetCurrentPrice do not use a stale derived price
+			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		}
+		return err
+	}
+	k.updateCurrentPrice(ctx, marketID, price)
+	return nil
+}
+
+// calculateDerivedPrice computes the price of a derived market, returning ErrNoValidPrice if any source market does not have a valid price
+func (k Keeper) calculateDerivedPrice(ctx sdk.Context, market types.Market) (sdk.Dec, error) {
+	switch market.Derivation.Type {
+	case types.DerivationTypeProduct:
+		product := sdk.OneDec()
+		for _, source := range market.Derivation.SourceMarkets {
+			sourcePrice, err := k.GetCurrentPrice(ctx, source)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			product = product.Mul(sourcePrice.Price)
+		}
+		if product.IsZero() {
+			return sdk.Dec{}, types.ErrNoValidPrice
+		}
+		return product, nil
+
+	case types.DerivationTypeInverse:
+		sourcePrice, err := k.GetCurrentPrice(ctx, market.Derivation.SourceMarkets[0])
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		inverse := sdk.OneDec().Quo(sourcePrice.Price)
+		if inverse.IsZero() {
+			return sdk.Dec{}, types.ErrNoValidPrice
+		}
+		return inverse, nil
+
+	case types.DerivationTypeTWAP:
+		source := market.Derivation.SourceMarkets[0]
+		// the average is only valid while the source market itself has a valid price
+		if _, err := k.GetCurrentPrice(ctx, source); err != nil {
+			return sdk.Dec{}, err
+		}
+		average, ok := k.GetPriceSnapshots(ctx, source).TimeWeightedAverage(ctx.BlockTime(), market.Derivation.TWAPWindow)
+		if !ok || average.IsZero() {
+			return sdk.Dec{}, types.ErrNoValidPrice
+		}
+		return average, nil
+
+	default:
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrNotDerivedMarket, market.MarketID)
+	}
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_SetDerivedPrice tests computing the prices of product, inverse and twap markets from their source markets
+func TestKeeper_SetDerivedPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	productMarket := types.NewMarket("btc:bnb", "btc", "bnb", nil, true)
+	productMarket.Derivation = types.NewMarketDerivation(types.DerivationTypeProduct, []string{"btc:usd", "usd:bnb"}, 0)
+	inverseMarket := types.NewMarket("usd:btc", "usd", "btc", nil, true)
+	inverseMarket.Derivation = types.NewMarketDerivation(types.DerivationTypeInverse, []string{"btc:usd"}, 0)
+	twapMarket := types.NewMarket("btc:usd:30", "btc", "usd", nil, true)
+	twapMarket.Derivation = types.NewMarketDerivation(types.DerivationTypeTWAP, []string{"btc:usd"}, 30*time.Minute)
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("btc:usd", "btc", "usd", addrs, true),
+		types.NewMarket("usd:bnb", "usd", "bnb", addrs, true),
+		productMarket,
+		inverseMarket,
+		twapMarket,
+	}, types.DefaultParams().PerformanceParams, types.DefaultPriceHistoryLength))
+
+	// oracle markets cannot be derived
+	err := keeper.SetDerivedPrice(ctx, "btc:usd")
+	require.True(t, types.ErrNotDerivedMarket.Is(err))
+
+	// derived prices are invalid until the source markets have prices
+	err = keeper.SetDerivedPrice(ctx, "btc:bnb")
+	require.True(t, types.ErrNoValidPrice.Is(err))
+
+	expiry := startTime.Add(24 * time.Hour)
+	_, err = keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("40000"), expiry)
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[0], "usd:bnb", sdk.MustNewDecFromStr("0.025"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "usd:bnb"))
+	keeper.UpdatePriceHistory(ctx, "btc:usd", 0, twapMarket.Derivation.TWAPWindow)
+
+	require.NoError(t, keeper.SetDerivedPrice(ctx, "btc:bnb"))
+	price, err := keeper.GetCurrentPrice(ctx, "btc:bnb")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1000"), price.Price)
+
+	require.NoError(t, keeper.SetDerivedPrice(ctx, "usd:btc"))
+	price, err = keeper.GetCurrentPrice(ctx, "usd:btc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.000025"), price.Price)
+
+	// the source price changes after 20 minutes, the twap weights each price by how long it was current
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(20 * time.Minute))
+	_, err = keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("43000"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+	keeper.UpdatePriceHistory(ctx, "btc:usd", 0, twapMarket.Derivation.TWAPWindow)
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(40 * time.Minute))
+	keeper.UpdatePriceHistory(ctx, "btc:usd", 0, twapMarket.Derivation.TWAPWindow)
+	require.NoError(t, keeper.SetDerivedPrice(ctx, "btc:usd:30"))
+	price, err = keeper.GetCurrentPrice(ctx, "btc:usd:30")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("42000"), price.Price)
+
+	// snapshots older than the window are pruned, except the one providing the price at the window start
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(55 * time.Minute))
+	keeper.UpdatePriceHistory(ctx, "btc:usd", 0, twapMarket.Derivation.TWAPWindow)
+	snapshots := keeper.GetPriceSnapshots(ctx, "btc:usd")
+	require.Len(t, snapshots, 3)
+	require.Equal(t, startTime.Add(20*time.Minute), snapshots[0].Time)
+
+	// derived prices are zeroed when a source market price expires
+	ctx = ctx.WithBlockTime(expiry)
+	require.True(t, types.ErrNoValidPrice.Is(keeper.SetCurrentPrices(ctx, "btc:usd")))
+	require.True(t, types.ErrNoValidPrice.Is(keeper.SetDerivedPrice(ctx, "btc:usd:30")))
+	_, err = keeper.GetCurrentPrice(ctx, "btc:usd:30")
+	require.True(t, types.ErrNoValidPrice.Is(err))
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// UpdatePriceHistory records the current price of a market at the current block and removes snapshots that are
+// neither within the last capacity blocks nor needed to cover the twap retention window. The latest snapshot before
+// the retention window is kept as it provides the price at the start of the window.
+func (k Keeper) UpdatePriceHistory(ctx sdk.Context, marketID string, capacity uint64, twapRetention time.Duration) {
+	if capacity == 0 && twapRetention == 0 {
+		k.DeletePriceHistory(ctx, marketID)
+		return
+	}
+
+	currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err == nil {
+		k.SetPriceSnapshot(ctx, types.NewPriceSnapshot(marketID, currentPrice.Price, ctx.BlockHeight(), ctx.BlockTime()))
+	}
+
+	maxExpiredHeight := ctx.BlockHeight() - int64(capacity)
+	cutoff := ctx.BlockTime().Add(-twapRetention)
+	var expiredHeights []int64
+	var previous *types.PriceSnapshot
+	k.IteratePriceSnapshots(ctx, marketID, func(snapshot types.PriceSnapshot) (stop bool) {
+		// the previous snapshot is only needed if this one is after the start of the retention window
+		if previous != nil {
+			if snapshot.Time.After(cutoff) {
+				return true
+			}
+			expiredHeights = append(expiredHeights, previous.Height)
+		}
+		if snapshot.Height > maxExpiredHeight {
+			return true
+		}
+		previous = &snapshot
+		return false
+	})
+	for _, height := range expiredHeights {
+		k.DeletePriceSnapshot(ctx, marketID, height)
+	}
+}
+
+// DeletePriceHistory removes all price snapshots of a market
+func (k Keeper) DeletePriceHistory(ctx sdk.Context, marketID string) {
+	var heights []int64
+	k.IteratePriceSnapshots(ctx, marketID, func(snapshot types.PriceSnapshot) (stop bool) {
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	for _, height := range heights {
+		k.DeletePriceSnapshot(ctx, marketID, height)
+	}
+}
+
+// GetPriceSnapshot returns a market's price snapshot at the given block height
+func (k Keeper) GetPriceSnapshot(ctx sdk.Context, marketID string, height int64) (types.PriceSnapshot, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceSnapshotKey(marketID, height))
+	if bz == nil {
+		return types.PriceSnapshot{}, false
+	}
+	var snapshot types.PriceSnapshot
+	k.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetPriceSnapshot stores a market's price snapshot
+func (k Keeper) SetPriceSnapshot(ctx sdk.Context, snapshot types.PriceSnapshot) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceSnapshotKey(snapshot.MarketID, snapshot.Height), k.cdc.MustMarshalBinaryBare(snapshot))
+}
+
+// DeletePriceSnapshot removes a market's price snapshot
+func (k Keeper) DeletePriceSnapshot(ctx sdk.Context, marketID string, height int64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.PriceSnapshotKey(marketID, height))
+}
+
+// IteratePriceSnapshots iterates over a market's price snapshots in ascending height order and performs a callback function
+func (k Keeper) IteratePriceSnapshots(ctx sdk.Context, marketID string, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	k.iteratePriceSnapshots(ctx, types.PriceHistoryMarketKey(marketID), cb)
+}
+
+// IteratePriceHistory iterates over the price snapshots of all markets and performs a callback function
+func (k Keeper) IteratePriceHistory(ctx sdk.Context, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	k.iteratePriceSnapshots(ctx, types.PriceHistoryPrefix, cb)
+}
+
+func (k Keeper) iteratePriceSnapshots(ctx sdk.Context, keyPrefix []byte, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetPriceSnapshots returns a market's price snapshots in ascending height order
+func (k Keeper) GetPriceSnapshots(ctx sdk.Context, marketID string) types.PriceSnapshots {
+	snapshots := types.PriceSnapshots{}
+	k.IteratePriceSnapshots(ctx, marketID, func(snapshot types.PriceSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// GetPriceHistory returns the price snapshots of all markets
+func (k Keeper) GetPriceHistory(ctx sdk.Context) types.PriceSnapshots {
+	snapshots := types.PriceSnapshots{}
+	k.IteratePriceHistory(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// GetPriceOHLC returns the open, high, low and close prices of a market between the start and end times
+func (k Keeper) GetPriceOHLC(ctx sdk.Context, marketID string, start, end time.Time) (types.PriceOHLC, error) {
+	if _, found := k.GetMarket(ctx, marketID); !found {
+		return types.PriceOHLC{}, sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	ohlc, found := k.GetPriceSnapshots(ctx, marketID).OHLC(start, end)
+	if !found {
+		return types.PriceOHLC{}, sdkerrors.Wrapf(types.ErrPriceHistoryNotFound, "%s between %s and %s", marketID, start, end)
+	}
+	return ohlc, nil
+}
+
+// GetPriceTWAP returns the time-weighted average price of a market between the start and end times
+func (k Keeper) GetPriceTWAP(ctx sdk.Context, marketID string, start, end time.Time) (types.PriceTWAP, error) {
+	if _, found := k.GetMarket(ctx, marketID); !found {
+		return types.PriceTWAP{}, sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	average, found := k.GetPriceSnapshots(ctx, marketID).TimeWeightedAverage(end, end.Sub(start))
+	if !found {
+		return types.PriceTWAP{}, sdkerrors.Wrapf(types.ErrPriceHistoryNotFound, "%s between %s and %s", marketID, start, end)
+	}
+	return types.NewPriceTWAP(marketID, average, start, end), nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceHistory tests recording and pruning the price history of a market and querying it
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("btc:usd", "btc", "usd", addrs, true),
+	}, types.DefaultParams().PerformanceParams, 3))
+
+	prices := []string{"100", "120", "90", "110", "105"}
+	for i, price := range prices {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr(price), startTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "btc:usd"))
+		keeper.UpdatePriceHistory(ctx, "btc:usd", 3, 0)
+	}
+
+	// only the last 3 blocks are kept
+	snapshots := keeper.GetPriceSnapshots(ctx, "btc:usd")
+	require.Len(t, snapshots, 3)
+	require.Equal(t, int64(3), snapshots[0].Height)
+	_, found := keeper.GetPriceSnapshot(ctx, "btc:usd", 2)
+	require.False(t, found)
+	snapshot, found := keeper.GetPriceSnapshot(ctx, "btc:usd", 4)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("110"), snapshot.Price)
+
+	ohlc, err := keeper.GetPriceOHLC(ctx, "btc:usd", startTime.Add(2*time.Minute), startTime.Add(4*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("90"), ohlc.Open)
+	require.Equal(t, sdk.MustNewDecFromStr("110"), ohlc.High)
+	require.Equal(t, sdk.MustNewDecFromStr("90"), ohlc.Low)
+	require.Equal(t, sdk.MustNewDecFromStr("105"), ohlc.Close)
+
+	twap, err := keeper.GetPriceTWAP(ctx, "btc:usd", startTime.Add(2*time.Minute), startTime.Add(4*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("100"), twap.Price)
+
+	_, err = keeper.GetPriceOHLC(ctx, "btc:usd", startTime.Add(-time.Hour), startTime.Add(-time.Minute))
+	require.True(t, types.ErrPriceHistoryNotFound.Is(err))
+	_, err = keeper.GetPriceTWAP(ctx, "eth:usd", startTime, startTime.Add(time.Minute))
+	require.True(t, types.ErrInvalidMarket.Is(err))
+
+	// disabling the history removes it
+	keeper.UpdatePriceHistory(ctx, "btc:usd", 0, 0)
+	require.Empty(t, keeper.GetPriceSnapshots(ctx, "btc:usd"))
+}
@@ -24,11 +24,13 @@ type Keeper struct {
 	cdc *codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace subspace.Subspace
+	// The reference to the supply keeper used to hold and slash oracle bonds
+	supplyKeeper types.SupplyKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
+	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, sk types.SupplyKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +40,7 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		supplyKeeper:  sk,
 	}
 }
 
@@ -93,6 +96,7 @@ func (k Keeper) SetPrice(
 	)
 
 	store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(prices))
+	k.recordOraclePost(ctx, marketID, oracle)
 	return prices[index], nil
 }
 
@@ -102,13 +106,6 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
-	// store current price
-	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-	if err != nil {
-		validPrevPrice = false
-	}
-
 	prices, err := k.GetRawPrices(ctx, marketID)
 	if err != nil {
 		return err
@@ -131,23 +128,27 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 	}
 
 	medianPrice := k.CalculateMedianPrice(ctx, notExpiredPrices)
+	k.updateCurrentPrice(ctx, marketID, medianPrice)
+
+	return nil
+}
 
+// updateCurrentPrice stores the new current price of a market, emitting an event if it changed from a valid previous price
+func (k Keeper) updateCurrentPrice(ctx sdk.Context, marketID string, price sdk.Dec) {
+	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
 	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
+	if err == nil && !price.Equal(prevPrice.Price) {
 		// only emit event if price has changed
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMarketPriceUpdated,
 				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
 			),
 		)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
-	k.setCurrentPrice(ctx, marketID, currentPrice)
-
-	return nil
+	k.setCurrentPrice(ctx, marketID, types.NewCurrentPrice(marketID, price))
 }
 
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
@@ -24,11 +24,11 @@ func (k Keeper) GetMarkets(ctx sdk.Context) types.Markets {
 	return k.GetParams(ctx).Markets
 }
 
-// GetOracles returns the oracles in the pricefeed store
+// GetOracles returns the oracles of a market, excluding oracles that were removed from it when penalized
 func (k Keeper) GetOracles(ctx sdk.Context, marketID string) ([]sdk.AccAddress, error) {
 	for _, m := range k.GetMarkets(ctx) {
 		if marketID == m.MarketID {
-			return m.Oracles, nil
+			return k.getActiveOracles(ctx, m), nil
 		}
 	}
 	return []sdk.AccAddress{}, sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
@@ -66,7 +66,7 @@ func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	uniqueOracles := map[string]bool{}
 
 	for _, m := range k.GetMarkets(ctx) {
-		for _, o := range m.Oracles {
+		for _, o := range k.getActiveOracles(ctx, m) {
 			// de-dup list of oracles
 			if _, found := uniqueOracles[o.String()]; !found {
 				oracles = append(oracles, o)
@@ -76,3 +76,16 @@ func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	}
 	return oracles
 }
+
+// getActiveOracles returns the oracles of a market that haven't been removed from it when penalized
+func (k Keeper) getActiveOracles(ctx sdk.Context, market types.Market) []sdk.AccAddress {
+	oracles := []sdk.AccAddress{}
+	for _, oracle := range market.Oracles {
+		performance, found := k.GetOraclePerformance(ctx, market.MarketID, oracle)
+		if found && performance.Removed {
+			continue
+		}
+		oracles = append(oracles, oracle)
+	}
+	return oracles
+}
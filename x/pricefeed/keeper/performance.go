@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetOraclePerformance returns the performance record of an oracle in a market
+func (k Keeper) GetOraclePerformance(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OraclePerformance, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OraclePerformanceKey(marketID, oracle))
+	if bz == nil {
+		return types.OraclePerformance{}, false
+	}
+	var performance types.OraclePerformance
+	k.cdc.MustUnmarshalBinaryBare(bz, &performance)
+	return performance, true
+}
+
+// SetOraclePerformance stores the performance record of an oracle in a market
+func (k Keeper) SetOraclePerformance(ctx sdk.Context, performance types.OraclePerformance) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OraclePerformanceKey(performance.MarketID, performance.OracleAddress), k.cdc.MustMarshalBinaryBare(performance))
+}
+
+// DeleteOraclePerformance removes the performance record of an oracle in a market
+func (k Keeper) DeleteOraclePerformance(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.OraclePerformanceKey(marketID, oracle))
+}
+
+// IterateOraclePerformances iterates over all oracle performance records and performs a callback function
+func (k Keeper) IterateOraclePerformances(ctx sdk.Context, cb func(performance types.OraclePerformance) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OraclePerformancePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var performance types.OraclePerformance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &performance)
+		if cb(performance) {
+			break
+		}
+	}
+}
+
+// GetOraclePerformances returns all oracle performance records from the store
+func (k Keeper) GetOraclePerformances(ctx sdk.Context) types.OraclePerformances {
+	performances := types.OraclePerformances{}
+	k.IterateOraclePerformances(ctx, func(performance types.OraclePerformance) (stop bool) {
+		performances = append(performances, performance)
+		return false
+	})
+	return performances
+}
+
+// GetPerformanceWindowStart returns the start time of the current performance window
+func (k Keeper) GetPerformanceWindowStart(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PerformanceWindowStartKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var windowStart time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &windowStart)
+	return windowStart, true
+}
+
+// SetPerformanceWindowStart sets the start time of the current performance window
+func (k Keeper) SetPerformanceWindowStart(ctx sdk.Context, windowStart time.Time) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PerformanceWindowStartKey, k.cdc.MustMarshalBinaryBare(windowStart))
+}
+
+// recordOraclePost updates the last post time of an oracle when performance tracking is enabled
+func (k Keeper) recordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	if !k.GetParams(ctx).PerformanceParams.TrackingEnabled() {
+		return
+	}
+	performance, found := k.GetOraclePerformance(ctx, marketID, oracle)
+	if !found {
+		performance = types.NewOraclePerformance(marketID, oracle)
+	}
+	performance.LastPostTime = ctx.BlockTime()
+	k.SetOraclePerformance(ctx, performance)
+}
+
+// UpdateOraclePerformances closes the current performance window once it has elapsed, recording for every
+// oracle of each active market whether it missed the window and how far its price deviates from the median.
+// Oracles that exceed the configured limits are penalized.
+func (k Keeper) UpdateOraclePerformances(ctx sdk.Context) error {
+	params := k.GetParams(ctx).PerformanceParams
+	if !params.TrackingEnabled() {
+		return nil
+	}
+	windowStart, found := k.GetPerformanceWindowStart(ctx)
+	if !found {
+		k.SetPerformanceWindowStart(ctx, ctx.BlockTime())
+		return nil
+	}
+	if ctx.BlockTime().Before(windowStart.Add(params.WindowDuration)) {
+		return nil
+	}
+
+	for _, market := range k.GetMarkets(ctx) {
+		if !market.Active {
+			continue
+		}
+		if err := k.evaluateMarketWindow(ctx, params, market, windowStart); err != nil {
+			return err
+		}
+	}
+	k.SetPerformanceWindowStart(ctx, ctx.BlockTime())
+	return nil
+}
+
+func (k Keeper) evaluateMarketWindow(ctx sdk.Context, params types.PerformanceParams, market types.Market, windowStart time.Time) error {
+	rawPrices, err := k.GetRawPrices(ctx, market.MarketID)
+	if err != nil {
+		return err
+	}
+	currentPrice, err := k.GetCurrentPrice(ctx, market.MarketID)
+	hasMedian := err == nil
+
+	for _, oracle := range k.getActiveOracles(ctx, market) {
+		performance, found := k.GetOraclePerformance(ctx, market.MarketID, oracle)
+		if !found {
+			performance = types.NewOraclePerformance(market.MarketID, oracle)
+		}
+		performance.WindowsTracked++
+
+		if performance.LastPostTime.Before(windowStart) {
+			performance.MissedWindows++
+		}
+
+		for _, rp := range rawPrices {
+			if !rp.OracleAddress.Equals(oracle) || !rp.Expiry.After(ctx.BlockTime()) || !hasMedian {
+				continue
+			}
+			performance.LastDeviation = rp.Price.Sub(currentPrice.Price).Abs().Quo(currentPrice.Price)
+			if params.MaxDeviation.IsPositive() && performance.LastDeviation.GT(params.MaxDeviation) {
+				performance.OutlierWindows++
+			}
+		}
+
+		exceedsMissed := params.MissedWindowLimit > 0 && performance.MissedWindows >= params.MissedWindowLimit
+		exceedsOutlier := params.OutlierWindowLimit > 0 && performance.OutlierWindows >= params.OutlierWindowLimit
+		if exceedsMissed || exceedsOutlier {
+			if err := k.penalizeOracle(ctx, params, &performance); err != nil {
+				return err
+			}
+		}
+		k.SetOraclePerformance(ctx, performance)
+	}
+	return nil
+}
+
+// penalizeOracle slashes the oracle's bond and optionally removes it from the market's oracle set.
+// Removed oracles are marked in their performance record, leaving the market's params unchanged.
+// The oracle's missed and outlier window counters are reset afterwards.
+func (k Keeper) penalizeOracle(ctx sdk.Context, params types.PerformanceParams, performance *types.OraclePerformance) error {
+	slashed, err := k.SlashOracleBond(ctx, performance.OracleAddress, params.SlashFraction)
+	if err != nil {
+		return err
+	}
+	if params.RemoveOracle {
+		if err := k.removeOracle(ctx, performance.MarketID, performance.OracleAddress); err != nil {
+			return err
+		}
+		performance.Removed = true
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOraclePenalized,
+			sdk.NewAttribute(types.AttributeMarketID, performance.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, performance.OracleAddress.String()),
+			sdk.NewAttribute(types.AttributeMissedWindows, fmt.Sprintf("%d", performance.MissedWindows)),
+			sdk.NewAttribute(types.AttributeOutlierCount, fmt.Sprintf("%d", performance.OutlierWindows)),
+			sdk.NewAttribute(types.AttributeSlashedAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeOracleRemoved, fmt.Sprintf("%t", params.RemoveOracle)),
+		),
+	)
+
+	performance.Penalties++
+	performance.MissedWindows = 0
+	performance.OutlierWindows = 0
+	return nil
+}
+
+// removeOracle deletes an oracle's posted price and price commitment in a market, so its last price stops counting towards the median
+func (k Keeper) removeOracle(ctx sdk.Context, marketID string, oracle sdk.AccAddress) error {
+	prices, err := k.GetRawPrices(ctx, marketID)
+	if err != nil {
+		return err
+	}
+	remaining := types.PostedPrices{}
+	for _, pp := range prices {
+		if !pp.OracleAddress.Equals(oracle) {
+			remaining = append(remaining, pp)
+		}
+	}
+	store := ctx.KVStore(k.key)
+	if len(remaining) == 0 {
+		store.Delete(types.RawPriceKey(marketID))
+	} else {
+		store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(remaining))
+	}
+	k.DeletePriceCommitment(ctx, marketID, oracle)
+	return nil
+}
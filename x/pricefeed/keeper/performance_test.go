@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_UpdateOraclePerformances tests recording missed windows and deviations, and penalizing oracles
+func TestKeeper_UpdateOraclePerformances(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(addrs, []sdk.Coins{
+			sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+		}),
+	)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	perfParams := types.NewPerformanceParams(
+		time.Hour, sdk.MustNewDecFromStr("0.1"), 2, 1,
+		sdk.MustNewDecFromStr("0.5"), true, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)),
+	)
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+	}, perfParams, types.DefaultPriceHistoryLength))
+	for _, addr := range addrs {
+		require.NoError(t, keeper.DepositOracleBond(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 200))))
+	}
+
+	// first evaluation only opens the window
+	require.NoError(t, keeper.UpdateOraclePerformances(ctx))
+	windowStart, found := keeper.GetPerformanceWindowStart(ctx)
+	require.True(t, found)
+	require.Equal(t, startTime, windowStart)
+
+	// oracle 0 and 1 post similar prices, oracle 2 posts an outlier
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	expiry := startTime.Add(3 * time.Hour)
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), expiry)
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("1.02"), expiry)
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("2.00"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	// window has not elapsed yet
+	require.NoError(t, keeper.UpdateOraclePerformances(ctx))
+	performance, found := keeper.GetOraclePerformance(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(0), performance.WindowsTracked)
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	require.NoError(t, keeper.UpdateOraclePerformances(ctx))
+
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(1), performance.WindowsTracked)
+	require.Equal(t, uint64(0), performance.MissedWindows)
+	require.Equal(t, uint64(0), performance.OutlierWindows)
+	require.Equal(t, sdk.MustNewDecFromStr("0.019607843137254902"), performance.LastDeviation)
+
+	// the outlying oracle is slashed and removed from the oracle set
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[2])
+	require.Equal(t, uint64(1), performance.Penalties)
+	require.Equal(t, uint64(0), performance.OutlierWindows)
+	bond, found := keeper.GetOracleBond(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), bond.Amount)
+	_, err = keeper.GetOracle(ctx, "tstusd", addrs[2])
+	require.Error(t, err)
+	require.True(t, performance.Removed)
+	// the market's params are unchanged, and the removed oracle's price no longer counts towards the median
+	market, _ := keeper.GetMarket(ctx, "tstusd")
+	require.Len(t, market.Oracles, 3)
+	rawPrices, err := keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, rawPrices, 2)
+	for _, rp := range rawPrices {
+		require.False(t, rp.OracleAddress.Equals(addrs[2]))
+	}
+
+	// oracle 1 misses the next two windows
+	ctx = ctx.WithBlockTime(startTime.Add(90 * time.Minute))
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), expiry)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	require.NoError(t, keeper.UpdateOraclePerformances(ctx))
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[1])
+	require.Equal(t, uint64(1), performance.MissedWindows)
+	require.Equal(t, uint64(0), performance.Penalties)
+
+	ctx = ctx.WithBlockTime(startTime.Add(150 * time.Minute))
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), expiry)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(3 * time.Hour))
+	require.NoError(t, keeper.UpdateOraclePerformances(ctx))
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[1])
+	require.Equal(t, uint64(1), performance.Penalties)
+	require.Equal(t, uint64(3), performance.WindowsTracked)
+	oracles, err := keeper.GetOracles(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, oracles)
+	rawPrices, err = keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, rawPrices, 1)
+
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(0), performance.MissedWindows)
+	require.Equal(t, uint64(0), performance.Penalties)
+}
+
+// TestKeeper_OracleBond tests depositing and withdrawing oracle bonds
+func TestKeeper_OracleBond(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(addrs, []sdk.Coins{
+			sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)),
+		}),
+	)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now()})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	perfParams := types.DefaultParams().PerformanceParams
+	perfParams.MinOracleBond = sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{addrs[0]}, true),
+	}, perfParams, types.DefaultPriceHistoryLength))
+
+	require.False(t, keeper.HasSufficientBond(ctx, addrs[0]))
+	require.NoError(t, keeper.DepositOracleBond(ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 150))))
+	require.True(t, keeper.HasSufficientBond(ctx, addrs[0]))
+
+	// an active oracle cannot withdraw below the minimum bond
+	err := keeper.WithdrawOracleBond(ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)))
+	require.True(t, types.ErrInsufficientBond.Is(err))
+	require.NoError(t, keeper.WithdrawOracleBond(ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 50))))
+	tApp.CheckBalance(t, ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 900)))
+
+	// an address that is not an oracle can withdraw its whole bond
+	require.NoError(t, keeper.DepositOracleBond(ctx, addrs[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 150))))
+	require.NoError(t, keeper.WithdrawOracleBond(ctx, addrs[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 150))))
+	_, found := keeper.GetOracleBond(ctx, addrs[1])
+	require.False(t, found)
+
+	err = keeper.WithdrawOracleBond(ctx, addrs[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))
+	require.True(t, types.ErrBondNotFound.Is(err))
+}
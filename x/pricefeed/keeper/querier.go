@@ -3,6 +3,7 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,6 +27,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryMarkets(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryOraclePerformance:
+			return queryOraclePerformance(ctx, req, keeper)
+		case types.QueryOracleBond:
+			return queryOracleBond(ctx, req, keeper)
+		case types.QueryPriceCommitments:
+			return queryPriceCommitments(ctx, req, keeper)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, req, keeper)
+		case types.QueryPriceAtHeight:
+			return queryPriceAtHeight(ctx, req, keeper)
+		case types.QueryPriceOHLC:
+			return queryPriceOHLC(ctx, req, keeper)
+		case types.QueryPriceTWAP:
+			return queryPriceTWAP(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -130,3 +145,159 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	}
 	return bz, nil
 }
+
+func queryOraclePerformance(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryOraclePerformanceParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	performances := types.OraclePerformances{}
+	keeper.IterateOraclePerformances(ctx, func(performance types.OraclePerformance) (stop bool) {
+		if requestParams.MarketID != "" && performance.MarketID != requestParams.MarketID {
+			return false
+		}
+		if !requestParams.Oracle.Empty() && !performance.OracleAddress.Equals(requestParams.Oracle) {
+			return false
+		}
+		performances = append(performances, performance)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, performances)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryOracleBond(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryOracleBondParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bond, found := keeper.GetOracleBond(ctx, requestParams.Oracle)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBondNotFound, requestParams.Oracle.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bond)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPriceCommitments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	commitments := types.PriceCommitments{}
+	keeper.IteratePriceCommitments(ctx, func(commitment types.PriceCommitment) (stop bool) {
+		if commitment.MarketID == requestParams.MarketID {
+			commitments = append(commitments, commitment)
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, commitments)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPriceHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryPriceHistoryParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	snapshots := keeper.GetPriceSnapshots(ctx, params.MarketID)
+	start, end := client.Paginate(len(snapshots), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		snapshots = types.PriceSnapshots{}
+	} else {
+		snapshots = snapshots[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, snapshots)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPriceAtHeight(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryPriceAtHeightParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	snapshot, found := keeper.GetPriceSnapshot(ctx, params.MarketID, params.Height)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrPriceHistoryNotFound, "%s at height %d", params.MarketID, params.Height)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, snapshot)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPriceOHLC(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryPriceRangeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if !params.EndTime.After(params.StartTime) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end time must be after start time")
+	}
+
+	ohlc, err := keeper.GetPriceOHLC(ctx, params.MarketID, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, ohlc)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPriceTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryPriceRangeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if !params.EndTime.After(params.StartTime) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end time must be after start time")
+	}
+
+	twap, err := keeper.GetPriceTWAP(ctx, params.MarketID, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, twap)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
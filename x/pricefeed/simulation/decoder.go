@@ -3,6 +3,7 @@ package simulation
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -26,6 +27,36 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &postedPriceB)
 		return fmt.Sprintf("%s\n%s", postedPriceA, postedPriceB)
 
+	case bytes.Contains(kvA.Key, []byte(types.OraclePerformancePrefix)):
+		var performanceA, performanceB types.OraclePerformance
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &performanceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &performanceB)
+		return fmt.Sprintf("%s\n%s", performanceA, performanceB)
+
+	case bytes.Contains(kvA.Key, []byte(types.OracleBondPrefix)):
+		var bondA, bondB types.OracleBond
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &bondA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &bondB)
+		return fmt.Sprintf("%s\n%s", bondA, bondB)
+
+	case bytes.Contains(kvA.Key, []byte(types.PerformanceWindowStartKey)):
+		var windowStartA, windowStartB time.Time
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &windowStartA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &windowStartB)
+		return fmt.Sprintf("%s\n%s", windowStartA, windowStartB)
+
+	case bytes.Contains(kvA.Key, []byte(types.PriceCommitmentPrefix)):
+		var commitmentA, commitmentB types.PriceCommitment
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &commitmentA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &commitmentB)
+		return fmt.Sprintf("%s\n%s", commitmentA, commitmentB)
+
+	case bytes.Contains(kvA.Key, []byte(types.PriceHistoryPrefix)):
+		var snapshotA, snapshotB types.PriceSnapshot
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &snapshotA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &snapshotB)
+		return fmt.Sprintf("%s\n%s", snapshotA, snapshotB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
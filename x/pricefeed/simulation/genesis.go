@@ -54,8 +54,8 @@ func loadPricefeedGenState(simState *module.SimulationState) pricefeed.GenesisSt
 		markets = append(markets, market)
 		postedPrices = append(postedPrices, postedPrice)
 	}
-	params := pricefeed.NewParams(markets)
-	return pricefeed.NewGenesisState(params, postedPrices)
+	params := pricefeed.NewParams(markets, pricefeed.DefaultParams().PerformanceParams, pricefeed.DefaultPriceHistoryLength)
+	return pricefeed.NewGenesisState(params, postedPrices, pricefeed.OraclePerformances{}, pricefeed.OracleBonds{}, pricefeed.PriceCommitments{}, pricefeed.PriceSnapshots{})
 }
 
 // getInitialPrice gets the starting price for each of the base assets
@@ -13,4 +13,8 @@ func init() {
 // RegisterCodec registers concrete types on the Amino code
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(MsgDepositOracleBond{}, "pricefeed/MsgDepositOracleBond", nil)
+	cdc.RegisterConcrete(MsgWithdrawOracleBond{}, "pricefeed/MsgWithdrawOracleBond", nil)
+	cdc.RegisterConcrete(MsgCommitPrice{}, "pricefeed/MsgCommitPrice", nil)
+	cdc.RegisterConcrete(MsgRevealPrice{}, "pricefeed/MsgRevealPrice", nil)
 }
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	// MaxSaltLength is the maximum length of the salt used in a price commitment
+	MaxSaltLength = 64
+	// CommitmentHashLength is the length of a price commitment hash
+	CommitmentHashLength = sha256.Size
+)
+
+// PriceCommitment is an oracle's commitment to a price that will be revealed in the following period
+type PriceCommitment struct {
+	MarketID      string           `json:"market_id" yaml:"market_id"`
+	OracleAddress sdk.AccAddress   `json:"oracle_address" yaml:"oracle_address"`
+	Hash          tmbytes.HexBytes `json:"hash" yaml:"hash"`
+	Period        int64            `json:"period" yaml:"period"` // commit period in which the commitment was made
+}
+
+// NewPriceCommitment returns a new PriceCommitment
+func NewPriceCommitment(marketID string, oracle sdk.AccAddress, hash tmbytes.HexBytes, period int64) PriceCommitment {
+	return PriceCommitment{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		Hash:          hash,
+		Period:        period,
+	}
+}
+
+// Validate performs a basic check of a PriceCommitment
+func (pc PriceCommitment) Validate() error {
+	if strings.TrimSpace(pc.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pc.OracleAddress.Empty() {
+		return errors.New("oracle address cannot be empty")
+	}
+	if len(pc.Hash) != CommitmentHashLength {
+		return fmt.Errorf("commitment hash must be %d bytes, got %d", CommitmentHashLength, len(pc.Hash))
+	}
+	if pc.Period < 0 {
+		return fmt.Errorf("commit period cannot be negative: %d", pc.Period)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pc PriceCommitment) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle Address: %s
+Hash: %s
+Period: %d`, pc.MarketID, pc.OracleAddress, pc.Hash, pc.Period))
+}
+
+// PriceCommitments is a slice of PriceCommitment
+type PriceCommitments []PriceCommitment
+
+// Validate checks that all commitments are valid and there are no duplicated entries
+func (pcs PriceCommitments) Validate() error {
+	seen := make(map[string]bool)
+	for _, pc := range pcs {
+		if err := pc.Validate(); err != nil {
+			return err
+		}
+		key := pc.MarketID + pc.OracleAddress.String()
+		if seen[key] {
+			return fmt.Errorf("duplicated commitment for market id %s and oracle address %s", pc.MarketID, pc.OracleAddress)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// CalculatePriceCommitment returns the hash an oracle commits to before revealing its price.
+// The oracle address is included so that other oracles cannot replay a commitment and its reveal.
+func CalculatePriceCommitment(marketID string, oracle sdk.AccAddress, price sdk.Dec, salt string) tmbytes.HexBytes {
+	data := []byte(marketID)
+	data = append(data, oracle...)
+	data = append(data, []byte(price.String())...)
+	data = append(data, []byte(salt)...)
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// CommitPeriodAt returns the index of the commit-reveal period containing the given time
+func CommitPeriodAt(t time.Time, periodLength time.Duration) int64 {
+	return t.UnixNano() / int64(periodLength)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Derivation types for markets whose price is computed from other markets
+const (
+	DerivationTypeProduct = "product" // product of the source market prices
+	DerivationTypeInverse = "inverse" // reciprocal of the source market price
+	DerivationTypeTWAP    = "twap"    // time-weighted average of the source market price over the TWAP window
+)
+
+// MarketDerivation defines how a derived market's price is computed from the current prices of other markets
+type MarketDerivation struct {
+	Type          string        `json:"type" yaml:"type"`
+	SourceMarkets []string      `json:"source_markets" yaml:"source_markets"`
+	TWAPWindow    time.Duration `json:"twap_window" yaml:"twap_window"`
+}
+
+// NewMarketDerivation returns a new MarketDerivation
+func NewMarketDerivation(derivationType string, sourceMarkets []string, twapWindow time.Duration) MarketDerivation {
+	return MarketDerivation{
+		Type:          derivationType,
+		SourceMarkets: sourceMarkets,
+		TWAPWindow:    twapWindow,
+	}
+}
+
+// Validate performs a basic validation of a market derivation
+func (md MarketDerivation) Validate() error {
+	for _, source := range md.SourceMarkets {
+		if strings.TrimSpace(source) == "" {
+			return errors.New("source market id cannot be blank")
+		}
+	}
+	if md.Type != DerivationTypeTWAP && md.TWAPWindow != 0 {
+		return fmt.Errorf("twap window is only valid for %s derivations", DerivationTypeTWAP)
+	}
+
+	switch md.Type {
+	case "":
+		if len(md.SourceMarkets) > 0 {
+			return errors.New("source markets require a derivation type")
+		}
+	case DerivationTypeProduct:
+		if len(md.SourceMarkets) < 2 {
+			return fmt.Errorf("%s derivation requires at least 2 source markets", md.Type)
+		}
+	case DerivationTypeInverse:
+		if len(md.SourceMarkets) != 1 {
+			return fmt.Errorf("%s derivation requires exactly 1 source market", md.Type)
+		}
+	case DerivationTypeTWAP:
+		if len(md.SourceMarkets) != 1 {
+			return fmt.Errorf("%s derivation requires exactly 1 source market", md.Type)
+		}
+		if md.TWAPWindow <= 0 {
+			return fmt.Errorf("%s derivation requires a positive window", md.Type)
+		}
+	default:
+		return fmt.Errorf("invalid derivation type %s", md.Type)
+	}
+	return nil
+}
+
+// Equal returns true if two market derivations are the same
+func (md MarketDerivation) Equal(other MarketDerivation) bool {
+	if md.Type != other.Type || md.TWAPWindow != other.TWAPWindow || len(md.SourceMarkets) != len(other.SourceMarkets) {
+		return false
+	}
+	for i := range md.SourceMarkets {
+		if md.SourceMarkets[i] != other.SourceMarkets[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer
+func (md MarketDerivation) String() string {
+	if md.Type == "" {
+		return "none"
+	}
+	if md.Type == DerivationTypeTWAP {
+		return fmt.Sprintf("%s %s over %s", md.Type, strings.Join(md.SourceMarkets, ", "), md.TWAPWindow)
+	}
+	return fmt.Sprintf("%s %s", md.Type, strings.Join(md.SourceMarkets, ", "))
+}
@@ -19,4 +19,22 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrInsufficientBond error for oracles that have not bonded the minimum required deposit
+	ErrInsufficientBond = sdkerrors.Register(ModuleName, 8, "oracle bond is insufficient")
+	// ErrBondNotFound error for oracles without a bond
+	ErrBondNotFound = sdkerrors.Register(ModuleName, 9, "oracle bond not found")
+	// ErrCommitRevealRequired error for direct price posts to markets that use commit-reveal
+	ErrCommitRevealRequired = sdkerrors.Register(ModuleName, 10, "market requires commit-reveal price posting")
+	// ErrCommitRevealNotEnabled error for commitments to markets that accept direct price posts
+	ErrCommitRevealNotEnabled = sdkerrors.Register(ModuleName, 11, "market does not use commit-reveal price posting")
+	// ErrCommitmentNotFound error for reveals without a matching commitment
+	ErrCommitmentNotFound = sdkerrors.Register(ModuleName, 12, "price commitment not found")
+	// ErrInvalidRevealPeriod error for reveals outside of the period following the commitment
+	ErrInvalidRevealPeriod = sdkerrors.Register(ModuleName, 13, "price must be revealed in the period after it was committed")
+	// ErrInvalidReveal error for revealed prices that do not match the commitment
+	ErrInvalidReveal = sdkerrors.Register(ModuleName, 14, "revealed price does not match commitment")
+	// ErrNotDerivedMarket error for deriving the price of a market that is posted by oracles
+	ErrNotDerivedMarket = sdkerrors.Register(ModuleName, 15, "market is not a derived market")
+	// ErrPriceHistoryNotFound error for historical price queries outside of the recorded price history
+	ErrPriceHistoryNotFound = sdkerrors.Register(ModuleName, 16, "price history not found")
 )
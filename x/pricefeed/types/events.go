@@ -5,10 +5,22 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeOraclePenalized    = "oracle_penalized"
+	EventTypeOracleBond         = "oracle_bond"
+	EventTypeOracleUnbond       = "oracle_unbond"
+	EventTypePriceCommitted     = "price_committed"
+	EventTypePriceRevealed      = "price_revealed"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeMissedWindows = "missed_windows"
+	AttributeOutlierCount  = "outlier_windows"
+	AttributeSlashedAmount = "slashed_amount"
+	AttributeOracleRemoved = "oracle_removed"
+	AttributeAmount        = "amount"
+	AttributeCommitment    = "commitment"
+	AttributeCommitPeriod  = "commit_period"
 )
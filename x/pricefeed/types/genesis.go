@@ -6,15 +6,23 @@ import (
 
 // GenesisState - pricefeed state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	PostedPrices PostedPrices `json:"posted_prices" yaml:"posted_prices"`
+	Params             Params             `json:"params" yaml:"params"`
+	PostedPrices       PostedPrices       `json:"posted_prices" yaml:"posted_prices"`
+	OraclePerformances OraclePerformances `json:"oracle_performances" yaml:"oracle_performances"`
+	OracleBonds        OracleBonds        `json:"oracle_bonds" yaml:"oracle_bonds"`
+	PriceCommitments   PriceCommitments   `json:"price_commitments" yaml:"price_commitments"`
+	PriceHistory       PriceSnapshots     `json:"price_history" yaml:"price_history"`
 }
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, ops OraclePerformances, obs OracleBonds, pcs PriceCommitments, ph PriceSnapshots) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:             p,
+		PostedPrices:       pp,
+		OraclePerformances: ops,
+		OracleBonds:        obs,
+		PriceCommitments:   pcs,
+		PriceHistory:       ph,
 	}
 }
 
@@ -23,6 +31,10 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		OraclePerformances{},
+		OracleBonds{},
+		PriceCommitments{},
+		PriceSnapshots{},
 	)
 }
 
@@ -44,5 +56,17 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OraclePerformances.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OracleBonds.Validate(); err != nil {
+		return err
+	}
+	if err := gs.PriceCommitments.Validate(); err != nil {
+		return err
+	}
+	return gs.PriceHistory.Validate()
 }
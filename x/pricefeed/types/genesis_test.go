@@ -32,9 +32,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, MarketDerivation{}},
+				}, DefaultParams().PerformanceParams, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				OraclePerformances{},
+				OracleBonds{},
+				PriceCommitments{},
+				PriceSnapshots{},
 			),
 			expPass: true,
 		},
@@ -42,9 +46,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, MarketDerivation{}},
+				}, DefaultParams().PerformanceParams, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				OraclePerformances{},
+				OracleBonds{},
+				PriceCommitments{},
+				PriceSnapshots{},
 			),
 			expPass: false,
 		},
@@ -52,29 +60,41 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, MarketDerivation{}},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, MarketDerivation{}},
+				}, DefaultParams().PerformanceParams, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				OraclePerformances{},
+				OracleBonds{},
+				PriceCommitments{},
+				PriceSnapshots{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultParams().PerformanceParams, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				OraclePerformances{},
+				OracleBonds{},
+				PriceCommitments{},
+				PriceSnapshots{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultParams().PerformanceParams, DefaultPriceHistoryLength),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				OraclePerformances{},
+				OracleBonds{},
+				PriceCommitments{},
+				PriceSnapshots{},
 			),
 			expPass: false,
 		},
@@ -0,0 +1,202 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceSnapshot is the median price of a market recorded at the end of a block
+type PriceSnapshot struct {
+	MarketID string    `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec   `json:"price" yaml:"price"`
+	Height   int64     `json:"height" yaml:"height"`
+	Time     time.Time `json:"time" yaml:"time"`
+}
+
+// NewPriceSnapshot returns a new PriceSnapshot
+func NewPriceSnapshot(marketID string, price sdk.Dec, height int64, t time.Time) PriceSnapshot {
+	return PriceSnapshot{
+		MarketID: marketID,
+		Price:    price,
+		Height:   height,
+		Time:     t,
+	}
+}
+
+// Validate performs a basic validation of a price snapshot
+func (ps PriceSnapshot) Validate() error {
+	if strings.TrimSpace(ps.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if ps.Price.IsNil() || !ps.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", ps.Price)
+	}
+	if ps.Height <= 0 {
+		return fmt.Errorf("height must be positive: %d", ps.Height)
+	}
+	if ps.Time.IsZero() {
+		return errors.New("time cannot be zero")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ps PriceSnapshot) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Price: %s
+Height: %d
+Time: %s`, ps.MarketID, ps.Price, ps.Height, ps.Time))
+}
+
+// PriceSnapshots is a slice of PriceSnapshot
+type PriceSnapshots []PriceSnapshot
+
+// Validate checks that all snapshots are valid and that each market's snapshots are in ascending height order
+func (pss PriceSnapshots) Validate() error {
+	lastHeights := make(map[string]int64)
+	for _, ps := range pss {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+		if ps.Height <= lastHeights[ps.MarketID] {
+			return fmt.Errorf("price snapshots for market %s are not in ascending height order", ps.MarketID)
+		}
+		lastHeights[ps.MarketID] = ps.Height
+	}
+	return nil
+}
+
+// TimeWeightedAverage returns the average price over the window ending at the given time, weighting each snapshot's
+// price by how long it was current. Snapshots must be in ascending time order. The latest snapshot before the window
+// start, if present, is used as the price at the start of the window.
+func (pss PriceSnapshots) TimeWeightedAverage(end time.Time, window time.Duration) (sdk.Dec, bool) {
+	start := end.Add(-window)
+	weightedSum := sdk.ZeroDec()
+	totalWeight := int64(0)
+	for i, ps := range pss {
+		if ps.Time.After(end) {
+			break
+		}
+		periodStart := ps.Time
+		if periodStart.Before(start) {
+			periodStart = start
+		}
+		periodEnd := end
+		if i+1 < len(pss) && pss[i+1].Time.Before(end) {
+			periodEnd = pss[i+1].Time
+		}
+		if !periodEnd.After(periodStart) {
+			continue
+		}
+		weight := int64(periodEnd.Sub(periodStart))
+		weightedSum = weightedSum.Add(ps.Price.MulInt64(weight))
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		// a single snapshot taken at the end of the window is the average
+		for i := len(pss) - 1; i >= 0; i-- {
+			if !pss[i].Time.After(end) {
+				return pss[i].Price, true
+			}
+		}
+		return sdk.Dec{}, false
+	}
+	return weightedSum.QuoInt64(totalWeight), true
+}
+
+// OHLC returns the open, high, low and close prices over the range from start to end. Snapshots must be in
+// ascending time order. The open is the latest price at or before the start of the range if present,
+// otherwise the first price within it.
+func (pss PriceSnapshots) OHLC(start, end time.Time) (PriceOHLC, bool) {
+	var ohlc PriceOHLC
+	found := false
+	for _, ps := range pss {
+		if ps.Time.After(end) {
+			break
+		}
+		if !ps.Time.After(start) {
+			// a later snapshot before the range start replaces the open
+			ohlc = NewPriceOHLC(ps.MarketID, ps.Price, ps.Price, ps.Price, ps.Price, start, end)
+			found = true
+			continue
+		}
+		if !found {
+			ohlc = NewPriceOHLC(ps.MarketID, ps.Price, ps.Price, ps.Price, ps.Price, start, end)
+			found = true
+			continue
+		}
+		if ps.Price.GT(ohlc.High) {
+			ohlc.High = ps.Price
+		}
+		if ps.Price.LT(ohlc.Low) {
+			ohlc.Low = ps.Price
+		}
+		ohlc.Close = ps.Price
+	}
+	return ohlc, found
+}
+
+// PriceOHLC is the open, high, low and close price of a market over a time range
+type PriceOHLC struct {
+	MarketID  string    `json:"market_id" yaml:"market_id"`
+	Open      sdk.Dec   `json:"open" yaml:"open"`
+	High      sdk.Dec   `json:"high" yaml:"high"`
+	Low       sdk.Dec   `json:"low" yaml:"low"`
+	Close     sdk.Dec   `json:"close" yaml:"close"`
+	StartTime time.Time `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewPriceOHLC returns a new PriceOHLC
+func NewPriceOHLC(marketID string, open, high, low, close sdk.Dec, start, end time.Time) PriceOHLC {
+	return PriceOHLC{
+		MarketID:  marketID,
+		Open:      open,
+		High:      high,
+		Low:       low,
+		Close:     close,
+		StartTime: start,
+		EndTime:   end,
+	}
+}
+
+// String implements fmt.Stringer
+func (ohlc PriceOHLC) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Open: %s
+High: %s
+Low: %s
+Close: %s
+Start Time: %s
+End Time: %s`, ohlc.MarketID, ohlc.Open, ohlc.High, ohlc.Low, ohlc.Close, ohlc.StartTime, ohlc.EndTime))
+}
+
+// PriceTWAP is the time-weighted average price of a market over a time range
+type PriceTWAP struct {
+	MarketID  string    `json:"market_id" yaml:"market_id"`
+	Price     sdk.Dec   `json:"price" yaml:"price"`
+	StartTime time.Time `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewPriceTWAP returns a new PriceTWAP
+func NewPriceTWAP(marketID string, price sdk.Dec, start, end time.Time) PriceTWAP {
+	return PriceTWAP{
+		MarketID:  marketID,
+		Price:     price,
+		StartTime: start,
+		EndTime:   end,
+	}
+}
+
+// String implements fmt.Stringer
+func (twap PriceTWAP) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Price: %s
+Start Time: %s
+End Time: %s`, twap.MarketID, twap.Price, twap.StartTime, twap.EndTime))
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPriceSnapshotsTimeWeightedAverage(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := PriceSnapshots{
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("1.0"), 1, start.Add(-time.Minute)),
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("2.0"), 2, start.Add(30*time.Minute)),
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("4.0"), 3, start.Add(45*time.Minute)),
+	}
+
+	// 1.0 for 30 minutes, 2.0 for 15 minutes, 4.0 for 15 minutes
+	average, ok := snapshots.TimeWeightedAverage(start.Add(time.Hour), time.Hour)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("2.0"), average)
+
+	// a single snapshot at the end of the window is the average
+	average, ok = snapshots[:1].TimeWeightedAverage(start.Add(-time.Minute), time.Hour)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("1.0"), average)
+
+	_, ok = PriceSnapshots{}.TimeWeightedAverage(start, time.Hour)
+	require.False(t, ok)
+}
+
+func TestPriceSnapshotsOHLC(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := PriceSnapshots{
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("1.0"), 1, start.Add(-time.Minute)),
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("3.0"), 2, start.Add(10*time.Minute)),
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("0.5"), 3, start.Add(20*time.Minute)),
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("2.0"), 4, start.Add(30*time.Minute)),
+		NewPriceSnapshot("xrp:usd", sdk.MustNewDecFromStr("9.0"), 5, start.Add(2*time.Hour)),
+	}
+
+	ohlc, ok := snapshots.OHLC(start, start.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, NewPriceOHLC(
+		"xrp:usd",
+		sdk.MustNewDecFromStr("1.0"), sdk.MustNewDecFromStr("3.0"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("2.0"),
+		start, start.Add(time.Hour),
+	), ohlc)
+
+	// without a price before the range the first price within it is the open
+	ohlc, ok = snapshots[1:].OHLC(start, start.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("3.0"), ohlc.Open)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), ohlc.Low)
+
+	_, ok = snapshots[4:].OHLC(start, start.Add(time.Hour))
+	require.False(t, ok)
+}
+
+func TestPriceSnapshotsValidate(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		msg       string
+		snapshots PriceSnapshots
+		expPass   bool
+	}{
+		{
+			"valid",
+			PriceSnapshots{
+				NewPriceSnapshot("xrp:usd", sdk.OneDec(), 1, now),
+				NewPriceSnapshot("bnb:usd", sdk.OneDec(), 1, now),
+				NewPriceSnapshot("xrp:usd", sdk.OneDec(), 2, now),
+			},
+			true,
+		},
+		{
+			"zero price",
+			PriceSnapshots{NewPriceSnapshot("xrp:usd", sdk.ZeroDec(), 1, now)},
+			false,
+		},
+		{
+			"zero height",
+			PriceSnapshots{NewPriceSnapshot("xrp:usd", sdk.OneDec(), 0, now)},
+			false,
+		},
+		{
+			"out of order",
+			PriceSnapshots{
+				NewPriceSnapshot("xrp:usd", sdk.OneDec(), 2, now),
+				NewPriceSnapshot("xrp:usd", sdk.OneDec(), 1, now),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.snapshots.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -15,6 +19,9 @@ const (
 
 	// DefaultParamspace default namestore
 	DefaultParamspace = ModuleName
+
+	// ModuleAccountName name of the module account holding oracle bonds
+	ModuleAccountName = ModuleName
 )
 
 var (
@@ -23,6 +30,21 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// OraclePerformancePrefix prefix for the performance record of an oracle in a market
+	OraclePerformancePrefix = []byte{0x02}
+
+	// OracleBondPrefix prefix for the bond deposited by an oracle
+	OracleBondPrefix = []byte{0x03}
+
+	// PerformanceWindowStartKey key for the start time of the current performance window
+	PerformanceWindowStartKey = []byte{0x04}
+
+	// PriceCommitmentPrefix prefix for an oracle's price commitment in a market
+	PriceCommitmentPrefix = []byte{0x05}
+
+	// PriceHistoryPrefix prefix for the recorded price snapshots of a market
+	PriceHistoryPrefix = []byte{0x06}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -34,3 +56,41 @@ func CurrentPriceKey(marketID string) []byte {
 func RawPriceKey(marketID string) []byte {
 	return append(RawPriceFeedPrefix, []byte(marketID)...)
 }
+
+// OraclePerformanceKey returns the key for an oracle's performance in a market
+func OraclePerformanceKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(OraclePerformanceMarketKey(marketID), oracle...)
+}
+
+// OraclePerformanceMarketKey returns the prefix for all oracle performances in a market
+func OraclePerformanceMarketKey(marketID string) []byte {
+	return marketKey(OraclePerformancePrefix, marketID)
+}
+
+// OracleBondKey returns the key for an oracle's bond
+func OracleBondKey(oracle sdk.AccAddress) []byte {
+	return append(OracleBondPrefix, oracle...)
+}
+
+// PriceCommitmentKey returns the key for an oracle's price commitment in a market
+func PriceCommitmentKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(marketKey(PriceCommitmentPrefix, marketID), oracle...)
+}
+
+// PriceHistoryMarketKey returns the prefix for all price snapshots of a market
+func PriceHistoryMarketKey(marketID string) []byte {
+	return marketKey(PriceHistoryPrefix, marketID)
+}
+
+// PriceSnapshotKey returns the key for a market's price snapshot at the given block height
+func PriceSnapshotKey(marketID string, height int64) []byte {
+	return append(PriceHistoryMarketKey(marketID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// marketKey returns a key for a market under the given prefix. The market id is length prefixed
+// so that keys of a market whose id is a prefix of another market's id do not overlap.
+func marketKey(prefix []byte, marketID string) []byte {
+	key := append([]byte{}, prefix...)
+	key = append(key, byte(len(marketID)))
+	return append(key, []byte(marketID)...)
+}
@@ -11,14 +11,16 @@ import (
 
 // Market an asset in the pricefeed
 type Market struct {
-	MarketID   string           `json:"market_id" yaml:"market_id"`
-	BaseAsset  string           `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
-	Active     bool             `json:"active" yaml:"active"`
+	MarketID           string           `json:"market_id" yaml:"market_id"`
+	BaseAsset          string           `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset         string           `json:"quote_asset" yaml:"quote_asset"`
+	Oracles            []sdk.AccAddress `json:"oracles" yaml:"oracles"`
+	Active             bool             `json:"active" yaml:"active"`
+	CommitRevealPeriod time.Duration    `json:"commit_reveal_period" yaml:"commit_reveal_period"` // length of the commit and reveal periods, zero for direct price posting
+	Derivation         MarketDerivation `json:"derivation" yaml:"derivation"`                     // how the price is computed from other markets, empty for oracle markets
 }
 
-// NewMarket returns a new Market
+// NewMarket returns a new Market that accepts directly posted prices
 func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Market {
 	return Market{
 		MarketID:   id,
@@ -36,8 +38,20 @@ func (m Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	Active: %t
+	Commit Reveal Period: %s
+	Derivation: %s`,
+		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active, m.CommitRevealPeriod, m.Derivation)
+}
+
+// IsDerived returns true if the market's price is computed from other markets rather than posted by oracles
+func (m Market) IsDerived() bool {
+	return m.Derivation.Type != ""
+}
+
+// UsesCommitReveal returns true if oracles must commit to and then reveal their prices for the market
+func (m Market) UsesCommitReveal() bool {
+	return m.CommitRevealPeriod > 0
 }
 
 // Validate performs a basic validation of the market params
@@ -61,6 +75,15 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if m.CommitRevealPeriod < 0 {
+		return fmt.Errorf("commit reveal period cannot be negative: %s", m.CommitRevealPeriod)
+	}
+	if err := m.Derivation.Validate(); err != nil {
+		return fmt.Errorf("invalid derivation for market %s: %w", m.MarketID, err)
+	}
+	if m.IsDerived() && (len(m.Oracles) > 0 || m.UsesCommitReveal()) {
+		return fmt.Errorf("derived market %s cannot have oracles or use commit-reveal", m.MarketID)
+	}
 	return nil
 }
 
@@ -78,11 +101,32 @@ func (ms Markets) Validate() error {
 		if err := m.Validate(); err != nil {
 			return err
 		}
+		// sources must precede the derived market so prices can be derived in a single ordered pass
+		for _, source := range m.Derivation.SourceMarkets {
+			if !seenMarkets[source] {
+				return fmt.Errorf("source market %s of derived market %s must be listed before it", source, m.MarketID)
+			}
+		}
 		seenMarkets[m.MarketID] = true
 	}
 	return nil
 }
 
+// TWAPSourceWindows returns the longest TWAP window of any active derived market for each market that is the source of a TWAP
+func (ms Markets) TWAPSourceWindows() map[string]time.Duration {
+	windows := make(map[string]time.Duration)
+	for _, m := range ms {
+		if !m.Active || m.Derivation.Type != DerivationTypeTWAP {
+			continue
+		}
+		source := m.Derivation.SourceMarkets[0]
+		if m.Derivation.TWAPWindow > windows[source] {
+			windows[source] = m.Derivation.TWAPWindow
+		}
+	}
+	return windows
+}
+
 // String implements fmt.Stringer
 func (ms Markets) String() string {
 	out := "Markets:\n"
@@ -77,6 +77,58 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid derived market",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Active:     true,
+				Derivation: NewMarketDerivation(DerivationTypeTWAP, []string{"xrp:bnb"}, time.Hour),
+			},
+			true,
+		},
+		{
+			"derived market with oracles",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Derivation: NewMarketDerivation(DerivationTypeInverse, []string{"bnb:xrp"}, 0),
+			},
+			false,
+		},
+		{
+			"product derivation with one source",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Derivation: NewMarketDerivation(DerivationTypeProduct, []string{"xrp:usd"}, 0),
+			},
+			false,
+		},
+		{
+			"twap derivation without window",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Derivation: NewMarketDerivation(DerivationTypeTWAP, []string{"xrp:bnb"}, 0),
+			},
+			false,
+		},
+		{
+			"unknown derivation type",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Derivation: NewMarketDerivation("sum", []string{"xrp:usd", "usd:bnb"}, 0),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,6 +141,21 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketsValidateDerivationOrder(t *testing.T) {
+	source := Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Active: true}
+	derived := Market{
+		MarketID:   "usd:xrp",
+		BaseAsset:  "usd",
+		QuoteAsset: "xrp",
+		Active:     true,
+		Derivation: NewMarketDerivation(DerivationTypeInverse, []string{"xrp:usd"}, 0),
+	}
+
+	require.NoError(t, Markets{source, derived}.Validate())
+	require.Error(t, Markets{derived, source}.Validate())
+	require.Error(t, Markets{derived}.Validate())
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
@@ -8,18 +8,34 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgDepositOracleBond type of DepositOracleBond msg
+	TypeMsgDepositOracleBond = "deposit_oracle_bond"
+	// TypeMsgWithdrawOracleBond type of WithdrawOracleBond msg
+	TypeMsgWithdrawOracleBond = "withdraw_oracle_bond"
+	// TypeMsgCommitPrice type of CommitPrice msg
+	TypeMsgCommitPrice = "commit_price"
+	// TypeMsgRevealPrice type of RevealPrice msg
+	TypeMsgRevealPrice = "reveal_price"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgDepositOracleBond{}
+	_ sdk.Msg = &MsgWithdrawOracleBond{}
+	_ sdk.Msg = &MsgCommitPrice{}
+	_ sdk.Msg = &MsgRevealPrice{}
+)
 
 // MsgPostPrice struct representing a posted price message.
 // Used by oracles to input prices to the pricefeed
@@ -77,3 +93,191 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// MsgDepositOracleBond deposits coins into an oracle's bond
+type MsgDepositOracleBond struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositOracleBond returns a new MsgDepositOracleBond
+func NewMsgDepositOracleBond(from sdk.AccAddress, amount sdk.Coins) MsgDepositOracleBond {
+	return MsgDepositOracleBond{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgDepositOracleBond) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgDepositOracleBond) Type() string { return TypeMsgDepositOracleBond }
+
+// GetSignBytes Implements Msg.
+func (msg MsgDepositOracleBond) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDepositOracleBond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositOracleBond) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bond amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// MsgWithdrawOracleBond withdraws coins from an oracle's bond
+type MsgWithdrawOracleBond struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawOracleBond returns a new MsgWithdrawOracleBond
+func NewMsgWithdrawOracleBond(from sdk.AccAddress, amount sdk.Coins) MsgWithdrawOracleBond {
+	return MsgWithdrawOracleBond{
+		From:   from,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgWithdrawOracleBond) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgWithdrawOracleBond) Type() string { return TypeMsgWithdrawOracleBond }
+
+// GetSignBytes Implements Msg.
+func (msg MsgWithdrawOracleBond) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWithdrawOracleBond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawOracleBond) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// MsgCommitPrice commits an oracle to a price for a commit-reveal market without disclosing it
+type MsgCommitPrice struct {
+	From     sdk.AccAddress   `json:"from" yaml:"from"`
+	MarketID string           `json:"market_id" yaml:"market_id"`
+	Hash     tmbytes.HexBytes `json:"hash" yaml:"hash"` // hash of the price and salt, see CalculatePriceCommitment
+}
+
+// NewMsgCommitPrice returns a new MsgCommitPrice
+func NewMsgCommitPrice(from sdk.AccAddress, marketID string, hash tmbytes.HexBytes) MsgCommitPrice {
+	return MsgCommitPrice{
+		From:     from,
+		MarketID: marketID,
+		Hash:     hash,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCommitPrice) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCommitPrice) Type() string { return TypeMsgCommitPrice }
+
+// GetSignBytes Implements Msg.
+func (msg MsgCommitPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCommitPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCommitPrice) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if strings.TrimSpace(msg.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(msg.Hash) != CommitmentHashLength {
+		return fmt.Errorf("commitment hash must be %d bytes, got %d", CommitmentHashLength, len(msg.Hash))
+	}
+	return nil
+}
+
+// MsgRevealPrice reveals a price committed to in the previous commit period
+type MsgRevealPrice struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	MarketID string         `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec        `json:"price" yaml:"price"`
+	Salt     string         `json:"salt" yaml:"salt"`
+	Expiry   time.Time      `json:"expiry" yaml:"expiry"`
+}
+
+// NewMsgRevealPrice returns a new MsgRevealPrice
+func NewMsgRevealPrice(from sdk.AccAddress, marketID string, price sdk.Dec, salt string, expiry time.Time) MsgRevealPrice {
+	return MsgRevealPrice{
+		From:     from,
+		MarketID: marketID,
+		Price:    price,
+		Salt:     salt,
+		Expiry:   expiry,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevealPrice) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevealPrice) Type() string { return TypeMsgRevealPrice }
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevealPrice) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if strings.TrimSpace(msg.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if msg.Price.IsNil() || msg.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", msg.Price)
+	}
+	if len(msg.Salt) == 0 || len(msg.Salt) > MaxSaltLength {
+		return fmt.Errorf("salt length must be between 1 and %d", MaxSaltLength)
+	}
+	if msg.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
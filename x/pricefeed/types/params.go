@@ -1,33 +1,119 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = Markets{}
+	KeyMarkets                = []byte("Markets")
+	KeyPerformanceParams      = []byte("PerformanceParams")
+	KeyPriceHistoryLength     = []byte("PriceHistoryLength")
+	DefaultMarkets            = Markets{}
+	DefaultPerformanceWindow  = time.Duration(0)
+	DefaultMaxDeviation       = sdk.ZeroDec()
+	DefaultSlashFraction      = sdk.ZeroDec()
+	DefaultMinOracleBond      = sdk.Coins{}
+	DefaultMissedWindowLimit  = uint64(0)
+	DefaultOutlierWindowLimit = uint64(0)
+	DefaultPriceHistoryLength = uint64(0)
 )
 
 // Params params for pricefeed. Can be altered via governance
 type Params struct {
-	Markets Markets `json:"markets" yaml:"markets"` //  Array containing the markets supported by the pricefeed
+	Markets            Markets           `json:"markets" yaml:"markets"`                           //  Array containing the markets supported by the pricefeed
+	PerformanceParams  PerformanceParams `json:"performance_params" yaml:"performance_params"`     // Oracle performance tracking and penalty configuration
+	PriceHistoryLength uint64            `json:"price_history_length" yaml:"price_history_length"` // Number of blocks of median prices kept for each market, zero disables price history
 }
 
 // NewParams creates a new AssetParams object
-func NewParams(markets Markets) Params {
+func NewParams(markets Markets, performanceParams PerformanceParams, priceHistoryLength uint64) Params {
 	return Params{
-		Markets: markets,
+		Markets:            markets,
+		PerformanceParams:  performanceParams,
+		PriceHistoryLength: priceHistoryLength,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, NewPerformanceParams(
+		DefaultPerformanceWindow, DefaultMaxDeviation, DefaultMissedWindowLimit,
+		DefaultOutlierWindowLimit, DefaultSlashFraction, false, DefaultMinOracleBond,
+	), DefaultPriceHistoryLength)
+}
+
+// PerformanceParams governs how oracle performance is tracked and penalized.
+// A zero WindowDuration disables tracking entirely, and a zero limit disables the corresponding penalty.
+type PerformanceParams struct {
+	WindowDuration     time.Duration `json:"window_duration" yaml:"window_duration"`           // length of each update window in which every oracle is expected to post
+	MaxDeviation       sdk.Dec       `json:"max_deviation" yaml:"max_deviation"`               // relative deviation from the median above which a posted price is an outlier
+	MissedWindowLimit  uint64        `json:"missed_window_limit" yaml:"missed_window_limit"`   // missed windows after which an oracle is penalized
+	OutlierWindowLimit uint64        `json:"outlier_window_limit" yaml:"outlier_window_limit"` // outlier windows after which an oracle is penalized
+	SlashFraction      sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`             // fraction of an oracle's bond burned when penalized
+	RemoveOracle       bool          `json:"remove_oracle" yaml:"remove_oracle"`               // remove penalized oracles from the market's oracle set
+	MinOracleBond      sdk.Coins     `json:"min_oracle_bond" yaml:"min_oracle_bond"`           // bond an oracle must hold to post prices
+}
+
+// NewPerformanceParams returns a new PerformanceParams
+func NewPerformanceParams(
+	window time.Duration, maxDeviation sdk.Dec, missedLimit, outlierLimit uint64,
+	slashFraction sdk.Dec, removeOracle bool, minBond sdk.Coins,
+) PerformanceParams {
+	return PerformanceParams{
+		WindowDuration:     window,
+		MaxDeviation:       maxDeviation,
+		MissedWindowLimit:  missedLimit,
+		OutlierWindowLimit: outlierLimit,
+		SlashFraction:      slashFraction,
+		RemoveOracle:       removeOracle,
+		MinOracleBond:      minBond,
+	}
+}
+
+// TrackingEnabled returns true if oracle performance should be recorded
+func (pp PerformanceParams) TrackingEnabled() bool {
+	return pp.WindowDuration > 0
+}
+
+// Validate performs basic validation of performance params
+func (pp PerformanceParams) Validate() error {
+	if pp.WindowDuration < 0 {
+		return fmt.Errorf("window duration cannot be negative: %s", pp.WindowDuration)
+	}
+	if !pp.MaxDeviation.IsNil() && pp.MaxDeviation.IsNegative() {
+		return fmt.Errorf("max deviation cannot be negative: %s", pp.MaxDeviation)
+	}
+	if !pp.SlashFraction.IsNil() && (pp.SlashFraction.IsNegative() || pp.SlashFraction.GT(sdk.OneDec())) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", pp.SlashFraction)
+	}
+	if !pp.MinOracleBond.IsValid() {
+		return fmt.Errorf("invalid min oracle bond: %s", pp.MinOracleBond)
+	}
+	if !pp.TrackingEnabled() && (pp.MissedWindowLimit > 0 || pp.OutlierWindowLimit > 0) {
+		return errors.New("penalty limits require a positive window duration")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pp PerformanceParams) String() string {
+	return fmt.Sprintf(`Performance Params:
+	Window Duration: %s
+	Max Deviation: %s
+	Missed Window Limit: %d
+	Outlier Window Limit: %d
+	Slash Fraction: %s
+	Remove Oracle: %t
+	Min Oracle Bond: %s`,
+		pp.WindowDuration, pp.MaxDeviation, pp.MissedWindowLimit, pp.OutlierWindowLimit,
+		pp.SlashFraction, pp.RemoveOracle, pp.MinOracleBond)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -40,6 +126,8 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		params.NewParamSetPair(KeyPerformanceParams, &p.PerformanceParams, validatePerformanceParams),
+		params.NewParamSetPair(KeyPriceHistoryLength, &p.PriceHistoryLength, validatePriceHistoryLength),
 	}
 }
 
@@ -49,12 +137,20 @@ func (p Params) String() string {
 	for _, a := range p.Markets {
 		out += fmt.Sprintf("%s\n", a.String())
 	}
+	out += fmt.Sprintf("%s\n", p.PerformanceParams)
+	out += fmt.Sprintf("Price History Length: %d\n", p.PriceHistoryLength)
 	return strings.TrimSpace(out)
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	if err := validatePerformanceParams(p.PerformanceParams); err != nil {
+		return err
+	}
+	return validatePriceHistoryLength(p.PriceHistoryLength)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -65,3 +161,21 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validatePerformanceParams(i interface{}) error {
+	performanceParams, ok := i.(PerformanceParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return performanceParams.Validate()
+}
+
+func validatePriceHistoryLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
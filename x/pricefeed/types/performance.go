@@ -0,0 +1,131 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OraclePerformance tracks how reliably an oracle has posted prices for a market
+type OraclePerformance struct {
+	MarketID       string         `json:"market_id" yaml:"market_id"`
+	OracleAddress  sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	WindowsTracked uint64         `json:"windows_tracked" yaml:"windows_tracked"` // total windows in which the oracle was evaluated
+	MissedWindows  uint64         `json:"missed_windows" yaml:"missed_windows"`   // windows without a posted price since the last penalty
+	OutlierWindows uint64         `json:"outlier_windows" yaml:"outlier_windows"` // windows with an outlying price since the last penalty
+	LastDeviation  sdk.Dec        `json:"last_deviation" yaml:"last_deviation"`   // relative deviation from the median at the last evaluation
+	LastPostTime   time.Time      `json:"last_post_time" yaml:"last_post_time"`
+	Penalties      uint64         `json:"penalties" yaml:"penalties"`
+	Removed        bool           `json:"removed" yaml:"removed"` // whether the oracle was removed from the market when penalized
+}
+
+// NewOraclePerformance returns a new OraclePerformance with no history
+func NewOraclePerformance(marketID string, oracle sdk.AccAddress) OraclePerformance {
+	return OraclePerformance{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		LastDeviation: sdk.ZeroDec(),
+	}
+}
+
+// Validate performs a basic check of an OraclePerformance
+func (op OraclePerformance) Validate() error {
+	if strings.TrimSpace(op.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if op.OracleAddress.Empty() {
+		return errors.New("oracle address cannot be empty")
+	}
+	if op.LastDeviation.IsNil() || op.LastDeviation.IsNegative() {
+		return fmt.Errorf("invalid last deviation %s", op.LastDeviation)
+	}
+	if op.MissedWindows > op.WindowsTracked || op.OutlierWindows > op.WindowsTracked {
+		return fmt.Errorf("missed or outlier windows exceed tracked windows for oracle %s", op.OracleAddress)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (op OraclePerformance) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle Address: %s
+Windows Tracked: %d
+Missed Windows: %d
+Outlier Windows: %d
+Last Deviation: %s
+Last Post Time: %s
+Penalties: %d
+Removed: %t`, op.MarketID, op.OracleAddress, op.WindowsTracked, op.MissedWindows,
+		op.OutlierWindows, op.LastDeviation, op.LastPostTime, op.Penalties, op.Removed))
+}
+
+// OraclePerformances is a slice of OraclePerformance
+type OraclePerformances []OraclePerformance
+
+// Validate checks that all performance records are valid and there are no duplicated entries
+func (ops OraclePerformances) Validate() error {
+	seen := make(map[string]bool)
+	for _, op := range ops {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+		key := op.MarketID + op.OracleAddress.String()
+		if seen[key] {
+			return fmt.Errorf("duplicated performance for market id %s and oracle address %s", op.MarketID, op.OracleAddress)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// OracleBond is the deposit an oracle holds in the pricefeed module account
+type OracleBond struct {
+	OracleAddress sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	Amount        sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewOracleBond returns a new OracleBond
+func NewOracleBond(oracle sdk.AccAddress, amount sdk.Coins) OracleBond {
+	return OracleBond{
+		OracleAddress: oracle,
+		Amount:        amount,
+	}
+}
+
+// Validate performs a basic check of an OracleBond
+func (ob OracleBond) Validate() error {
+	if ob.OracleAddress.Empty() {
+		return errors.New("oracle address cannot be empty")
+	}
+	if !ob.Amount.IsValid() || ob.Amount.Empty() {
+		return fmt.Errorf("invalid bond amount %s", ob.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ob OracleBond) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Oracle Address: %s
+Amount: %s`, ob.OracleAddress, ob.Amount))
+}
+
+// OracleBonds is a slice of OracleBond
+type OracleBonds []OracleBond
+
+// Validate checks that all bonds are valid and there are no duplicated entries
+func (obs OracleBonds) Validate() error {
+	seen := make(map[string]bool)
+	for _, ob := range obs {
+		if err := ob.Validate(); err != nil {
+			return err
+		}
+		if seen[ob.OracleAddress.String()] {
+			return fmt.Errorf("duplicated bond for oracle address %s", ob.OracleAddress)
+		}
+		seen[ob.OracleAddress.String()] = true
+	}
+	return nil
+}
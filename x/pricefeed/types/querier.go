@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
 // assets Returns []Assets in the pricefeed system
@@ -17,6 +23,20 @@ const (
 	QueryPrice = "price"
 	// QueryPrices command for quering all prices
 	QueryPrices = "prices"
+	// QueryOraclePerformance command for oracle performance queries
+	QueryOraclePerformance = "oracle-performance"
+	// QueryOracleBond command for oracle bond queries
+	QueryOracleBond = "oracle-bond"
+	// QueryPriceCommitments command for price commitment queries
+	QueryPriceCommitments = "price-commitments"
+	// QueryPriceHistory command for querying the recorded prices of a market
+	QueryPriceHistory = "price-history"
+	// QueryPriceAtHeight command for querying the price of a market at a block height
+	QueryPriceAtHeight = "price-at-height"
+	// QueryPriceOHLC command for querying the open, high, low and close prices of a market over a time range
+	QueryPriceOHLC = "ohlc"
+	// QueryPriceTWAP command for querying the time-weighted average price of a market over a time range
+	QueryPriceTWAP = "twap"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -30,3 +50,76 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// QueryOraclePerformanceParams fields for querying oracle performance.
+// An empty MarketID or Oracle matches all markets or oracles respectively.
+type QueryOraclePerformanceParams struct {
+	MarketID string         `json:"market_id" yaml:"market_id"`
+	Oracle   sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewQueryOraclePerformanceParams creates a new instance of QueryOraclePerformanceParams
+func NewQueryOraclePerformanceParams(marketID string, oracle sdk.AccAddress) QueryOraclePerformanceParams {
+	return QueryOraclePerformanceParams{
+		MarketID: marketID,
+		Oracle:   oracle,
+	}
+}
+
+// QueryOracleBondParams fields for querying the bond of an oracle
+type QueryOracleBondParams struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewQueryOracleBondParams creates a new instance of QueryOracleBondParams
+func NewQueryOracleBondParams(oracle sdk.AccAddress) QueryOracleBondParams {
+	return QueryOracleBondParams{
+		Oracle: oracle,
+	}
+}
+
+// QueryPriceHistoryParams fields for querying the recorded prices of a market
+type QueryPriceHistoryParams struct {
+	MarketID string `json:"market_id" yaml:"market_id"`
+	Page     int    `json:"page" yaml:"page"`
+	Limit    int    `json:"limit" yaml:"limit"`
+}
+
+// NewQueryPriceHistoryParams creates a new instance of QueryPriceHistoryParams
+func NewQueryPriceHistoryParams(marketID string, page, limit int) QueryPriceHistoryParams {
+	return QueryPriceHistoryParams{
+		MarketID: marketID,
+		Page:     page,
+		Limit:    limit,
+	}
+}
+
+// QueryPriceAtHeightParams fields for querying the price of a market at a block height
+type QueryPriceAtHeightParams struct {
+	MarketID string `json:"market_id" yaml:"market_id"`
+	Height   int64  `json:"height" yaml:"height"`
+}
+
+// NewQueryPriceAtHeightParams creates a new instance of QueryPriceAtHeightParams
+func NewQueryPriceAtHeightParams(marketID string, height int64) QueryPriceAtHeightParams {
+	return QueryPriceAtHeightParams{
+		MarketID: marketID,
+		Height:   height,
+	}
+}
+
+// QueryPriceRangeParams fields for querying prices of a market over a time range
+type QueryPriceRangeParams struct {
+	MarketID  string    `json:"market_id" yaml:"market_id"`
+	StartTime time.Time `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewQueryPriceRangeParams creates a new instance of QueryPriceRangeParams
+func NewQueryPriceRangeParams(marketID string, start, end time.Time) QueryPriceRangeParams {
+	return QueryPriceRangeParams{
+		MarketID:  marketID,
+		StartTime: start,
+		EndTime:   end,
+	}
+}
@@ -118,6 +118,7 @@ type (
 	PoolStatsQueryResults = types.PoolStatsQueryResults
 	QueryDepositsParams   = types.QueryDepositsParams
 	QueryPoolParams       = types.QueryPoolParams
+	SendRestriction       = types.SendRestriction
 	ShareRecord           = types.ShareRecord
 	ShareRecords          = types.ShareRecords
 	SupplyKeeper          = types.SupplyKeeper
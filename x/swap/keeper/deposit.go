@@ -76,6 +76,11 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
 	}
 
+	err = k.validateTransfer(ctx, depositor, k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), depositAmount)
+	if err != nil {
+		return err
+	}
+
 	k.updatePool(ctx, poolID, pool)
 	if shareRecord, hasExistingShares := k.GetDepositorShares(ctx, depositor, poolID); hasExistingShares {
 		k.BeforePoolDepositModified(ctx, poolID, depositor, shareRecord.SharesOwned)
@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/kava-labs/kava/x/issuance"
 	"github.com/kava-labs/kava/x/swap/types"
+	"github.com/kava-labs/kava/x/swap/types/mocks"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
@@ -341,3 +344,33 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientLiquidity() {
 		})
 	}
 }
+
+func (suite *keeperTestSuite) TestDeposit_RestrictedIssuanceAsset() {
+	pool := types.NewAllowedPool("ukava", "usdtoken")
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)), sdk.NewCoin("usdtoken", sdk.NewInt(50e6)))
+	totalShares := sdk.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, sdk.AccAddress(crypto.AddressHash([]byte("PoolOwner"))))
+
+	balance := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10e6)), sdk.NewCoin("usdtoken", sdk.NewInt(50e6)))
+	depositor := suite.CreateAccount(balance)
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("IssuanceOwner")))
+
+	asset := issuance.NewAsset(owner, "usdtoken", false, true, false, issuance.NewRateLimit(false, sdk.ZeroInt(), 0))
+	issuanceKeeper := suite.App.GetIssuanceKeeper()
+	issuanceKeeper.SetParams(suite.Ctx, issuance.NewParams(issuance.Assets{asset}))
+	issuanceKeeper.SetBlockedAddress(suite.Ctx, "usdtoken", depositor.GetAddress())
+
+	// the transfer is checked before hooks are called or the pool and shares are updated
+	suite.Keeper.ClearHooks()
+	suite.Keeper.SetHooks(&mocks.SwapHooks{})
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), sdk.NewCoin("ukava", sdk.NewInt(10e6)), sdk.NewCoin("usdtoken", sdk.NewInt(50e6)), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().True(errors.Is(err, issuance.ErrAccountBlocked), fmt.Sprintf("got err %s", err))
+	suite.AccountBalanceEqual(depositor, balance)
+	suite.PoolReservesEqual(poolID, reserves)
+	suite.PoolShareTotalEqual(poolID, totalShares)
+	_, found := suite.Keeper.GetDepositorShares(suite.Ctx, depositor.GetAddress(), poolID)
+	suite.False(found)
+}
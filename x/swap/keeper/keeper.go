@@ -13,12 +13,13 @@ import (
 
 // Keeper keeper for the swap module
 type Keeper struct {
-	key           sdk.StoreKey
-	cdc           *codec.Codec
-	paramSubspace subspace.Subspace
-	hooks         types.SwapHooks
-	accountKeeper types.AccountKeeper
-	supplyKeeper  types.SupplyKeeper
+	key             sdk.StoreKey
+	cdc             *codec.Codec
+	paramSubspace   subspace.Subspace
+	hooks           types.SwapHooks
+	sendRestriction types.SendRestriction
+	accountKeeper   types.AccountKeeper
+	supplyKeeper    types.SupplyKeeper
 }
 
 // NewKeeper creates a new keeper
@@ -51,6 +52,23 @@ func (k *Keeper) SetHooks(sh types.SwapHooks) *Keeper {
 	return k
 }
 
+// SetSendRestriction sets the restriction checked when coins move between accounts and the swap module
+func (k *Keeper) SetSendRestriction(sr types.SendRestriction) *Keeper {
+	if k.sendRestriction != nil {
+		panic("cannot set swap send restriction twice")
+	}
+	k.sendRestriction = sr
+	return k
+}
+
+// validateTransfer checks a transfer against the send restriction, if one is set
+func (k Keeper) validateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if k.sendRestriction == nil {
+		return nil
+	}
+	return k.sendRestriction.ValidateTransfer(ctx, from, to, coins)
+}
+
 // ClearHooks clears the hooks on the keeper
 func (k *Keeper) ClearHooks() {
 	k.hooks = nil
@@ -96,6 +96,10 @@ func (k Keeper) commitSwap(
 	feePaid sdk.Coin,
 	exactDirection string,
 ) error {
+	if err := k.validateTransfer(ctx, requester, nil, sdk.NewCoins(swapInput, swapOutput)); err != nil {
+		return err
+	}
+
 	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
 
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
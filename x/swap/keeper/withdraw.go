@@ -51,6 +51,11 @@ func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdk.Int,
 		return sdkerrors.Wrap(types.ErrSlippageExceeded, "minimum withdraw not met")
 	}
 
+	err = k.validateTransfer(ctx, k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), owner, withdrawnAmount)
+	if err != nil {
+		return err
+	}
+
 	k.updatePool(ctx, poolID, pool)
 	k.BeforePoolDepositModified(ctx, poolID, owner, shareRecord.SharesOwned)
 	k.updateDepositorShares(ctx, owner, poolID, shareRecord.SharesOwned.Sub(shares))
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/x/issuance"
 	"github.com/kava-labs/kava/x/swap/types"
+	"github.com/kava-labs/kava/x/swap/types/mocks"
 )
 
 func (suite *keeperTestSuite) TestWithdraw_AllShares() {
@@ -221,3 +226,29 @@ func (suite *keeperTestSuite) TestWithdraw_PanicOnModuleInsufficientFunds() {
 		_ = suite.Keeper.Withdraw(suite.Ctx, owner.GetAddress(), totalShares, reserves[0], reserves[1])
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestWithdraw_RestrictedIssuanceAsset() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(10e6)),
+		sdk.NewCoin("usdtoken", sdk.NewInt(50e6)),
+	)
+	totalShares := sdk.NewInt(30e6)
+	poolID := suite.setupPool(reserves, totalShares, owner.GetAddress())
+
+	issuer := sdk.AccAddress(crypto.AddressHash([]byte("IssuanceOwner")))
+	asset := issuance.NewAsset(issuer, "usdtoken", false, true, false, issuance.NewRateLimit(false, sdk.ZeroInt(), 0))
+	issuanceKeeper := suite.App.GetIssuanceKeeper()
+	issuanceKeeper.SetParams(suite.Ctx, issuance.NewParams(issuance.Assets{asset}))
+	issuanceKeeper.SetBlockedAddress(suite.Ctx, "usdtoken", owner.GetAddress())
+
+	// the transfer is checked before hooks are called or the pool and shares are updated
+	suite.Keeper.ClearHooks()
+	suite.Keeper.SetHooks(&mocks.SwapHooks{})
+
+	err := suite.Keeper.Withdraw(suite.Ctx, owner.GetAddress(), totalShares, reserves[0], reserves[1])
+	suite.Require().True(errors.Is(err, issuance.ErrAccountBlocked), fmt.Sprintf("got err %s", err))
+	suite.PoolReservesEqual(poolID, reserves)
+	suite.PoolDepositorSharesEqual(owner.GetAddress(), poolID, totalShares)
+	suite.AccountBalanceEqual(owner, sdk.Coins(nil))
+}
@@ -26,3 +26,8 @@ type SwapHooks interface {
 	AfterPoolDepositCreated(ctx sdk.Context, poolID string, depositor sdk.AccAddress, sharedOwned sdk.Int)
 	BeforePoolDepositModified(ctx sdk.Context, poolID string, depositor sdk.AccAddress, sharedOwned sdk.Int)
 }
+
+// SendRestriction validates transfers of coins between accounts and the module, such as transfers of restricted issuance assets
+type SendRestriction interface {
+	ValidateTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error
+}